@@ -72,6 +72,7 @@ type Device struct {
 	endpoints map[string]string
 	info      DeviceInfo
 	deltaTime time.Duration
+	auth      *networking.Auth
 }
 
 type DeviceParams struct {
@@ -79,6 +80,8 @@ type DeviceParams struct {
 	Username   string
 	Password   string
 	HttpClient *http.Client
+	// Auth is WS-Security, HTTP Digest or both, by default WS-Security is used until the device asks for Digest
+	Auth networking.AuthMode
 }
 
 //GetXaddr return IP address.
@@ -191,6 +194,48 @@ func (dev *Device) getSupportedServices(data []byte) error {
 	return nil
 }
 
+// serviceNamespaces maps GetServices namespaces to the endpoint keys (the service package names)
+var serviceNamespaces = map[string]string{
+	"http://www.onvif.org/ver10/device/wsdl":           "device",
+	"http://www.onvif.org/ver10/media/wsdl":            "media",
+	"http://www.onvif.org/ver20/media/wsdl":            "media2",
+	"http://www.onvif.org/ver10/events/wsdl":           "events",
+	"http://www.onvif.org/ver20/ptz/wsdl":              "ptz",
+	"http://www.onvif.org/ver20/imaging/wsdl":          "imaging",
+	"http://www.onvif.org/ver20/analytics/wsdl":        "analytics",
+	"http://www.onvif.org/ver10/deviceIO/wsdl":         "deviceio",
+	"http://www.onvif.org/ver10/recording/wsdl":        "recording",
+	"http://www.onvif.org/ver10/search/wsdl":           "search",
+	"http://www.onvif.org/ver10/replay/wsdl":           "replay",
+	"http://www.onvif.org/ver10/receiver/wsdl":         "receiver",
+	"http://www.onvif.org/ver10/display/wsdl":          "display",
+	"http://www.onvif.org/ver10/analyticsdevice/wsdl":  "analyticsdevice",
+	"http://www.onvif.org/ver10/accesscontrol/wsdl":    "accesscontrol",
+	"http://www.onvif.org/ver10/doorcontrol/wsdl":      "doorcontrol",
+	"http://www.onvif.org/ver10/credential/wsdl":       "credential",
+	"http://www.onvif.org/ver10/accessrules/wsdl":      "accessrules",
+	"http://www.onvif.org/ver10/schedule/wsdl":         "schedule",
+	"http://www.onvif.org/ver10/thermal/wsdl":          "thermal",
+	"http://www.onvif.org/ver10/advancedsecurity/wsdl": "advancedsecurity",
+	"http://www.onvif.org/ver10/provisioning/wsdl":     "provisioning",
+}
+
+// getServices add the endpoints of GetServices response, unknown namespaces are skipped
+func (dev *Device) getServices(ctx context.Context) error {
+	services := device.GetServicesResponse{}
+	if err := dev.CreateRequest(device.GetServices{}).WithContext(ctx).Do().Unmarshal(&services); err != nil {
+		return err
+	}
+
+	for _, service := range services.Service {
+		if key, ok := serviceNamespaces[string(service.Namespace)]; ok && len(service.XAddr) > 0 {
+			dev.addEndpoint(key, string(service.XAddr))
+		}
+	}
+
+	return nil
+}
+
 // lookup scopes by path ./Body/ProbeMatches/ProbeMatch/Scopes
 // ex: <d:Scopes>onvif://www.onvif.org/type/video_encoder onvif://www.onvif.org/hardware/DS-2CD2042WD-I onvif://www.onvif.org/name/HIKVISION%20DS-2CD2042WD-I</d:Scopes>
 func (dev *Device) lookupScopes(doc *etree.Document) {
@@ -255,6 +300,7 @@ func NewDevice(params DeviceParams) *Device {
 	dev := Device{
 		params:    params,
 		endpoints: make(map[string]string),
+		auth:      networking.NewAuth(params.Auth),
 	}
 
 	dev.addEndpoint("Device", "http://"+dev.params.Xaddr+"/onvif/device_service")
@@ -269,7 +315,8 @@ func NewDevice(params DeviceParams) *Device {
 func (dev *Device) CreateRequest(method interface{}) *networking.Request {
 	return networking.NewRequest(dev, method).
 		WithHttpClient(dev.params.HttpClient).
-		WithUsernamePassword(dev.params.Username, dev.params.Password)
+		WithUsernamePassword(dev.params.Username, dev.params.Password).
+		WithAuth(dev.auth)
 }
 
 func (dev *Device) Inspect() (*device.GetCapabilitiesResponse, error) {
@@ -291,6 +338,10 @@ func (dev *Device) inspect(ctx context.Context) (*device.GetCapabilitiesResponse
 		return nil, resp.Error()
 	}
 
+	if fault := resp.Fault(); fault != nil {
+		return nil, fault
+	}
+
 	if !resp.StatusOK() {
 		return nil, errors.New("camera is not available at " + dev.params.Xaddr + " or it does not support ONVIF services")
 	}
@@ -304,6 +355,9 @@ func (dev *Device) inspect(ctx context.Context) (*device.GetCapabilitiesResponse
 		return nil, err
 	}
 
+	// GetServices is optional for the old devices, but it is the only way to find media2 and the other ver20 services
+	_ = dev.getServices(ctx)
+
 	capabilitiesResponse := device.GetCapabilitiesResponse{}
 	if err = resp.Unmarshal(&capabilitiesResponse); err != nil {
 		return nil, err
@@ -387,23 +441,16 @@ func (dev *Device) addEndpoint(Key, Value string) {
 	dev.endpoints[lowCaseKey] = Value
 }
 
-//getEndpoint functions get the target service endpoint in a better way
+// GetEndpoint return the url of the service endpoint by its key, ex: media, media2, events.
+// The key is matched exactly, as media is a prefix of media2, except the plural keys:
+// the event package requests are served by the events endpoint.
 func (dev Device) GetEndpoint(endpoint string) (string, error) {
-
-	// common condition, endpointMark in map we use this.
-	if endpointURL, bFound := dev.endpoints[endpoint]; bFound {
+	endpoint = strings.ToLower(endpoint)
+	if endpointURL, ok := dev.endpoints[endpoint]; ok {
 		return endpointURL, nil
 	}
-
-	//but ,if we have endpoint like event、analytic
-	//and sametime the Targetkey like : events、analytics
-	//we use fuzzy way to find the best match url
-	var endpointURL string
-	for targetKey := range dev.endpoints {
-		if strings.Contains(targetKey, endpoint) {
-			endpointURL = dev.endpoints[targetKey]
-			return endpointURL, nil
-		}
+	if endpointURL, ok := dev.endpoints[endpoint+"s"]; ok {
+		return endpointURL, nil
 	}
-	return endpointURL, errors.New("target endpoint service not found")
+	return "", errors.New("target endpoint service not found")
 }
@@ -0,0 +1,45 @@
+package onvif
+
+import "testing"
+
+func TestGetEndpoint(t *testing.T) {
+	dev := &Device{params: DeviceParams{Xaddr: "192.168.1.10"}, endpoints: map[string]string{}}
+	for key, url := range map[string]string{
+		"Device":          "http://192.168.1.10/onvif/device_service",
+		"Media":           "http://192.168.1.10/onvif/media_service",
+		"media2":          "http://192.168.1.10/onvif/media2_service",
+		"Events":          "http://192.168.1.10/onvif/events_service",
+		"analyticsdevice": "http://192.168.1.10/onvif/analyticsdevice_service",
+	} {
+		dev.addEndpoint(key, url)
+	}
+
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"media", "http://192.168.1.10/onvif/media_service"},
+		{"Media", "http://192.168.1.10/onvif/media_service"},
+		{"media2", "http://192.168.1.10/onvif/media2_service"},
+		{"device", "http://192.168.1.10/onvif/device_service"},
+		{"event", "http://192.168.1.10/onvif/events_service"},
+		{"events", "http://192.168.1.10/onvif/events_service"},
+		{"analyticsdevice", "http://192.168.1.10/onvif/analyticsdevice_service"},
+		// the services the device does not expose are not matched by the prefix
+		{"analytics", ""},
+		{"ptz", ""},
+		{"me", ""},
+	}
+	for _, tt := range tests {
+		got, err := dev.GetEndpoint(tt.endpoint)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("GetEndpoint(%q) = %q, want error", tt.endpoint, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("GetEndpoint(%q) = %q, %v, want %q", tt.endpoint, got, err, tt.want)
+		}
+	}
+}
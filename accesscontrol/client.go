@@ -0,0 +1,103 @@
+package accesscontrol
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the AccessControl service (tac): access points and areas.
+// The device must expose the accesscontrol endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	// PageSize is the Limit of the list requests of the GetAll methods, 0 means the device default
+	PageSize int
+
+	dev networking.RequestCreator
+}
+
+// NewClient return AccessControl service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the access control service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (ServiceCapabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetAccessPointInfoList return a page of the access points and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetAccessPointInfoList(ctx context.Context, limit int, startReference string) ([]AccessPointInfo, string, error) {
+	resp := GetAccessPointInfoListResponse{}
+	if err := c.call(ctx, GetAccessPointInfoList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.AccessPointInfo, resp.NextStartReference, nil
+}
+
+// GetAllAccessPointInfo return the access points info of all pages, see PageSize
+func (c *Client) GetAllAccessPointInfo(ctx context.Context) ([]AccessPointInfo, error) {
+	return networking.GetAll(ctx, c.GetAccessPointInfoList, c.PageSize)
+}
+
+// GetAccessPointInfo return the access points by tokens
+func (c *Client) GetAccessPointInfo(ctx context.Context, tokens ...onvif.ReferenceToken) ([]AccessPointInfo, error) {
+	resp := GetAccessPointInfoResponse{}
+	if err := c.call(ctx, GetAccessPointInfo{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.AccessPointInfo, nil
+}
+
+// GetAreaInfoList return a page of the areas and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetAreaInfoList(ctx context.Context, limit int, startReference string) ([]AreaInfo, string, error) {
+	resp := GetAreaInfoListResponse{}
+	if err := c.call(ctx, GetAreaInfoList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.AreaInfo, resp.NextStartReference, nil
+}
+
+// GetAllAreaInfo return the areas info of all pages, see PageSize
+func (c *Client) GetAllAreaInfo(ctx context.Context) ([]AreaInfo, error) {
+	return networking.GetAll(ctx, c.GetAreaInfoList, c.PageSize)
+}
+
+// GetAreaInfo return the areas by tokens
+func (c *Client) GetAreaInfo(ctx context.Context, tokens ...onvif.ReferenceToken) ([]AreaInfo, error) {
+	resp := GetAreaInfoResponse{}
+	if err := c.call(ctx, GetAreaInfo{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.AreaInfo, nil
+}
+
+// GetAccessPointState return state of the access point
+func (c *Client) GetAccessPointState(ctx context.Context, token onvif.ReferenceToken) (AccessPointState, error) {
+	resp := GetAccessPointStateResponse{}
+	err := c.call(ctx, GetAccessPointState{Token: token}, &resp)
+	return resp.AccessPointState, err
+}
+
+// EnableAccessPoint enable the access point
+func (c *Client) EnableAccessPoint(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, EnableAccessPoint{Token: token}, &EnableAccessPointResponse{})
+}
+
+// DisableAccessPoint disable the access point, it denies all the requests
+func (c *Client) DisableAccessPoint(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DisableAccessPoint{Token: token}, &DisableAccessPointResponse{})
+}
+
+// ExternalAuthorization send the decision (DecisionGranted or DecisionDenied) of the external authorization request
+func (c *Client) ExternalAuthorization(ctx context.Context, request ExternalAuthorization) error {
+	return c.call(ctx, request, &ExternalAuthorizationResponse{})
+}
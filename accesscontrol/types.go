@@ -0,0 +1,133 @@
+package accesscontrol
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Decisions of ExternalAuthorization (tac:Decision)
+const (
+	DecisionGranted = "Granted"
+	DecisionDenied  = "Denied"
+)
+
+type ServiceCapabilities struct {
+	//MaxLimit is the max number of items the device returns in one GetXxxList response
+	MaxLimit uint32 `xml:"MaxLimit,attr"`
+}
+
+type AccessPointCapabilities struct {
+	DisableAccessPoint    bool `xml:"DisableAccessPoint,attr"`
+	Duress                bool `xml:"Duress,attr"`
+	AnonymousAccess       bool `xml:"AnonymousAccess,attr"`
+	AccessTaken           bool `xml:"AccessTaken,attr"`
+	ExternalAuthorization bool `xml:"ExternalAuthorization,attr"`
+}
+
+type AccessPointInfo struct {
+	Token       onvif.ReferenceToken `xml:"token,attr"`
+	Name        onvif.Name
+	Description xsd.String
+	AreaFrom    onvif.ReferenceToken
+	AreaTo      onvif.ReferenceToken
+	//EntityType is the type of Entity, tdc:Door by default
+	EntityType   xsd.QName
+	Entity       onvif.ReferenceToken
+	Capabilities AccessPointCapabilities
+}
+
+type AreaInfo struct {
+	Token       onvif.ReferenceToken `xml:"token,attr"`
+	Name        onvif.Name
+	Description xsd.String
+}
+
+type AccessPointState struct {
+	Enabled bool
+}
+
+//AccessControl main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tac:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetAccessPointInfoList struct {
+	XMLName        string `xml:"tac:GetAccessPointInfoList"`
+	Limit          int    `xml:"tac:Limit,omitempty"`
+	StartReference string `xml:"tac:StartReference,omitempty"`
+}
+
+type GetAccessPointInfoListResponse struct {
+	NextStartReference string
+	AccessPointInfo    []AccessPointInfo
+}
+
+type GetAccessPointInfo struct {
+	XMLName string                 `xml:"tac:GetAccessPointInfo"`
+	Token   []onvif.ReferenceToken `xml:"tac:Token"`
+}
+
+type GetAccessPointInfoResponse struct {
+	AccessPointInfo []AccessPointInfo
+}
+
+type GetAreaInfoList struct {
+	XMLName        string `xml:"tac:GetAreaInfoList"`
+	Limit          int    `xml:"tac:Limit,omitempty"`
+	StartReference string `xml:"tac:StartReference,omitempty"`
+}
+
+type GetAreaInfoListResponse struct {
+	NextStartReference string
+	AreaInfo           []AreaInfo
+}
+
+type GetAreaInfo struct {
+	XMLName string                 `xml:"tac:GetAreaInfo"`
+	Token   []onvif.ReferenceToken `xml:"tac:Token"`
+}
+
+type GetAreaInfoResponse struct {
+	AreaInfo []AreaInfo
+}
+
+type GetAccessPointState struct {
+	XMLName string               `xml:"tac:GetAccessPointState"`
+	Token   onvif.ReferenceToken `xml:"tac:Token"`
+}
+
+type GetAccessPointStateResponse struct {
+	AccessPointState AccessPointState
+}
+
+type EnableAccessPoint struct {
+	XMLName string               `xml:"tac:EnableAccessPoint"`
+	Token   onvif.ReferenceToken `xml:"tac:Token"`
+}
+
+type EnableAccessPointResponse struct {
+}
+
+type DisableAccessPoint struct {
+	XMLName string               `xml:"tac:DisableAccessPoint"`
+	Token   onvif.ReferenceToken `xml:"tac:Token"`
+}
+
+type DisableAccessPointResponse struct {
+}
+
+type ExternalAuthorization struct {
+	XMLName          string               `xml:"tac:ExternalAuthorization"`
+	AccessPointToken onvif.ReferenceToken `xml:"tac:AccessPointToken"`
+	CredentialToken  onvif.ReferenceToken `xml:"tac:CredentialToken,omitempty"`
+	Reason           string               `xml:"tac:Reason,omitempty"`
+	Decision         string               `xml:"tac:Decision"`
+}
+
+type ExternalAuthorizationResponse struct {
+}
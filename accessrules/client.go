@@ -0,0 +1,98 @@
+package accessrules
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the AccessRules service (tar): access profiles and policies.
+// The device must expose the accessrules endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	// PageSize is the Limit of the list requests of the GetAll methods, 0 means the device default
+	PageSize int
+
+	dev networking.RequestCreator
+}
+
+// NewClient return AccessRules service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the access rules service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (ServiceCapabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetAccessProfileInfo return the access profiles info by tokens
+func (c *Client) GetAccessProfileInfo(ctx context.Context, tokens ...onvif.ReferenceToken) ([]AccessProfileInfo, error) {
+	resp := GetAccessProfileInfoResponse{}
+	if err := c.call(ctx, GetAccessProfileInfo{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.AccessProfileInfo, nil
+}
+
+// GetAccessProfileInfoList return a page of the access profiles info and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetAccessProfileInfoList(ctx context.Context, limit int, startReference string) ([]AccessProfileInfo, string, error) {
+	resp := GetAccessProfileInfoListResponse{}
+	if err := c.call(ctx, GetAccessProfileInfoList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.AccessProfileInfo, resp.NextStartReference, nil
+}
+
+// GetAllAccessProfileInfo return the access profiles info of all pages, see PageSize
+func (c *Client) GetAllAccessProfileInfo(ctx context.Context) ([]AccessProfileInfo, error) {
+	return networking.GetAll(ctx, c.GetAccessProfileInfoList, c.PageSize)
+}
+
+// GetAccessProfiles return the access profiles by tokens
+func (c *Client) GetAccessProfiles(ctx context.Context, tokens ...onvif.ReferenceToken) ([]AccessProfile, error) {
+	resp := GetAccessProfilesResponse{}
+	if err := c.call(ctx, GetAccessProfiles{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.AccessProfile, nil
+}
+
+// GetAccessProfileList return a page of the access profiles and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetAccessProfileList(ctx context.Context, limit int, startReference string) ([]AccessProfile, string, error) {
+	resp := GetAccessProfileListResponse{}
+	if err := c.call(ctx, GetAccessProfileList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.AccessProfile, resp.NextStartReference, nil
+}
+
+// GetAllAccessProfiles return the access profiles of all pages, see PageSize
+func (c *Client) GetAllAccessProfiles(ctx context.Context) ([]AccessProfile, error) {
+	return networking.GetAll(ctx, c.GetAccessProfileList, c.PageSize)
+}
+
+// CreateAccessProfile create the access profile and return its token
+func (c *Client) CreateAccessProfile(ctx context.Context, profile AccessProfile) (onvif.ReferenceToken, error) {
+	resp := CreateAccessProfileResponse{}
+	err := c.call(ctx, CreateAccessProfile{AccessProfile: profile}, &resp)
+	return resp.Token, err
+}
+
+// ModifyAccessProfile replace the access profile by token
+func (c *Client) ModifyAccessProfile(ctx context.Context, profile AccessProfile) error {
+	return c.call(ctx, ModifyAccessProfile{AccessProfile: profile}, &ModifyAccessProfileResponse{})
+}
+
+// DeleteAccessProfile delete the access profile
+func (c *Client) DeleteAccessProfile(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteAccessProfile{Token: token}, &DeleteAccessProfileResponse{})
+}
@@ -0,0 +1,116 @@
+package accessrules
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+type ServiceCapabilities struct {
+	//MaxLimit is the max number of items the device returns in one GetXxxList response
+	MaxLimit                                 uint32 `xml:"MaxLimit,attr"`
+	MaxAccessProfiles                        uint32 `xml:"MaxAccessProfiles,attr"`
+	MaxAccessPoliciesPerAccessProfile        uint32 `xml:"MaxAccessPoliciesPerAccessProfile,attr"`
+	MultipleSchedulesPerAccessPointSupported bool   `xml:"MultipleSchedulesPerAccessPointSupported,attr"`
+}
+
+// AccessPolicy grants access to Entity (an access point by default) during the schedule
+type AccessPolicy struct {
+	ScheduleToken onvif.ReferenceToken `xml:"http://www.onvif.org/ver10/accessrules/wsdl ScheduleToken"`
+	Entity        onvif.ReferenceToken `xml:"http://www.onvif.org/ver10/accessrules/wsdl Entity"`
+	EntityType    xsd.QName            `xml:"http://www.onvif.org/ver10/accessrules/wsdl EntityType,omitempty"`
+}
+
+// Deprecated: use AccessPolicy, it decodes the responses too.
+type AccessPolicyResponse = AccessPolicy
+
+type AccessProfileInfo struct {
+	Token       onvif.ReferenceToken `xml:"token,attr"`
+	Name        onvif.Name
+	Description xsd.String
+}
+
+// AccessProfile the token is empty in CreateAccessProfile, the device assigns it
+type AccessProfile struct {
+	Token        onvif.ReferenceToken `xml:"token,attr"`
+	Name         onvif.Name           `xml:"http://www.onvif.org/ver10/accessrules/wsdl Name"`
+	Description  xsd.String           `xml:"http://www.onvif.org/ver10/accessrules/wsdl Description,omitempty"`
+	AccessPolicy []AccessPolicy       `xml:"http://www.onvif.org/ver10/accessrules/wsdl AccessPolicy,omitempty"`
+}
+
+// Deprecated: use AccessProfile, it decodes the responses too.
+type AccessProfileResponse = AccessProfile
+
+//AccessRules main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tar:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetAccessProfileInfo struct {
+	XMLName string                 `xml:"tar:GetAccessProfileInfo"`
+	Token   []onvif.ReferenceToken `xml:"tar:Token"`
+}
+
+type GetAccessProfileInfoResponse struct {
+	AccessProfileInfo []AccessProfileInfo
+}
+
+type GetAccessProfileInfoList struct {
+	XMLName        string `xml:"tar:GetAccessProfileInfoList"`
+	Limit          int    `xml:"tar:Limit,omitempty"`
+	StartReference string `xml:"tar:StartReference,omitempty"`
+}
+
+type GetAccessProfileInfoListResponse struct {
+	NextStartReference string
+	AccessProfileInfo  []AccessProfileInfo
+}
+
+type GetAccessProfiles struct {
+	XMLName string                 `xml:"tar:GetAccessProfiles"`
+	Token   []onvif.ReferenceToken `xml:"tar:Token"`
+}
+
+type GetAccessProfilesResponse struct {
+	AccessProfile []AccessProfile
+}
+
+type GetAccessProfileList struct {
+	XMLName        string `xml:"tar:GetAccessProfileList"`
+	Limit          int    `xml:"tar:Limit,omitempty"`
+	StartReference string `xml:"tar:StartReference,omitempty"`
+}
+
+type GetAccessProfileListResponse struct {
+	NextStartReference string
+	AccessProfile      []AccessProfile
+}
+
+type CreateAccessProfile struct {
+	XMLName       string        `xml:"tar:CreateAccessProfile"`
+	AccessProfile AccessProfile `xml:"tar:AccessProfile"`
+}
+
+type CreateAccessProfileResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type ModifyAccessProfile struct {
+	XMLName       string        `xml:"tar:ModifyAccessProfile"`
+	AccessProfile AccessProfile `xml:"tar:AccessProfile"`
+}
+
+type ModifyAccessProfileResponse struct {
+}
+
+type DeleteAccessProfile struct {
+	XMLName string               `xml:"tar:DeleteAccessProfile"`
+	Token   onvif.ReferenceToken `xml:"tar:Token"`
+}
+
+type DeleteAccessProfileResponse struct {
+}
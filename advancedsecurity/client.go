@@ -0,0 +1,438 @@
+package advancedsecurity
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+// Client is a typed client of the AdvancedSecurity service (tas): keystore, TLS server and 802.1X.
+// The keys, certificates and CSRs are exchanged as crypto and crypto/x509 types.
+// The device must expose the advancedsecurity endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return AdvancedSecurity service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the keystore, TLS server and 802.1X
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// CreateRSAKeyPair start the key pair generation on the device and return the key id and the estimated creation time,
+// the key may be used after GetKeyStatus reports KeyStatusOK
+func (c *Client) CreateRSAKeyPair(ctx context.Context, keyLength int, alias string) (KeyID, time.Duration, error) {
+	resp := CreateRSAKeyPairResponse{}
+	if err := c.call(ctx, CreateRSAKeyPair{KeyLength: keyLength, Alias: alias}, &resp); err != nil {
+		return "", 0, err
+	}
+	if resp.EstimatedCreationTime == "" {
+		return resp.KeyID, 0, nil
+	}
+	estimated, err := resp.EstimatedCreationTime.Duration()
+	return resp.KeyID, estimated, err
+}
+
+// UploadKeyPairInPKCS8 upload the private key (*rsa.PrivateKey usually) unencrypted in PKCS #8
+func (c *Client) UploadKeyPairInPKCS8(ctx context.Context, key crypto.PrivateKey, alias string) (KeyID, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	resp := UploadKeyPairInPKCS8Response{}
+	err = c.call(ctx, UploadKeyPairInPKCS8{KeyPair: encodeBase64(der), Alias: alias}, &resp)
+	return resp.KeyID, err
+}
+
+// UploadPKCS12 upload the PKCS #12 (pfx) certification path with the private key protected by the passphrase
+// and return the ids of the created certification path and key. The standard library can't encode PKCS #12,
+// use an encoder like software.sslmate.com/src/go-pkcs12 to make pfx of []*x509.Certificate and crypto.PrivateKey.
+func (c *Client) UploadPKCS12(ctx context.Context, pfx []byte, passphrase, certificationPathAlias, keyAlias string) (CertificationPathID, KeyID, error) {
+	return c.UploadCertificateWithPrivateKeyInPKCS12(ctx, UploadCertificateWithPrivateKeyInPKCS12{
+		CertWithPrivateKey:     encodeBase64(pfx),
+		CertificationPathAlias: certificationPathAlias,
+		KeyAlias:               keyAlias,
+		Passphrase:             passphrase,
+	})
+}
+
+// UploadCertificateWithPrivateKeyInPKCS12 upload the PKCS #12 (pfx) certificate with the private key,
+// the request is given as is for the passphrases already uploaded to the device, see UploadPKCS12 otherwise
+func (c *Client) UploadCertificateWithPrivateKeyInPKCS12(ctx context.Context, request UploadCertificateWithPrivateKeyInPKCS12) (CertificationPathID, KeyID, error) {
+	resp := UploadCertificateWithPrivateKeyInPKCS12Response{}
+	err := c.call(ctx, request, &resp)
+	return resp.CertificationPathID, resp.KeyID, err
+}
+
+// GetKeyStatus return the status of the key, see KeyStatusOK
+func (c *Client) GetKeyStatus(ctx context.Context, keyID KeyID) (string, error) {
+	resp := GetKeyStatusResponse{}
+	err := c.call(ctx, GetKeyStatus{KeyID: keyID}, &resp)
+	return resp.KeyStatus, err
+}
+
+// GetPrivateKeyStatus return whether the key has the private key
+func (c *Client) GetPrivateKeyStatus(ctx context.Context, keyID KeyID) (bool, error) {
+	resp := GetPrivateKeyStatusResponse{}
+	err := c.call(ctx, GetPrivateKeyStatus{KeyID: keyID}, &resp)
+	return resp.HasPrivateKey, err
+}
+
+// GetAllKeys return the attributes of all keys of the keystore
+func (c *Client) GetAllKeys(ctx context.Context) ([]KeyAttribute, error) {
+	resp := GetAllKeysResponse{}
+	if err := c.call(ctx, GetAllKeys{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.KeyAttribute, nil
+}
+
+// DeleteKey delete the key, it must not be used by a certificate
+func (c *Client) DeleteKey(ctx context.Context, keyID KeyID) error {
+	return c.call(ctx, DeleteKey{KeyID: keyID}, &DeleteKeyResponse{})
+}
+
+// CreatePKCS10CSR return the certificate request of the key signed by the device,
+// sign it with your CA and upload the certificate with UploadCertificate
+func (c *Client) CreatePKCS10CSR(ctx context.Context, keyID KeyID, subject pkix.Name, algorithm x509.SignatureAlgorithm, extensions ...pkix.Extension) (*x509.CertificateRequest, error) {
+	signature, err := NewAlgorithmIdentifier(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	request := CreatePKCS10CSR{
+		Subject:            NewDistinguishedName(subject),
+		KeyID:              keyID,
+		SignatureAlgorithm: signature,
+	}
+	for _, extension := range extensions {
+		request.CSRAttribute = append(request.CSRAttribute, NewCSRAttribute(extension))
+	}
+
+	resp := CreatePKCS10CSRResponse{}
+	if err := c.call(ctx, request, &resp); err != nil {
+		return nil, err
+	}
+	der, err := decodeBase64(resp.PKCS10CSR)
+	if err != nil {
+		return nil, fmt.Errorf("advancedsecurity: csr: %w", err)
+	}
+	return x509.ParseCertificateRequest(der)
+}
+
+// CreateSelfSignedCertificate create the certificate of the key signed by itself, zero notBefore or notAfter means the device default
+func (c *Client) CreateSelfSignedCertificate(ctx context.Context, keyID KeyID, subject pkix.Name, algorithm x509.SignatureAlgorithm, alias string, notBefore, notAfter time.Time, extensions ...pkix.Extension) (CertificateID, error) {
+	signature, err := NewAlgorithmIdentifier(algorithm)
+	if err != nil {
+		return "", err
+	}
+	request := CreateSelfSignedCertificate{
+		Subject:            NewDistinguishedName(subject),
+		KeyID:              keyID,
+		Alias:              alias,
+		SignatureAlgorithm: signature,
+	}
+	if !notBefore.IsZero() {
+		request.NotValidBefore = xsd.NewDateTime(notBefore)
+	}
+	if !notAfter.IsZero() {
+		request.NotValidAfter = xsd.NewDateTime(notAfter)
+	}
+	for _, extension := range extensions {
+		request.Extension = append(request.Extension, NewX509v3Extension(extension))
+	}
+
+	resp := CreateSelfSignedCertificateResponse{}
+	err = c.call(ctx, request, &resp)
+	return resp.CertificateID, err
+}
+
+// UploadCertificate upload the certificate and return its id and the id of its public key.
+// The key is found in the keystore or created with keyAlias, privateKeyRequired fails the upload if the keystore hasn't the private key.
+func (c *Client) UploadCertificate(ctx context.Context, certificate *x509.Certificate, alias, keyAlias string, privateKeyRequired bool) (CertificateID, KeyID, error) {
+	resp := UploadCertificateResponse{}
+	err := c.call(ctx, UploadCertificate{
+		Certificate:        encodeBase64(certificate.Raw),
+		Alias:              alias,
+		KeyAlias:           keyAlias,
+		PrivateKeyRequired: privateKeyRequired,
+	}, &resp)
+	return resp.CertificateID, resp.KeyID, err
+}
+
+// GetCertificate return the certificate of the keystore, the certificate which can't be parsed
+// is returned with Raw and the error
+func (c *Client) GetCertificate(ctx context.Context, certificateID CertificateID) (Certificate, error) {
+	resp := GetCertificateResponse{}
+	if err := c.call(ctx, GetCertificate{CertificateID: certificateID}, &resp); err != nil {
+		return Certificate{}, err
+	}
+	return parseCertificate(resp.Certificate)
+}
+
+// GetAllCertificates return all certificates of the keystore. The certificates which can't be parsed
+// are returned with Raw and nil Certificate, their errors are listed by *CertificatesError.
+func (c *Client) GetAllCertificates(ctx context.Context) ([]Certificate, error) {
+	resp := GetAllCertificatesResponse{}
+	if err := c.call(ctx, GetAllCertificates{}, &resp); err != nil {
+		return nil, err
+	}
+	certificates := make([]Certificate, 0, len(resp.Certificate))
+	var errs []error
+	for _, raw := range resp.Certificate {
+		certificate, err := parseCertificate(raw)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		certificates = append(certificates, certificate)
+	}
+	if len(errs) > 0 {
+		return certificates, &CertificatesError{Errors: errs}
+	}
+	return certificates, nil
+}
+
+// DeleteCertificate delete the certificate, it must not be used by a certification path
+func (c *Client) DeleteCertificate(ctx context.Context, certificateID CertificateID) error {
+	return c.call(ctx, DeleteCertificate{CertificateID: certificateID}, &DeleteCertificateResponse{})
+}
+
+// CreateCertificationPath create the chain of the certificates, starting from the end entity (server) certificate to the CA
+func (c *Client) CreateCertificationPath(ctx context.Context, alias string, certificateIDs ...CertificateID) (CertificationPathID, error) {
+	resp := CreateCertificationPathResponse{}
+	err := c.call(ctx, CreateCertificationPath{CertificateIDs: CertificateIDs{CertificateID: certificateIDs}, Alias: alias}, &resp)
+	return resp.CertificationPathID, err
+}
+
+// GetCertificationPath return the certificates ids of the certification path
+func (c *Client) GetCertificationPath(ctx context.Context, certificationPathID CertificationPathID) (CertificationPath, error) {
+	resp := GetCertificationPathResponse{}
+	err := c.call(ctx, GetCertificationPath{CertificationPathID: certificationPathID}, &resp)
+	return resp.CertificationPath, err
+}
+
+// GetAllCertificationPaths return ids of all certification paths
+func (c *Client) GetAllCertificationPaths(ctx context.Context) ([]CertificationPathID, error) {
+	resp := GetAllCertificationPathsResponse{}
+	if err := c.call(ctx, GetAllCertificationPaths{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CertificationPathID, nil
+}
+
+// DeleteCertificationPath delete the certification path, it must not be assigned to the TLS server
+func (c *Client) DeleteCertificationPath(ctx context.Context, certificationPathID CertificationPathID) error {
+	return c.call(ctx, DeleteCertificationPath{CertificationPathID: certificationPathID}, &DeleteCertificationPathResponse{})
+}
+
+// UploadPassphrase upload the passphrase of the encrypted keys and the 802.1X
+func (c *Client) UploadPassphrase(ctx context.Context, passphrase, alias string) (PassphraseID, error) {
+	resp := UploadPassphraseResponse{}
+	err := c.call(ctx, UploadPassphrase{Passphrase: passphrase, PassphraseAlias: alias}, &resp)
+	return resp.PassphraseID, err
+}
+
+// GetAllPassphrases return ids and aliases of all passphrases
+func (c *Client) GetAllPassphrases(ctx context.Context) ([]PassphraseAttribute, error) {
+	resp := GetAllPassphrasesResponse{}
+	if err := c.call(ctx, GetAllPassphrases{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PassphraseAttribute, nil
+}
+
+// DeletePassphrase delete the passphrase
+func (c *Client) DeletePassphrase(ctx context.Context, passphraseID PassphraseID) error {
+	return c.call(ctx, DeletePassphrase{PassphraseID: passphraseID}, &DeletePassphraseResponse{})
+}
+
+// AddServerCertificateAssignment assign the certification path to the TLS server
+func (c *Client) AddServerCertificateAssignment(ctx context.Context, certificationPathID CertificationPathID) error {
+	return c.call(ctx, AddServerCertificateAssignment{CertificationPathID: certificationPathID}, &AddServerCertificateAssignmentResponse{})
+}
+
+// RemoveServerCertificateAssignment remove the certification path from the TLS server
+func (c *Client) RemoveServerCertificateAssignment(ctx context.Context, certificationPathID CertificationPathID) error {
+	return c.call(ctx, RemoveServerCertificateAssignment{CertificationPathID: certificationPathID}, &RemoveServerCertificateAssignmentResponse{})
+}
+
+// ReplaceServerCertificateAssignment replace the certification path of the TLS server in one step,
+// the HTTPS connection is not lost between remove and add
+func (c *Client) ReplaceServerCertificateAssignment(ctx context.Context, oldCertificationPathID, newCertificationPathID CertificationPathID) error {
+	return c.call(ctx, ReplaceServerCertificateAssignment{
+		OldCertificationPathID: oldCertificationPathID,
+		NewCertificationPathID: newCertificationPathID,
+	}, &ReplaceServerCertificateAssignmentResponse{})
+}
+
+// GetAssignedServerCertificates return ids of the certification paths of the TLS server
+func (c *Client) GetAssignedServerCertificates(ctx context.Context) ([]CertificationPathID, error) {
+	resp := GetAssignedServerCertificatesResponse{}
+	if err := c.call(ctx, GetAssignedServerCertificates{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CertificationPathID, nil
+}
+
+// UploadCRL upload the DER encoded certificate revocation list, ex: the result of x509.CreateRevocationList
+func (c *Client) UploadCRL(ctx context.Context, der []byte, alias string) (CRLID, error) {
+	resp := UploadCRLResponse{}
+	err := c.call(ctx, UploadCRL{Crl: encodeBase64(der), Alias: alias}, &resp)
+	return resp.CrlID, err
+}
+
+// GetCRL return the certificate revocation list, see CRL.DER
+func (c *Client) GetCRL(ctx context.Context, crlID CRLID) (CRL, error) {
+	resp := GetCRLResponse{}
+	err := c.call(ctx, GetCRL{CrlID: crlID}, &resp)
+	return resp.Crl, err
+}
+
+// GetAllCRLs return all certificate revocation lists
+func (c *Client) GetAllCRLs(ctx context.Context) ([]CRL, error) {
+	resp := GetAllCRLsResponse{}
+	if err := c.call(ctx, GetAllCRLs{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Crl, nil
+}
+
+// DeleteCRL delete the certificate revocation list
+func (c *Client) DeleteCRL(ctx context.Context, crlID CRLID) error {
+	return c.call(ctx, DeleteCRL{CrlID: crlID}, &DeleteCRLResponse{})
+}
+
+// CreateCertPathValidationPolicy create the policy validating the TLS client certificates by the trust anchors (CA certificates)
+func (c *Client) CreateCertPathValidationPolicy(ctx context.Context, alias string, parameters CertPathValidationParameters, trustAnchors ...CertificateID) (CertPathValidationPolicyID, error) {
+	request := CreateCertPathValidationPolicy{Alias: alias, Parameters: parameters}
+	for _, id := range trustAnchors {
+		request.TrustAnchor = append(request.TrustAnchor, TrustAnchor{CertificateID: id})
+	}
+	resp := CreateCertPathValidationPolicyResponse{}
+	err := c.call(ctx, request, &resp)
+	return resp.CertPathValidationPolicyID, err
+}
+
+// GetCertPathValidationPolicy return the certification path validation policy
+func (c *Client) GetCertPathValidationPolicy(ctx context.Context, policyID CertPathValidationPolicyID) (CertPathValidationPolicy, error) {
+	resp := GetCertPathValidationPolicyResponse{}
+	err := c.call(ctx, GetCertPathValidationPolicy{CertPathValidationPolicyID: policyID}, &resp)
+	return resp.CertPathValidationPolicy, err
+}
+
+// GetAllCertPathValidationPolicies return all certification path validation policies
+func (c *Client) GetAllCertPathValidationPolicies(ctx context.Context) ([]CertPathValidationPolicy, error) {
+	resp := GetAllCertPathValidationPoliciesResponse{}
+	if err := c.call(ctx, GetAllCertPathValidationPolicies{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CertPathValidationPolicy, nil
+}
+
+// DeleteCertPathValidationPolicy delete the certification path validation policy
+func (c *Client) DeleteCertPathValidationPolicy(ctx context.Context, policyID CertPathValidationPolicyID) error {
+	return c.call(ctx, DeleteCertPathValidationPolicy{CertPathValidationPolicyID: policyID}, &DeleteCertPathValidationPolicyResponse{})
+}
+
+// SetClientAuthenticationRequired enable or disable the TLS client authentication
+func (c *Client) SetClientAuthenticationRequired(ctx context.Context, required bool) error {
+	return c.call(ctx, SetClientAuthenticationRequired{ClientAuthenticationRequired: required}, &SetClientAuthenticationRequiredResponse{})
+}
+
+// GetClientAuthenticationRequired return whether the TLS client authentication is enabled
+func (c *Client) GetClientAuthenticationRequired(ctx context.Context) (bool, error) {
+	resp := GetClientAuthenticationRequiredResponse{}
+	err := c.call(ctx, GetClientAuthenticationRequired{}, &resp)
+	return resp.ClientAuthenticationRequired, err
+}
+
+// AddCertPathValidationPolicyAssignment assign the policy to the TLS server client authentication
+func (c *Client) AddCertPathValidationPolicyAssignment(ctx context.Context, policyID CertPathValidationPolicyID) error {
+	return c.call(ctx, AddCertPathValidationPolicyAssignment{CertPathValidationPolicyID: policyID}, &AddCertPathValidationPolicyAssignmentResponse{})
+}
+
+// RemoveCertPathValidationPolicyAssignment remove the policy from the TLS server client authentication
+func (c *Client) RemoveCertPathValidationPolicyAssignment(ctx context.Context, policyID CertPathValidationPolicyID) error {
+	return c.call(ctx, RemoveCertPathValidationPolicyAssignment{CertPathValidationPolicyID: policyID}, &RemoveCertPathValidationPolicyAssignmentResponse{})
+}
+
+// ReplaceCertPathValidationPolicyAssignment replace the policy of the TLS server client authentication in one step
+func (c *Client) ReplaceCertPathValidationPolicyAssignment(ctx context.Context, oldPolicyID, newPolicyID CertPathValidationPolicyID) error {
+	return c.call(ctx, ReplaceCertPathValidationPolicyAssignment{
+		OldCertPathValidationPolicyID: oldPolicyID,
+		NewCertPathValidationPolicyID: newPolicyID,
+	}, &ReplaceCertPathValidationPolicyAssignmentResponse{})
+}
+
+// GetAssignedCertPathValidationPolicies return ids of the policies of the TLS server client authentication
+func (c *Client) GetAssignedCertPathValidationPolicies(ctx context.Context) ([]CertPathValidationPolicyID, error) {
+	resp := GetAssignedCertPathValidationPoliciesResponse{}
+	if err := c.call(ctx, GetAssignedCertPathValidationPolicies{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CertPathValidationPolicyID, nil
+}
+
+// AddDot1XConfiguration add the 802.1X configuration and return its id
+func (c *Client) AddDot1XConfiguration(ctx context.Context, configuration Dot1XConfiguration) (Dot1XID, error) {
+	resp := AddDot1XConfigurationResponse{}
+	err := c.call(ctx, AddDot1XConfiguration{Dot1XConfiguration: configuration}, &resp)
+	return resp.Dot1XID, err
+}
+
+// GetAllDot1XConfigurations return all 802.1X configurations
+func (c *Client) GetAllDot1XConfigurations(ctx context.Context) ([]Dot1XConfiguration, error) {
+	resp := GetAllDot1XConfigurationsResponse{}
+	if err := c.call(ctx, GetAllDot1XConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configuration, nil
+}
+
+// GetDot1XConfiguration return the 802.1X configuration
+func (c *Client) GetDot1XConfiguration(ctx context.Context, dot1XID Dot1XID) (Dot1XConfiguration, error) {
+	resp := GetDot1XConfigurationResponse{}
+	err := c.call(ctx, GetDot1XConfiguration{Dot1XID: dot1XID}, &resp)
+	return resp.Dot1XConfiguration, err
+}
+
+// DeleteDot1XConfiguration delete the 802.1X configuration, it must not be used by a network interface
+func (c *Client) DeleteDot1XConfiguration(ctx context.Context, dot1XID Dot1XID) error {
+	return c.call(ctx, DeleteDot1XConfiguration{Dot1XID: dot1XID}, &DeleteDot1XConfigurationResponse{})
+}
+
+// SetNetworkInterfaceDot1XConfiguration set the 802.1X configuration of the network interface, return whether the reboot is needed
+func (c *Client) SetNetworkInterfaceDot1XConfiguration(ctx context.Context, interfaceToken string, dot1XID Dot1XID) (bool, error) {
+	resp := SetNetworkInterfaceDot1XConfigurationResponse{}
+	err := c.call(ctx, SetNetworkInterfaceDot1XConfiguration{Token: interfaceToken, Dot1XID: dot1XID}, &resp)
+	return resp.RebootNeeded, err
+}
+
+// GetNetworkInterfaceDot1XConfiguration return the 802.1X configuration id of the network interface, empty if 802.1X is disabled
+func (c *Client) GetNetworkInterfaceDot1XConfiguration(ctx context.Context, interfaceToken string) (Dot1XID, error) {
+	resp := GetNetworkInterfaceDot1XConfigurationResponse{}
+	err := c.call(ctx, GetNetworkInterfaceDot1XConfiguration{Token: interfaceToken}, &resp)
+	return resp.Dot1XID, err
+}
+
+// DeleteNetworkInterfaceDot1XConfiguration disable 802.1X of the network interface, return whether the reboot is needed
+func (c *Client) DeleteNetworkInterfaceDot1XConfiguration(ctx context.Context, interfaceToken string) (bool, error) {
+	resp := DeleteNetworkInterfaceDot1XConfigurationResponse{}
+	err := c.call(ctx, DeleteNetworkInterfaceDot1XConfiguration{Token: interfaceToken}, &resp)
+	return resp.RebootNeeded, err
+}
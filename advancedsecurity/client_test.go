@@ -0,0 +1,139 @@
+package advancedsecurity
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+)
+
+// testDevice answers every request with the content, the body of the last request is kept
+type testDevice struct {
+	*httptest.Server
+	content string
+	body    []byte
+}
+
+func newTestDevice(t *testing.T, content string) *testDevice {
+	d := &testDevice{content: content}
+	d.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		d.body, _ = io.ReadAll(r.Body)
+		io.WriteString(w, `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:tas="http://www.onvif.org/ver10/advancedsecurity/wsdl">`+
+			`<s:Body>`+d.content+`</s:Body></s:Envelope>`)
+	}))
+	t.Cleanup(d.Close)
+	return d
+}
+
+func (d *testDevice) GetEndpoint(string) (string, error) { return d.URL + "/onvif/advancedsecurity", nil }
+func (d *testDevice) DeltaTime() time.Duration           { return 0 }
+
+func (d *testDevice) CreateRequest(method interface{}) *networking.Request {
+	return networking.NewRequest(d, method)
+}
+
+func selfSigned(t *testing.T, commonName string) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func certificateXML(id, content string) string {
+	return `<tas:Certificate><tas:CertificateID>` + id + `</tas:CertificateID><tas:KeyID>key_` + id + `</tas:KeyID>` +
+		`<tas:Alias>` + id + `</tas:Alias><tas:CertificateContent>` + content + `</tas:CertificateContent></tas:Certificate>`
+}
+
+func TestGetAllCertificates(t *testing.T) {
+	server := selfSigned(t, "camera")
+	// the device wraps the base64 lines
+	wrapped := base64.StdEncoding.EncodeToString(server)
+	wrapped = wrapped[:64] + "\n" + wrapped[64:]
+
+	dev := newTestDevice(t, `<tas:GetAllCertificatesResponse>`+
+		certificateXML("cert_1", wrapped)+
+		certificateXML("cert_2", base64.StdEncoding.EncodeToString([]byte("not a certificate")))+
+		certificateXML("cert_3", "%%%")+
+		`</tas:GetAllCertificatesResponse>`)
+
+	certificates, err := NewClient(dev).GetAllCertificates(context.Background())
+	var certificatesErr *CertificatesError
+	if !errors.As(err, &certificatesErr) || len(certificatesErr.Errors) != 2 {
+		t.Fatalf("error %v, want the errors of 2 certificates", err)
+	}
+	if !strings.Contains(err.Error(), "cert_2") || !strings.Contains(certificatesErr.Errors[1].Error(), "cert_3") {
+		t.Errorf("errors %q do not tell the certificates", certificatesErr.Errors)
+	}
+	if len(certificates) != 3 {
+		t.Fatalf("%d certificates, want all of them", len(certificates))
+	}
+
+	if c := certificates[0]; c.Certificate == nil || c.Certificate.Subject.CommonName != "camera" || !bytes.Equal(c.Raw, server) || c.KeyID != "key_cert_1" {
+		t.Errorf("certificate %+v", c)
+	}
+	if c := certificates[1]; c.Certificate != nil || string(c.Raw) != "not a certificate" || c.CertificateID != "cert_2" {
+		t.Errorf("unparsable certificate %+v", c)
+	}
+	if c := certificates[2]; c.Certificate != nil || c.Raw != nil || c.Alias != "cert_3" {
+		t.Errorf("invalid base64 certificate %+v", c)
+	}
+}
+
+func TestUploadPKCS12(t *testing.T) {
+	dev := newTestDevice(t, `<tas:UploadCertificateWithPrivateKeyInPKCS12Response>`+
+		`<tas:CertificationPathID>path_1</tas:CertificationPathID><tas:KeyID>key_1</tas:KeyID>`+
+		`</tas:UploadCertificateWithPrivateKeyInPKCS12Response>`)
+
+	pfx := []byte{0x30, 0x82, 0x01, 0x00, 0xff}
+	pathID, keyID, err := NewClient(dev).UploadPKCS12(context.Background(), pfx, "secret", "server", "server key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pathID != "path_1" || keyID != "key_1" {
+		t.Errorf("ids %s %s", pathID, keyID)
+	}
+
+	var request struct {
+		Upload struct {
+			CertWithPrivateKey     string
+			CertificationPathAlias string
+			KeyAlias               string
+			Passphrase             string
+		} `xml:"Body>UploadCertificateWithPrivateKeyInPKCS12"`
+	}
+	if err := xml.Unmarshal(dev.body, &request); err != nil {
+		t.Fatal(err)
+	}
+	upload := request.Upload
+	if upload.CertWithPrivateKey != base64.StdEncoding.EncodeToString(pfx) || upload.Passphrase != "secret" ||
+		upload.CertificationPathAlias != "server" || upload.KeyAlias != "server key" {
+		t.Errorf("request %+v", upload)
+	}
+}
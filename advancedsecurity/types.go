@@ -0,0 +1,628 @@
+package advancedsecurity
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+// Identifiers of the keystore items, assigned by the device
+type (
+	KeyID                      string
+	CertificateID              string
+	CertificationPathID        string
+	PassphraseID               string
+	Dot1XID                    string
+	CRLID                      string
+	CertPathValidationPolicyID string
+)
+
+// Key statuses (tas:KeyStatus)
+const (
+	KeyStatusOK         = "ok"
+	KeyStatusGenerating = "generating"
+	KeyStatusCorrupt    = "corrupt"
+)
+
+type KeystoreCapabilities struct {
+	SignatureAlgorithms               []AlgorithmIdentifier
+	MaximumNumberOfKeys               int  `xml:"MaximumNumberOfKeys,attr"`
+	MaximumNumberOfCertificates       int  `xml:"MaximumNumberOfCertificates,attr"`
+	MaximumNumberOfCertificationPaths int  `xml:"MaximumNumberOfCertificationPaths,attr"`
+	RSAKeyPairGeneration              bool `xml:"RSAKeyPairGeneration,attr"`
+	//RSAKeyLengths space separated list of the supported key lengths
+	RSAKeyLengths                                      string `xml:"RSAKeyLengths,attr"`
+	PKCS10ExternalCertificationWithRSA                 bool   `xml:"PKCS10ExternalCertificationWithRSA,attr"`
+	SelfSignedCertificateCreationWithRSA               bool   `xml:"SelfSignedCertificateCreationWithRSA,attr"`
+	X509Versions                                       string `xml:"X509Versions,attr"`
+	MaximumNumberOfPassphrases                         int    `xml:"MaximumNumberOfPassphrases,attr"`
+	PKCS8RSAKeyPairUpload                              bool   `xml:"PKCS8RSAKeyPairUpload,attr"`
+	PKCS12CertificateWithRSAPrivateKeyUpload           bool   `xml:"PKCS12CertificateWithRSAPrivateKeyUpload,attr"`
+	PasswordBasedEncryptionAlgorithms                  string `xml:"PasswordBasedEncryptionAlgorithms,attr"`
+	PasswordBasedMACAlgorithms                         string `xml:"PasswordBasedMACAlgorithms,attr"`
+	MaximumNumberOfCRLs                                int    `xml:"MaximumNumberOfCRLs,attr"`
+	MaximumNumberOfCertificationPathValidationPolicies int    `xml:"MaximumNumberOfCertificationPathValidationPolicies,attr"`
+	EnforceTLSWebClientAuthExtKeyUsage                 bool   `xml:"EnforceTLSWebClientAuthExtKeyUsage,attr"`
+}
+
+type TLSServerCapabilities struct {
+	//TLSServerSupported space separated list of the TLS versions, ex: 1.1 1.2
+	TLSServerSupported                                    string `xml:"TLSServerSupported,attr"`
+	MaximumNumberOfTLSCertificationPaths                  int    `xml:"MaximumNumberOfTLSCertificationPaths,attr"`
+	TLSClientAuthSupported                                bool   `xml:"TLSClientAuthSupported,attr"`
+	MaximumNumberOfTLSCertificationPathValidationPolicies int    `xml:"MaximumNumberOfTLSCertificationPathValidationPolicies,attr"`
+}
+
+type Dot1XCapabilities struct {
+	MaximumNumberOfDot1XConfigurations int `xml:"MaximumNumberOfDot1XConfigurations,attr"`
+	//Dot1XMethods space separated list of the EAP methods, ex: EAP-PEAP/MSCHAPv2
+	Dot1XMethods string `xml:"Dot1XMethods,attr"`
+}
+
+type Capabilities struct {
+	KeystoreCapabilities  KeystoreCapabilities
+	TLSServerCapabilities TLSServerCapabilities
+	Dot1XCapabilities     *Dot1XCapabilities
+}
+
+type KeyAttribute struct {
+	KeyID               KeyID
+	Alias               string
+	HasPrivateKey       *bool `xml:"hasPrivateKey"`
+	KeyStatus           string
+	ExternallyGenerated *bool `xml:"externallyGenerated"`
+	SecurelyStored      *bool `xml:"securelyStored"`
+}
+
+// DNAttributeTypeAndValue Type is the dot decimal OID of the attribute
+type DNAttributeTypeAndValue struct {
+	Type  string `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Type"`
+	Value string `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Value"`
+}
+
+type MultiValuedRDN struct {
+	Attribute []DNAttributeTypeAndValue `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Attribute"`
+}
+
+// DistinguishedName is the subject of the certificates, see NewDistinguishedName
+type DistinguishedName struct {
+	Country                    []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Country,omitempty"`
+	Organization               []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Organization,omitempty"`
+	OrganizationalUnit         []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl OrganizationalUnit,omitempty"`
+	DistinguishedNameQualifier []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl DistinguishedNameQualifier,omitempty"`
+	StateOrProvinceName        []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl StateOrProvinceName,omitempty"`
+	CommonName                 []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl CommonName,omitempty"`
+	SerialNumber               []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl SerialNumber,omitempty"`
+	Locality                   []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Locality,omitempty"`
+	Title                      []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Title,omitempty"`
+	Surname                    []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Surname,omitempty"`
+	GivenName                  []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl GivenName,omitempty"`
+	Initials                   []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Initials,omitempty"`
+	Pseudonym                  []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Pseudonym,omitempty"`
+	GenerationQualifier        []string                  `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl GenerationQualifier,omitempty"`
+	GenericAttribute           []DNAttributeTypeAndValue `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl GenericAttribute,omitempty"`
+	MultiValuedRDN             []MultiValuedRDN          `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl MultiValuedRDN,omitempty"`
+}
+
+// AlgorithmIdentifier Algorithm is the dot decimal OID, Parameters is base64 DER encoded, see NewAlgorithmIdentifier
+type AlgorithmIdentifier struct {
+	Algorithm  string           `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl algorithm"`
+	Parameters xsd.Base64Binary `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl parameters,omitempty"`
+}
+
+// Deprecated: use AlgorithmIdentifier, it decodes the responses too.
+type AlgorithmIdentifierResponse = AlgorithmIdentifier
+
+// X509v3Extension ExtnOID is the dot decimal OID, ExtnValue is base64 DER encoded, see NewX509v3Extension
+type X509v3Extension struct {
+	ExtnOID   string           `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl extnOID"`
+	Critical  bool             `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl critical"`
+	ExtnValue xsd.Base64Binary `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl extnValue"`
+}
+
+type BasicRequestAttribute struct {
+	OID   string           `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl OID"`
+	Value xsd.Base64Binary `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl value"`
+}
+
+// CSRAttribute is one of X509v3Extension or BasicRequestAttribute
+type CSRAttribute struct {
+	X509v3Extension       *X509v3Extension       `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl X509v3Extension,omitempty"`
+	BasicRequestAttribute *BasicRequestAttribute `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl BasicRequestAttribute,omitempty"`
+}
+
+// X509Certificate CertificateContent is base64 DER encoded, see Client.GetCertificate
+type X509Certificate struct {
+	CertificateID      CertificateID
+	KeyID              KeyID
+	Alias              string
+	CertificateContent xsd.Base64Binary
+}
+
+type CertificateIDs struct {
+	CertificateID []CertificateID `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl CertificateID"`
+}
+
+type CertificationPath struct {
+	CertificateID []CertificateID
+	Alias         string
+}
+
+type PassphraseAttribute struct {
+	PassphraseID PassphraseID
+	Alias        string
+}
+
+// Dot1XStage Method is the EAP method, Inner is the stage of the tunneled methods
+type Dot1XStage struct {
+	Method              string              `xml:"Method,attr"`
+	Identity            string              `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Identity,omitempty"`
+	CertificationPathID CertificationPathID `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl CertificationPathID,omitempty"`
+	PassphraseID        PassphraseID        `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl PassphraseID,omitempty"`
+	Inner               *Dot1XStage         `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Inner,omitempty"`
+}
+
+// Deprecated: use Dot1XStage, it decodes the responses too.
+type Dot1XStageResponse = Dot1XStage
+
+// Dot1XConfiguration the Dot1XID is empty in AddDot1XConfiguration, the device assigns it
+type Dot1XConfiguration struct {
+	Dot1XID Dot1XID    `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Dot1XID,omitempty"`
+	Alias   string     `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Alias,omitempty"`
+	Outer   Dot1XStage `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl Outer"`
+}
+
+// Deprecated: use Dot1XConfiguration, it decodes the responses too.
+type Dot1XConfigurationResponse = Dot1XConfiguration
+
+// CRL CRLContent is base64 DER encoded, see Client.GetCRL
+type CRL struct {
+	CRLID      CRLID
+	Alias      string
+	CRLContent xsd.Base64Binary
+}
+
+type CertPathValidationParameters struct {
+	RequireTLSWWWClientAuthExtendedKeyUsage bool `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl RequireTLSWWWClientAuthExtendedKeyUsage"`
+	UseDeltaCRLs                            bool `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl UseDeltaCRLs"`
+}
+
+// Deprecated: use CertPathValidationParameters, it decodes the responses too.
+type CertPathValidationParametersResponse = CertPathValidationParameters
+
+type TrustAnchor struct {
+	CertificateID CertificateID `xml:"http://www.onvif.org/ver10/advancedsecurity/wsdl CertificateID"`
+}
+
+// Deprecated: use TrustAnchor, it decodes the responses too.
+type TrustAnchorResponse = TrustAnchor
+
+type CertPathValidationPolicy struct {
+	CertPathValidationPolicyID CertPathValidationPolicyID
+	Alias                      string
+	Parameters                 CertPathValidationParameters
+	TrustAnchor                []TrustAnchor
+}
+
+//AdvancedSecurity main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tas:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type CreateRSAKeyPair struct {
+	XMLName   string `xml:"tas:CreateRSAKeyPair"`
+	KeyLength int    `xml:"tas:KeyLength"`
+	Alias     string `xml:"tas:Alias,omitempty"`
+}
+
+type CreateRSAKeyPairResponse struct {
+	KeyID                 KeyID
+	EstimatedCreationTime xsd.Duration
+}
+
+type UploadKeyPairInPKCS8 struct {
+	XMLName                string           `xml:"tas:UploadKeyPairInPKCS8"`
+	KeyPair                xsd.Base64Binary `xml:"tas:KeyPair"`
+	Alias                  string           `xml:"tas:Alias,omitempty"`
+	EncryptionPassphraseID PassphraseID     `xml:"tas:EncryptionPassphraseID,omitempty"`
+	EncryptionPassphrase   string           `xml:"tas:EncryptionPassphrase,omitempty"`
+}
+
+type UploadKeyPairInPKCS8Response struct {
+	KeyID KeyID
+}
+
+type UploadCertificateWithPrivateKeyInPKCS12 struct {
+	XMLName                      string           `xml:"tas:UploadCertificateWithPrivateKeyInPKCS12"`
+	CertWithPrivateKey           xsd.Base64Binary `xml:"tas:CertWithPrivateKey"`
+	CertificationPathAlias       string           `xml:"tas:CertificationPathAlias,omitempty"`
+	KeyAlias                     string           `xml:"tas:KeyAlias,omitempty"`
+	IgnoreAdditionalCertificates bool             `xml:"tas:IgnoreAdditionalCertificates,omitempty"`
+	IntegrityPassphraseID        PassphraseID     `xml:"tas:IntegrityPassphraseID,omitempty"`
+	EncryptionPassphraseID       PassphraseID     `xml:"tas:EncryptionPassphraseID,omitempty"`
+	Passphrase                   string           `xml:"tas:Passphrase,omitempty"`
+}
+
+type UploadCertificateWithPrivateKeyInPKCS12Response struct {
+	CertificationPathID CertificationPathID
+	KeyID               KeyID
+}
+
+type GetKeyStatus struct {
+	XMLName string `xml:"tas:GetKeyStatus"`
+	KeyID   KeyID  `xml:"tas:KeyID"`
+}
+
+type GetKeyStatusResponse struct {
+	KeyStatus string
+}
+
+type GetPrivateKeyStatus struct {
+	XMLName string `xml:"tas:GetPrivateKeyStatus"`
+	KeyID   KeyID  `xml:"tas:KeyID"`
+}
+
+type GetPrivateKeyStatusResponse struct {
+	HasPrivateKey bool `xml:"hasPrivateKey"`
+}
+
+type GetAllKeys struct {
+	XMLName string `xml:"tas:GetAllKeys"`
+}
+
+type GetAllKeysResponse struct {
+	KeyAttribute []KeyAttribute
+}
+
+type DeleteKey struct {
+	XMLName string `xml:"tas:DeleteKey"`
+	KeyID   KeyID  `xml:"tas:KeyID"`
+}
+
+type DeleteKeyResponse struct {
+}
+
+type CreatePKCS10CSR struct {
+	XMLName            string              `xml:"tas:CreatePKCS10CSR"`
+	Subject            DistinguishedName   `xml:"tas:Subject"`
+	KeyID              KeyID               `xml:"tas:KeyID"`
+	CSRAttribute       []CSRAttribute      `xml:"tas:CSRAttribute,omitempty"`
+	SignatureAlgorithm AlgorithmIdentifier `xml:"tas:SignatureAlgorithm"`
+}
+
+type CreatePKCS10CSRResponse struct {
+	PKCS10CSR xsd.Base64Binary
+}
+
+type CreateSelfSignedCertificate struct {
+	XMLName            string              `xml:"tas:CreateSelfSignedCertificate"`
+	X509Version        int                 `xml:"tas:X509Version,omitempty"`
+	Subject            DistinguishedName   `xml:"tas:Subject"`
+	KeyID              KeyID               `xml:"tas:KeyID"`
+	Alias              string              `xml:"tas:Alias,omitempty"`
+	NotValidBefore     xsd.DateTime        `xml:"tas:notValidBefore,omitempty"`
+	NotValidAfter      xsd.DateTime        `xml:"tas:notValidAfter,omitempty"`
+	SignatureAlgorithm AlgorithmIdentifier `xml:"tas:SignatureAlgorithm"`
+	Extension          []X509v3Extension   `xml:"tas:Extension,omitempty"`
+}
+
+type CreateSelfSignedCertificateResponse struct {
+	CertificateID CertificateID
+}
+
+type UploadCertificate struct {
+	XMLName            string           `xml:"tas:UploadCertificate"`
+	Certificate        xsd.Base64Binary `xml:"tas:Certificate"`
+	Alias              string           `xml:"tas:Alias,omitempty"`
+	KeyAlias           string           `xml:"tas:KeyAlias,omitempty"`
+	PrivateKeyRequired bool             `xml:"tas:PrivateKeyRequired,omitempty"`
+}
+
+type UploadCertificateResponse struct {
+	CertificateID CertificateID
+	KeyID         KeyID
+}
+
+type GetCertificate struct {
+	XMLName       string        `xml:"tas:GetCertificate"`
+	CertificateID CertificateID `xml:"tas:CertificateID"`
+}
+
+type GetCertificateResponse struct {
+	Certificate X509Certificate
+}
+
+type GetAllCertificates struct {
+	XMLName string `xml:"tas:GetAllCertificates"`
+}
+
+type GetAllCertificatesResponse struct {
+	Certificate []X509Certificate
+}
+
+type DeleteCertificate struct {
+	XMLName       string        `xml:"tas:DeleteCertificate"`
+	CertificateID CertificateID `xml:"tas:CertificateID"`
+}
+
+type DeleteCertificateResponse struct {
+}
+
+type CreateCertificationPath struct {
+	XMLName        string         `xml:"tas:CreateCertificationPath"`
+	CertificateIDs CertificateIDs `xml:"tas:CertificateIDs"`
+	Alias          string         `xml:"tas:Alias,omitempty"`
+}
+
+type CreateCertificationPathResponse struct {
+	CertificationPathID CertificationPathID
+}
+
+type GetCertificationPath struct {
+	XMLName             string              `xml:"tas:GetCertificationPath"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type GetCertificationPathResponse struct {
+	CertificationPath CertificationPath
+}
+
+type GetAllCertificationPaths struct {
+	XMLName string `xml:"tas:GetAllCertificationPaths"`
+}
+
+type GetAllCertificationPathsResponse struct {
+	CertificationPathID []CertificationPathID
+}
+
+type DeleteCertificationPath struct {
+	XMLName             string              `xml:"tas:DeleteCertificationPath"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type DeleteCertificationPathResponse struct {
+}
+
+type UploadPassphrase struct {
+	XMLName         string `xml:"tas:UploadPassphrase"`
+	Passphrase      string `xml:"tas:Passphrase"`
+	PassphraseAlias string `xml:"tas:PassphraseAlias,omitempty"`
+}
+
+type UploadPassphraseResponse struct {
+	PassphraseID PassphraseID
+}
+
+type GetAllPassphrases struct {
+	XMLName string `xml:"tas:GetAllPassphrases"`
+}
+
+type GetAllPassphrasesResponse struct {
+	PassphraseAttribute []PassphraseAttribute
+}
+
+type DeletePassphrase struct {
+	XMLName      string       `xml:"tas:DeletePassphrase"`
+	PassphraseID PassphraseID `xml:"tas:PassphraseID"`
+}
+
+type DeletePassphraseResponse struct {
+}
+
+type AddServerCertificateAssignment struct {
+	XMLName             string              `xml:"tas:AddServerCertificateAssignment"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type AddServerCertificateAssignmentResponse struct {
+}
+
+type RemoveServerCertificateAssignment struct {
+	XMLName             string              `xml:"tas:RemoveServerCertificateAssignment"`
+	CertificationPathID CertificationPathID `xml:"tas:CertificationPathID"`
+}
+
+type RemoveServerCertificateAssignmentResponse struct {
+}
+
+type ReplaceServerCertificateAssignment struct {
+	XMLName                string              `xml:"tas:ReplaceServerCertificateAssignment"`
+	OldCertificationPathID CertificationPathID `xml:"tas:OldCertificationPathID"`
+	NewCertificationPathID CertificationPathID `xml:"tas:NewCertificationPathID"`
+}
+
+type ReplaceServerCertificateAssignmentResponse struct {
+}
+
+type GetAssignedServerCertificates struct {
+	XMLName string `xml:"tas:GetAssignedServerCertificates"`
+}
+
+type GetAssignedServerCertificatesResponse struct {
+	CertificationPathID []CertificationPathID
+}
+
+type UploadCRL struct {
+	XMLName string           `xml:"tas:UploadCRL"`
+	Crl     xsd.Base64Binary `xml:"tas:Crl"`
+	Alias   string           `xml:"tas:Alias,omitempty"`
+}
+
+type UploadCRLResponse struct {
+	CrlID CRLID
+}
+
+type GetCRL struct {
+	XMLName string `xml:"tas:GetCRL"`
+	CrlID   CRLID  `xml:"tas:CrlID"`
+}
+
+type GetCRLResponse struct {
+	Crl CRL
+}
+
+type GetAllCRLs struct {
+	XMLName string `xml:"tas:GetAllCRLs"`
+}
+
+type GetAllCRLsResponse struct {
+	Crl []CRL
+}
+
+type DeleteCRL struct {
+	XMLName string `xml:"tas:DeleteCRL"`
+	CrlID   CRLID  `xml:"tas:CrlID"`
+}
+
+type DeleteCRLResponse struct {
+}
+
+type CreateCertPathValidationPolicy struct {
+	XMLName     string                       `xml:"tas:CreateCertPathValidationPolicy"`
+	Alias       string                       `xml:"tas:Alias,omitempty"`
+	Parameters  CertPathValidationParameters `xml:"tas:Parameters"`
+	TrustAnchor []TrustAnchor                `xml:"tas:TrustAnchor"`
+}
+
+type CreateCertPathValidationPolicyResponse struct {
+	CertPathValidationPolicyID CertPathValidationPolicyID
+}
+
+type GetCertPathValidationPolicy struct {
+	XMLName                    string                     `xml:"tas:GetCertPathValidationPolicy"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type GetCertPathValidationPolicyResponse struct {
+	CertPathValidationPolicy CertPathValidationPolicy
+}
+
+type GetAllCertPathValidationPolicies struct {
+	XMLName string `xml:"tas:GetAllCertPathValidationPolicies"`
+}
+
+type GetAllCertPathValidationPoliciesResponse struct {
+	CertPathValidationPolicy []CertPathValidationPolicy
+}
+
+type DeleteCertPathValidationPolicy struct {
+	XMLName                    string                     `xml:"tas:DeleteCertPathValidationPolicy"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type DeleteCertPathValidationPolicyResponse struct {
+}
+
+type SetClientAuthenticationRequired struct {
+	XMLName                      string `xml:"tas:SetClientAuthenticationRequired"`
+	ClientAuthenticationRequired bool   `xml:"tas:clientAuthenticationRequired"`
+}
+
+type SetClientAuthenticationRequiredResponse struct {
+}
+
+type GetClientAuthenticationRequired struct {
+	XMLName string `xml:"tas:GetClientAuthenticationRequired"`
+}
+
+type GetClientAuthenticationRequiredResponse struct {
+	ClientAuthenticationRequired bool `xml:"clientAuthenticationRequired"`
+}
+
+type AddCertPathValidationPolicyAssignment struct {
+	XMLName                    string                     `xml:"tas:AddCertPathValidationPolicyAssignment"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type AddCertPathValidationPolicyAssignmentResponse struct {
+}
+
+type RemoveCertPathValidationPolicyAssignment struct {
+	XMLName                    string                     `xml:"tas:RemoveCertPathValidationPolicyAssignment"`
+	CertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:CertPathValidationPolicyID"`
+}
+
+type RemoveCertPathValidationPolicyAssignmentResponse struct {
+}
+
+type ReplaceCertPathValidationPolicyAssignment struct {
+	XMLName                       string                     `xml:"tas:ReplaceCertPathValidationPolicyAssignment"`
+	OldCertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:OldCertPathValidationPolicyID"`
+	NewCertPathValidationPolicyID CertPathValidationPolicyID `xml:"tas:NewCertPathValidationPolicyID"`
+}
+
+type ReplaceCertPathValidationPolicyAssignmentResponse struct {
+}
+
+type GetAssignedCertPathValidationPolicies struct {
+	XMLName string `xml:"tas:GetAssignedCertPathValidationPolicies"`
+}
+
+type GetAssignedCertPathValidationPoliciesResponse struct {
+	CertPathValidationPolicyID []CertPathValidationPolicyID
+}
+
+type AddDot1XConfiguration struct {
+	XMLName            string             `xml:"tas:AddDot1XConfiguration"`
+	Dot1XConfiguration Dot1XConfiguration `xml:"tas:Dot1XConfiguration"`
+}
+
+type AddDot1XConfigurationResponse struct {
+	Dot1XID Dot1XID
+}
+
+type GetAllDot1XConfigurations struct {
+	XMLName string `xml:"tas:GetAllDot1XConfigurations"`
+}
+
+type GetAllDot1XConfigurationsResponse struct {
+	Configuration []Dot1XConfiguration
+}
+
+type GetDot1XConfiguration struct {
+	XMLName string  `xml:"tas:GetDot1XConfiguration"`
+	Dot1XID Dot1XID `xml:"tas:Dot1XID"`
+}
+
+type GetDot1XConfigurationResponse struct {
+	Dot1XConfiguration Dot1XConfiguration
+}
+
+type DeleteDot1XConfiguration struct {
+	XMLName string  `xml:"tas:DeleteDot1XConfiguration"`
+	Dot1XID Dot1XID `xml:"tas:Dot1XID"`
+}
+
+type DeleteDot1XConfigurationResponse struct {
+}
+
+type SetNetworkInterfaceDot1XConfiguration struct {
+	XMLName string  `xml:"tas:SetNetworkInterfaceDot1XConfiguration"`
+	Token   string  `xml:"tas:token"`
+	Dot1XID Dot1XID `xml:"tas:Dot1XID"`
+}
+
+type SetNetworkInterfaceDot1XConfigurationResponse struct {
+	RebootNeeded bool
+}
+
+type GetNetworkInterfaceDot1XConfiguration struct {
+	XMLName string `xml:"tas:GetNetworkInterfaceDot1XConfiguration"`
+	Token   string `xml:"tas:token"`
+}
+
+type GetNetworkInterfaceDot1XConfigurationResponse struct {
+	Dot1XID Dot1XID
+}
+
+type DeleteNetworkInterfaceDot1XConfiguration struct {
+	XMLName string `xml:"tas:DeleteNetworkInterfaceDot1XConfiguration"`
+	Token   string `xml:"tas:token"`
+}
+
+type DeleteNetworkInterfaceDot1XConfigurationResponse struct {
+	RebootNeeded bool
+}
@@ -0,0 +1,178 @@
+package advancedsecurity
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+// signatureAlgorithmOIDs of the x509 signature algorithms, RSA-PSS is not there as its parameters depend on the hash
+var signatureAlgorithmOIDs = map[x509.SignatureAlgorithm]string{
+	x509.SHA1WithRSA:     "1.2.840.113549.1.1.5",
+	x509.SHA256WithRSA:   "1.2.840.113549.1.1.11",
+	x509.SHA384WithRSA:   "1.2.840.113549.1.1.12",
+	x509.SHA512WithRSA:   "1.2.840.113549.1.1.13",
+	x509.ECDSAWithSHA1:   "1.2.840.10045.4.1",
+	x509.ECDSAWithSHA256: "1.2.840.10045.4.3.2",
+	x509.ECDSAWithSHA384: "1.2.840.10045.4.3.3",
+	x509.ECDSAWithSHA512: "1.2.840.10045.4.3.4",
+	x509.PureEd25519:     "1.3.101.112",
+}
+
+// rsaNullParameters is the DER NULL, the parameters of the PKCS #1 v1.5 signature algorithms
+var rsaNullParameters = []byte{0x05, 0x00}
+
+// Distinguished name attributes without DistinguishedName field
+var (
+	oidStreetAddress = asn1.ObjectIdentifier{2, 5, 4, 9}
+	oidPostalCode    = asn1.ObjectIdentifier{2, 5, 4, 17}
+)
+
+// NewAlgorithmIdentifier return the identifier of the signature algorithm, RSA-PSS is not supported
+func NewAlgorithmIdentifier(algorithm x509.SignatureAlgorithm) (AlgorithmIdentifier, error) {
+	oid, ok := signatureAlgorithmOIDs[algorithm]
+	if !ok {
+		return AlgorithmIdentifier{}, fmt.Errorf("advancedsecurity: unsupported signature algorithm %s", algorithm)
+	}
+
+	identifier := AlgorithmIdentifier{Algorithm: oid}
+	switch algorithm {
+	case x509.SHA1WithRSA, x509.SHA256WithRSA, x509.SHA384WithRSA, x509.SHA512WithRSA:
+		identifier.Parameters = encodeBase64(rsaNullParameters)
+	}
+	return identifier, nil
+}
+
+// SignatureAlgorithm return the x509 signature algorithm of the identifier, x509.UnknownSignatureAlgorithm if not known
+func (a AlgorithmIdentifier) SignatureAlgorithm() x509.SignatureAlgorithm {
+	for algorithm, oid := range signatureAlgorithmOIDs {
+		if oid == a.Algorithm {
+			return algorithm
+		}
+	}
+	return x509.UnknownSignatureAlgorithm
+}
+
+// NewDistinguishedName return the distinguished name of the pkix name,
+// the StreetAddress, PostalCode and ExtraNames are sent as generic attributes.
+func NewDistinguishedName(name pkix.Name) DistinguishedName {
+	dn := DistinguishedName{
+		Country:             name.Country,
+		Organization:        name.Organization,
+		OrganizationalUnit:  name.OrganizationalUnit,
+		StateOrProvinceName: name.Province,
+		Locality:            name.Locality,
+	}
+	if name.CommonName != "" {
+		dn.CommonName = []string{name.CommonName}
+	}
+	if name.SerialNumber != "" {
+		dn.SerialNumber = []string{name.SerialNumber}
+	}
+	for _, street := range name.StreetAddress {
+		dn.GenericAttribute = append(dn.GenericAttribute, DNAttributeTypeAndValue{Type: oidStreetAddress.String(), Value: street})
+	}
+	for _, code := range name.PostalCode {
+		dn.GenericAttribute = append(dn.GenericAttribute, DNAttributeTypeAndValue{Type: oidPostalCode.String(), Value: code})
+	}
+	for _, attr := range name.ExtraNames {
+		dn.GenericAttribute = append(dn.GenericAttribute, DNAttributeTypeAndValue{Type: attr.Type.String(), Value: fmt.Sprint(attr.Value)})
+	}
+	return dn
+}
+
+// NewX509v3Extension return the extension of the certificate or the CSR
+func NewX509v3Extension(extension pkix.Extension) X509v3Extension {
+	return X509v3Extension{
+		ExtnOID:   extension.Id.String(),
+		Critical:  extension.Critical,
+		ExtnValue: encodeBase64(extension.Value),
+	}
+}
+
+// NewCSRAttribute return the CSR attribute of the extension
+func NewCSRAttribute(extension pkix.Extension) CSRAttribute {
+	ext := NewX509v3Extension(extension)
+	return CSRAttribute{X509v3Extension: &ext}
+}
+
+// Parse return the parsed certificate content
+func (c X509Certificate) Parse() (*x509.Certificate, error) {
+	der, err := decodeBase64(c.CertificateContent)
+	if err != nil {
+		return nil, fmt.Errorf("advancedsecurity: certificate %s: %w", c.CertificateID, err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("advancedsecurity: certificate %s: %w", c.CertificateID, err)
+	}
+	return certificate, nil
+}
+
+// Certificate of the keystore
+type Certificate struct {
+	CertificateID CertificateID
+	KeyID         KeyID
+	Alias         string
+	// Raw is the DER content, it is kept when the certificate can't be parsed
+	Raw []byte
+	// Certificate is nil when Raw can't be parsed, ex: a certificate with a negative serial number
+	Certificate *x509.Certificate
+}
+
+// CertificatesError lists the certificates of GetAllCertificates which can't be parsed,
+// the other certificates are returned with it
+type CertificatesError struct {
+	Errors []error
+}
+
+func (e *CertificatesError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", e.Errors[0], len(e.Errors)-1)
+}
+
+// parseCertificate return the certificate with Raw set, unless the content is not base64, and the parse error
+func parseCertificate(c X509Certificate) (Certificate, error) {
+	certificate := Certificate{CertificateID: c.CertificateID, KeyID: c.KeyID, Alias: c.Alias}
+	der, err := decodeBase64(c.CertificateContent)
+	if err != nil {
+		return certificate, fmt.Errorf("advancedsecurity: certificate %s: %w", c.CertificateID, err)
+	}
+	certificate.Raw = der
+	if certificate.Certificate, err = x509.ParseCertificate(der); err != nil {
+		return certificate, fmt.Errorf("advancedsecurity: certificate %s: %w", c.CertificateID, err)
+	}
+	return certificate, nil
+}
+
+// DER return the DER encoded CRL content, parse it with x509.ParseRevocationList
+func (c CRL) DER() ([]byte, error) {
+	der, err := decodeBase64(c.CRLContent)
+	if err != nil {
+		return nil, fmt.Errorf("advancedsecurity: crl %s: %w", c.CRLID, err)
+	}
+	return der, nil
+}
+
+func encodeBase64(data []byte) xsd.Base64Binary {
+	return xsd.Base64Binary(base64.StdEncoding.EncodeToString(data))
+}
+
+// decodeBase64 ignores the whitespaces, the devices may wrap the lines
+func decodeBase64(data xsd.Base64Binary) ([]byte, error) {
+	clean := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case ' ', '\t', '\r', '\n':
+		default:
+			clean = append(clean, data[i])
+		}
+	}
+	return base64.StdEncoding.DecodeString(string(clean))
+}
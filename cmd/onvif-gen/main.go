@@ -0,0 +1,200 @@
+// Command onvif-gen generates the service packages from the WSDL files (docs/wsdl)
+// and the namespace table of the SOAP envelope (networking.Xlmns).
+//
+// Generate the types and the client of a service, run from the root of the module:
+//
+//	go run ./cmd/onvif-gen -wsdl docs/wsdl/thermal.wsdl -out thermal
+//
+// The request types have XMLName with the prefix of the service, the fields are tagged with the namespaces,
+// so the same types are used in the requests and the responses. The schema types are taken from xsd/onvif,
+// the unknown types are kept as xsd.AnyElement. The client methods take the fields of the request
+// and return the only field of the response, or the response itself.
+//
+// Generate the namespace table from all the documents:
+//
+//	go run ./cmd/onvif-gen -xlmns -out networking/xlmns.go docs/wsdl xsd/onvif
+//
+// The files which are not generated by onvif-gen are not overwritten without -f.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func main() {
+	var (
+		wsdl    = flag.String("wsdl", "", "WSDL or XSD `file` of the service")
+		pkg     = flag.String("pkg", "", "package name, the letters and digits of the output directory name by default")
+		service = flag.String("service", "", "service name used in the comments, the port type name by default")
+		out     = flag.String("out", "", "output directory of the service, or output file of -xlmns")
+		schema  = flag.String("onvif", "xsd/onvif", "`directory` of the xsd/onvif package, the schema types are taken from it")
+		xlmns   = flag.Bool("xlmns", false, "generate the namespace table from the WSDL and XSD files or directories in the arguments")
+		force   = flag.Bool("f", false, "overwrite the files which are not generated by onvif-gen")
+	)
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("onvif-gen: ")
+
+	var err error
+	switch {
+	case *xlmns && *out != "":
+		err = generateXlmns(flag.Args(), *out, *force)
+	case *wsdl != "" && *out != "":
+		err = generateService(*wsdl, *out, *pkg, *service, *schema, *force)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generateService(path, out, pkg, service, schema string, force bool) error {
+	if pkg == "" {
+		pkg = packageName(out)
+	}
+	if !token.IsIdentifier(pkg) {
+		return fmt.Errorf("package name %q is not an identifier, set -pkg", pkg)
+	}
+
+	doc, err := readDocument(path)
+	if err != nil {
+		return err
+	}
+	onvif, err := loadOnvifTypes(schema)
+	if err != nil {
+		return err
+	}
+
+	if service == "" {
+		service = exported(pkg)
+		if len(doc.PortTypes) > 0 {
+			service = strings.TrimSuffix(doc.PortTypes[0].Name, "Port")
+		}
+	}
+
+	g, err := newGenerator(doc, service, onvif)
+	if err != nil {
+		return err
+	}
+	g.generate()
+	for _, warning := range g.warnings {
+		log.Printf("%s: %s", filepath.Base(path), warning)
+	}
+
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	source := filepath.Base(path)
+	types, err := g.renderTypes(source, pkg)
+	if err != nil {
+		return fmt.Errorf("types.go: %w", err)
+	}
+	if err := writeFile(filepath.Join(out, "types.go"), types, force); err != nil {
+		return err
+	}
+	if len(g.ops) == 0 {
+		return nil
+	}
+	client, err := g.renderClient(source, pkg)
+	if err != nil {
+		return fmt.Errorf("client.go: %w", err)
+	}
+	return writeFile(filepath.Join(out, "client.go"), client, force)
+}
+
+// packageName return the package name of the output directory, the characters which are not
+// letters or digits are dropped, ex: WS-BaseNotification is wsbasenotification
+func packageName(out string) string {
+	return strings.Map(func(r rune) rune {
+		if r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, filepath.Base(filepath.Clean(out)))
+}
+
+func generateXlmns(paths []string, out string, force bool) error {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		for _, pattern := range []string{"*.wsdl", "*.xsd"} {
+			matches, err := filepath.Glob(filepath.Join(path, pattern))
+			if err != nil {
+				return err
+			}
+			files = append(files, matches...)
+		}
+	}
+	if len(files) == 0 {
+		return errors.New("no WSDL or XSD files")
+	}
+	sort.Strings(files)
+
+	docs := make([]*document, 0, len(files))
+	for _, file := range files {
+		doc, err := readDocument(file)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+
+	table, warnings := namespaceTable(docs)
+	for _, warning := range warnings {
+		log.Print(warning)
+	}
+	src, err := renderXlmns(table)
+	if err != nil {
+		return err
+	}
+	return writeFile(out, src, force)
+}
+
+// writeFile writes the generated source, the hand written files are kept unless force is set
+func writeFile(path string, src []byte, force bool) error {
+	if !force {
+		generated, err := isGenerated(path)
+		if err != nil {
+			return err
+		}
+		if !generated {
+			return fmt.Errorf("%s is not generated by onvif-gen, use -f to overwrite it", path)
+		}
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+// isGenerated reports whether the file is absent or generated by onvif-gen
+func isGenerated(path string) (bool, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	line, _ := bufio.NewReader(f).ReadString('\n')
+	return strings.HasPrefix(line, "// Code generated by onvif-gen"), nil
+}
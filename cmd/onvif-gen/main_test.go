@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files of testdata")
+
+func TestPackageName(t *testing.T) {
+	tests := map[string]string{
+		"deviceio":                     "deviceio",
+		"event-vs":                     "eventvs",
+		"WS-BaseNotification":          "wsbasenotification",
+		"./gen/Media2/":                "media2",
+		"gen/access_control.v1":        "accesscontrolv1",
+		filepath.Join("a", "thermal"): "thermal",
+	}
+	for out, want := range tests {
+		if got := packageName(out); got != want {
+			t.Errorf("packageName(%q) = %q, want %q", out, got, want)
+		}
+	}
+}
+
+func TestGenerateServicePackageName(t *testing.T) {
+	tests := []struct {
+		out, pkg string
+		err      bool
+	}{
+		{out: "event-vs"},
+		{out: "2fa", err: true},
+		{out: "2fa", pkg: "twofa"},
+		{out: "type", err: true},
+		{out: "deviceio", pkg: "device-io", err: true},
+	}
+	for _, tt := range tests {
+		out := filepath.Join(t.TempDir(), tt.out)
+		err := generateService("../../docs/wsdl/deviceio.wsdl", out, tt.pkg, "", "../../xsd/onvif", false)
+		if (err != nil) != tt.err {
+			t.Errorf("out %s pkg %q: error %v", tt.out, tt.pkg, err)
+		}
+	}
+}
+
+// TestGenerateServiceGolden generates deviceio.wsdl and compares it with testdata/deviceio,
+// run with -update after a change of the generator or xsd/onvif
+func TestGenerateServiceGolden(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "deviceio")
+	if err := generateService("../../docs/wsdl/deviceio.wsdl", out, "", "", "../../xsd/onvif", false); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"types.go", "client.go"} {
+		got, err := os.ReadFile(filepath.Join(out, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		golden := filepath.Join("testdata", "deviceio", name+".golden")
+		if *update {
+			if err := os.MkdirAll(filepath.Dir(golden), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(golden, got, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s differs from %s, run go test ./cmd/onvif-gen -update if the change is expected\n%s", name, golden, firstDiff(string(got), string(want)))
+		}
+	}
+}
+
+// firstDiff return the first different line of got and want
+func firstDiff(got, want string) string {
+	gotLines, wantLines := strings.Split(got, "\n"), strings.Split(want, "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var g, w string
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if g != w {
+			return fmt.Sprintf("line %d:\ngot:  %s\nwant: %s", i+1, g, w)
+		}
+	}
+	return ""
+}
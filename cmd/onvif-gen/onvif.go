@@ -0,0 +1,162 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// kind of a Go type, it decides how an optional value is declared
+type kind int
+
+const (
+	// kindString string based types, the empty value is omitted
+	kindString kind = iota
+	// kindValue numbers and booleans, optional values are pointers so the zero value is sent
+	kindValue
+	// kindStruct optional structs are pointers
+	kindStruct
+	// kindAny xsd.AnyElement omits itself when empty
+	kindAny
+	// kindSlice lists
+	kindSlice
+)
+
+// builtins maps the XML Schema types to the xsd package
+var builtins = map[string]string{
+	"anyType":            "xsd.AnyElement",
+	"anySimpleType":      "xsd.AnySimpleType",
+	"string":             "xsd.String",
+	"normalizedString":   "xsd.NormalizedString",
+	"token":              "xsd.Token",
+	"language":           "xsd.Language",
+	"Name":               "xsd.Name",
+	"NCName":             "xsd.NCName",
+	"ID":                 "xsd.ID",
+	"IDREF":              "xsd.IDREF",
+	"IDREFS":             "xsd.IDREFS",
+	"ENTITY":             "xsd.ENTITY",
+	"ENTITIES":           "xsd.ENTITIES",
+	"NMTOKEN":            "xsd.NMTOKEN",
+	"NMTOKENS":           "xsd.NMTOKENS",
+	"QName":              "xsd.QName",
+	"anyURI":             "xsd.AnyURI",
+	"base64Binary":       "xsd.Base64Binary",
+	"hexBinary":          "xsd.HexBinary",
+	"duration":           "xsd.Duration",
+	"dateTime":           "xsd.DateTime",
+	"date":               "xsd.Date",
+	"time":               "xsd.Time",
+	"gYearMonth":         "xsd.GYearMonth",
+	"gYear":              "xsd.GYear",
+	"gMonthDay":          "xsd.GMonthDay",
+	"gDay":               "xsd.GDay",
+	"gMonth":             "xsd.GMonth",
+	"decimal":            "xsd.Decimal",
+	"boolean":            "xsd.Boolean",
+	"float":              "xsd.Float",
+	"double":             "xsd.Double",
+	"integer":            "xsd.Integer",
+	"nonPositiveInteger": "xsd.NonPositiveInteger",
+	"negativeInteger":    "xsd.NegativeInteger",
+	"long":               "xsd.Long",
+	"int":                "xsd.Int",
+	"short":              "xsd.Short",
+	"byte":               "xsd.Byte",
+	"nonNegativeInteger": "xsd.NonNegativeInteger",
+	"unsignedLong":       "xsd.UnsignedLong",
+	"unsignedInt":        "xsd.UnsignedInt",
+	"unsignedShort":      "xsd.UnsignedShort",
+	"unsignedByte":       "xsd.UnsignedByte",
+	"positiveInteger":    "xsd.PositiveInteger",
+}
+
+// builtinKinds are the xsd types which are not strings
+var builtinKinds = map[string]kind{
+	"xsd.AnyElement":         kindAny,
+	"xsd.IDREFS":             kindSlice,
+	"xsd.ENTITIES":           kindSlice,
+	"xsd.NMTOKENS":           kindSlice,
+	"xsd.Boolean":            kindValue,
+	"xsd.Float":              kindValue,
+	"xsd.Double":             kindValue,
+	"xsd.Integer":            kindValue,
+	"xsd.NonPositiveInteger": kindValue,
+	"xsd.NegativeInteger":    kindValue,
+	"xsd.Long":               kindValue,
+	"xsd.Int":                kindValue,
+	"xsd.Short":              kindValue,
+	"xsd.Byte":               kindValue,
+	"xsd.NonNegativeInteger": kindValue,
+	"xsd.UnsignedLong":       kindValue,
+	"xsd.UnsignedInt":        kindValue,
+	"xsd.UnsignedShort":      kindValue,
+	"xsd.UnsignedByte":       kindValue,
+	"xsd.PositiveInteger":    kindValue,
+}
+
+// loadOnvifTypes returns the kinds of the types declared in the xsd/onvif package
+func loadOnvifTypes(dir string) (map[string]kind, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	specs := map[string]*ast.TypeSpec{}
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				spec := spec.(*ast.TypeSpec)
+				specs[spec.Name.Name] = spec
+			}
+		}
+	}
+
+	kinds := make(map[string]kind, len(specs))
+	var kindOf func(expr ast.Expr, depth int) kind
+	kindOf = func(expr ast.Expr, depth int) kind {
+		switch t := expr.(type) {
+		case *ast.StructType:
+			return kindStruct
+		case *ast.ArrayType:
+			return kindSlice
+		case *ast.StarExpr:
+			return kindOf(t.X, depth)
+		case *ast.SelectorExpr:
+			name := "xsd." + t.Sel.Name
+			if k, ok := builtinKinds[name]; ok {
+				return k
+			}
+			return kindString
+		case *ast.Ident:
+			switch t.Name {
+			case "string":
+				return kindString
+			case "bool", "int", "int32", "int64", "uint", "uint32", "uint64", "float32", "float64":
+				return kindValue
+			}
+			if spec, ok := specs[t.Name]; ok && depth < 16 {
+				return kindOf(spec.Type, depth+1)
+			}
+		}
+		return kindString
+	}
+	for name, spec := range specs {
+		kinds[name] = kindOf(spec.Type, 0)
+	}
+	return kinds, nil
+}
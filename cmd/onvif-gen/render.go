@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const header = "// Code generated by onvif-gen from %s. DO NOT EDIT.\n\npackage %s\n\n"
+
+var importPaths = map[string]string{
+	"context":    "context",
+	"networking": "github.com/neirolis/onvif-go/networking",
+	"onvif":      "github.com/neirolis/onvif-go/xsd/onvif",
+	"xsd":        "github.com/neirolis/onvif-go/xsd",
+}
+
+// renderTypes returns the source of types.go
+func (g *generator) renderTypes(source, pkg string) ([]byte, error) {
+	var body bytes.Buffer
+	for _, t := range g.types {
+		writeType(&body, t)
+	}
+	if len(g.main) > 0 {
+		fmt.Fprintf(&body, "//%s main types\n\n", g.service)
+		for _, t := range g.main {
+			writeType(&body, t)
+		}
+	}
+	return render(source, pkg, body.Bytes())
+}
+
+func writeType(b *bytes.Buffer, t *goType) {
+	writeDoc(b, "", t.Name, t.Doc)
+	if !t.Struct {
+		fmt.Fprintf(b, "type %s %s\n\n", t.Name, t.Underlying)
+		if len(t.Consts) > 0 {
+			b.WriteString("const (\n")
+			for _, c := range t.Consts {
+				fmt.Fprintf(b, "%s %s = %q\n", c.Name, t.Name, c.Value)
+			}
+			b.WriteString(")\n\n")
+		}
+		return
+	}
+
+	fmt.Fprintf(b, "type %s struct {\n", t.Name)
+	if t.XMLName != "" {
+		fmt.Fprintf(b, "XMLName string `xml:\"%s\"`\n", t.XMLName)
+	}
+	for _, f := range t.Fields {
+		if f.Embedded {
+			fmt.Fprintf(b, "%s\n", f.Type)
+			continue
+		}
+		fmt.Fprintf(b, "%s %s `xml:\"%s\"`\n", f.Name, f.Type, f.Tag)
+	}
+	b.WriteString("}\n\n")
+}
+
+// renderClient returns the source of client.go
+func (g *generator) renderClient(source, pkg string) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Client is a typed client of the %s service (%s).\n", g.service, g.prefix)
+	fmt.Fprintf(&b, "// The device must expose the %s endpoint, see onvif.Device.GetEndpoint.\n", pkg)
+	b.WriteString("type Client struct {\ndev networking.RequestCreator\n}\n\n")
+	fmt.Fprintf(&b, "// NewClient return %s service client, dev is usually *onvif.Device\n", g.service)
+	b.WriteString("func NewClient(dev networking.RequestCreator) *Client {\nreturn &Client{dev: dev}\n}\n\n")
+	b.WriteString("func (c *Client) call(ctx context.Context, method, response interface{}) error {\n")
+	b.WriteString("return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)\n}\n\n")
+
+	for _, o := range g.ops {
+		writeOp(&b, o)
+	}
+	return render(source, pkg, b.Bytes())
+}
+
+// writeOp writes the method of the operation, the fields of the request are the arguments
+// and the only field of the response is returned
+func writeOp(b *bytes.Buffer, o op) {
+	params := []string{"ctx context.Context"}
+	var values []string
+	used := map[string]bool{"ctx": true, "c": true, "resp": true, "err": true, "context": true, "onvif": true, "xsd": true}
+	for _, f := range o.Request.Fields {
+		name := unexported(f.Name)
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s%d", unexported(f.Name), i)
+		}
+		used[name] = true
+		params = append(params, name+" "+f.Type)
+		values = append(values, f.Name+": "+name)
+	}
+	request := fmt.Sprintf("%s{%s}", o.Request.Name, strings.Join(values, ", "))
+
+	writeDoc(b, "", o.Name, o.Doc)
+	signature := fmt.Sprintf("func (c *Client) %s(%s)", o.Name, strings.Join(params, ", "))
+	switch fields := o.Response.Fields; {
+	case len(fields) == 0:
+		fmt.Fprintf(b, "%s error {\n", signature)
+		fmt.Fprintf(b, "return c.call(ctx, %s, &%s{})\n}\n\n", request, o.Response.Name)
+	case len(fields) == 1:
+		f := fields[0]
+		fmt.Fprintf(b, "%s (%s, error) {\n", signature, f.Type)
+		fmt.Fprintf(b, "resp := %s{}\n", o.Response.Name)
+		if strings.HasPrefix(f.Type, "[]") {
+			fmt.Fprintf(b, "if err := c.call(ctx, %s, &resp); err != nil {\nreturn nil, err\n}\n", request)
+			fmt.Fprintf(b, "return resp.%s, nil\n}\n\n", f.Name)
+			return
+		}
+		fmt.Fprintf(b, "err := c.call(ctx, %s, &resp)\n", request)
+		fmt.Fprintf(b, "return resp.%s, err\n}\n\n", f.Name)
+	default:
+		fmt.Fprintf(b, "%s (%s, error) {\n", signature, o.Response.Name)
+		fmt.Fprintf(b, "resp := %s{}\n", o.Response.Name)
+		fmt.Fprintf(b, "err := c.call(ctx, %s, &resp)\n", request)
+		b.WriteString("return resp, err\n}\n\n")
+	}
+}
+
+var (
+	spaces   = regexp.MustCompile(`\s+`)
+	sentence = regexp.MustCompile(`^.*?[.:](\s|$)`)
+	comments = regexp.MustCompile(`(?m)^\s*//.*$`)
+	// the operations are usually documented as "This operation returns ..."
+	preamble = regexp.MustCompile(`^(?i)(this|the) (operation|command|method|function) (will |shall )?`)
+)
+
+// writeDoc writes the first sentence of the schema documentation as the doc comment
+func writeDoc(b *bytes.Buffer, indent, name, doc string) {
+	doc = strings.TrimSpace(spaces.ReplaceAllString(doc, " "))
+	if doc == "" {
+		return
+	}
+	if s := sentence.FindString(doc); s != "" {
+		doc = strings.TrimSpace(s)
+	}
+	doc = strings.TrimRight(preamble.ReplaceAllString(doc, ""), ".:")
+	if r := []rune(doc); len(r) > 1 && unicode.IsUpper(r[0]) && !unicode.IsUpper(r[1]) {
+		doc = string(unicode.ToLower(r[0])) + string(r[1:])
+	}
+	fmt.Fprintf(b, "%s// %s %s\n", indent, name, doc)
+}
+
+// render adds the header and the imports, then formats the source
+func render(source, pkg string, body []byte) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, header, source, pkg)
+
+	code := comments.ReplaceAll(body, nil)
+	var imports []string
+	for _, name := range []string{"context", "networking", "xsd", "onvif"} {
+		if regexp.MustCompile(`\b` + name + `\.[A-Z]`).Match(code) {
+			imports = append(imports, importPaths[name])
+		}
+	}
+	if len(imports) > 0 {
+		b.WriteString("import (\n")
+		for i, path := range imports {
+			if i > 0 && !strings.Contains(imports[i-1], ".") && strings.Contains(path, ".") {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "%q\n", path)
+		}
+		b.WriteString(")\n\n")
+	}
+	b.Write(body)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return b.Bytes(), err
+	}
+	return src, nil
+}
+
+// unexported returns the argument name of the field, ex: PTZConfiguration is ptzConfiguration
+func unexported(name string) string {
+	r := []rune(name)
+	i := 0
+	for i < len(r) && unicode.IsUpper(r[i]) {
+		i++
+	}
+	switch {
+	case i == 0:
+	case i == len(r):
+		name = strings.ToLower(name)
+	case i == 1:
+		name = strings.ToLower(string(r[0])) + string(r[1:])
+	default:
+		// the last upper letter starts the next word
+		name = strings.ToLower(string(r[:i-1])) + string(r[i-1:])
+	}
+	if keywords[name] {
+		name += "Value"
+	}
+	return name
+}
+
+var keywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true, "goto": true,
+	"if": true, "import": true, "interface": true, "map": true, "package": true, "range": true,
+	"return": true, "select": true, "struct": true, "switch": true, "type": true, "var": true,
+}
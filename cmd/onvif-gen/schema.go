@@ -0,0 +1,283 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	nsXMLSchema = "http://www.w3.org/2001/XMLSchema"
+	nsWSDL      = "http://schemas.xmlsoap.org/wsdl/"
+	nsSOAP12    = "http://schemas.xmlsoap.org/wsdl/soap12/"
+	nsSOAP      = "http://schemas.xmlsoap.org/wsdl/soap/"
+	nsSchema    = "http://www.onvif.org/ver10/schema"
+	nsPACS      = "http://www.onvif.org/ver10/pacs"
+)
+
+// document is a WSDL file or a XSD file, the latter has only one schema and no operations
+type document struct {
+	Path            string
+	TargetNamespace string
+	// Namespaces declarations of the root element, namespace by prefix
+	Namespaces map[string]string
+	Schemas    []*schema
+	Messages   map[string]message
+	PortTypes  []portType
+}
+
+type definitions struct {
+	TargetNamespace string     `xml:"targetNamespace,attr"`
+	Attrs           []xml.Attr `xml:",any,attr"`
+	Schemas         []*schema  `xml:"types>schema"`
+	Messages        []message  `xml:"message"`
+	PortTypes       []portType `xml:"portType"`
+}
+
+type message struct {
+	Name  string `xml:"name,attr"`
+	Parts []struct {
+		Name    string `xml:"name,attr"`
+		Element string `xml:"element,attr"`
+	} `xml:"part"`
+}
+
+type portType struct {
+	Name       string      `xml:"name,attr"`
+	Operations []operation `xml:"operation"`
+}
+
+type operation struct {
+	Name          string `xml:"name,attr"`
+	Documentation string `xml:"documentation"`
+	Input         struct {
+		Message string `xml:"message,attr"`
+	} `xml:"input"`
+	Output struct {
+		Message string `xml:"message,attr"`
+	} `xml:"output"`
+}
+
+type schema struct {
+	TargetNamespace    string         `xml:"targetNamespace,attr"`
+	ElementFormDefault string         `xml:"elementFormDefault,attr"`
+	Attrs              []xml.Attr     `xml:",any,attr"`
+	Elements           []*element     `xml:"element"`
+	ComplexTypes       []*complexType `xml:"complexType"`
+	SimpleTypes        []*simpleType  `xml:"simpleType"`
+
+	// namespaces in scope of the schema, namespace by prefix
+	namespaces map[string]string
+}
+
+type element struct {
+	Name          string       `xml:"name,attr"`
+	Type          string       `xml:"type,attr"`
+	Ref           string       `xml:"ref,attr"`
+	MinOccurs     string       `xml:"minOccurs,attr"`
+	MaxOccurs     string       `xml:"maxOccurs,attr"`
+	Documentation string       `xml:"annotation>documentation"`
+	ComplexType   *complexType `xml:"complexType"`
+	SimpleType    *simpleType  `xml:"simpleType"`
+}
+
+type complexType struct {
+	Name           string       `xml:"name,attr"`
+	Documentation  string       `xml:"annotation>documentation"`
+	Sequence       *group       `xml:"sequence"`
+	Choice         *group       `xml:"choice"`
+	All            *group       `xml:"all"`
+	Attributes     []*attribute `xml:"attribute"`
+	ComplexContent *struct {
+		Extension   *extension `xml:"extension"`
+		Restriction *extension `xml:"restriction"`
+	} `xml:"complexContent"`
+	SimpleContent *struct {
+		Extension *extension `xml:"extension"`
+	} `xml:"simpleContent"`
+}
+
+type extension struct {
+	Base       string       `xml:"base,attr"`
+	Sequence   *group       `xml:"sequence"`
+	Choice     *group       `xml:"choice"`
+	Attributes []*attribute `xml:"attribute"`
+}
+
+type attribute struct {
+	Name       string      `xml:"name,attr"`
+	Ref        string      `xml:"ref,attr"`
+	Type       string      `xml:"type,attr"`
+	Use        string      `xml:"use,attr"`
+	SimpleType *simpleType `xml:"simpleType"`
+}
+
+type simpleType struct {
+	Name          string `xml:"name,attr"`
+	Documentation string `xml:"annotation>documentation"`
+	Restriction   *struct {
+		Base         string `xml:"base,attr"`
+		Enumerations []struct {
+			Value string `xml:"value,attr"`
+		} `xml:"enumeration"`
+	} `xml:"restriction"`
+	List *struct {
+		ItemType string `xml:"itemType,attr"`
+	} `xml:"list"`
+	Union *struct{} `xml:"union"`
+}
+
+// group is a sequence, a choice or an all model group, the particles are kept in the schema order
+type group struct {
+	Kind      string
+	MinOccurs string
+	MaxOccurs string
+	Particles []particle
+}
+
+// particle is one of element, nested group or xs:any
+type particle struct {
+	Element *element
+	Group   *group
+	Any     bool
+}
+
+func (g *group) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	g.Kind = start.Name.Local
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "minOccurs":
+			g.MinOccurs = attr.Value
+		case "maxOccurs":
+			g.MaxOccurs = attr.Value
+		}
+	}
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "element":
+				e := &element{}
+				if err := d.DecodeElement(e, &t); err != nil {
+					return err
+				}
+				g.Particles = append(g.Particles, particle{Element: e})
+			case "sequence", "choice", "all":
+				nested := &group{}
+				if err := d.DecodeElement(nested, &t); err != nil {
+					return err
+				}
+				g.Particles = append(g.Particles, particle{Group: nested})
+			case "any":
+				g.Particles = append(g.Particles, particle{Any: true})
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			default:
+				if err := d.Skip(); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// readDocument parses a WSDL or a XSD file
+func readDocument(path string) (*document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var defs definitions
+	switch root.XMLName.Local {
+	case "definitions":
+		if err := xml.Unmarshal(data, &defs); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	case "schema":
+		s := &schema{}
+		if err := xml.Unmarshal(data, s); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		defs.TargetNamespace = s.TargetNamespace
+		defs.Schemas = []*schema{s}
+	default:
+		return nil, fmt.Errorf("%s: unexpected root element %s", path, root.XMLName.Local)
+	}
+
+	doc := &document{
+		Path:            path,
+		TargetNamespace: defs.TargetNamespace,
+		Namespaces:      declarations(defs.Attrs),
+		Schemas:         defs.Schemas,
+		Messages:        map[string]message{},
+		PortTypes:       defs.PortTypes,
+	}
+	for _, s := range doc.Schemas {
+		s.namespaces = map[string]string{}
+		for prefix, namespace := range doc.Namespaces {
+			s.namespaces[prefix] = namespace
+		}
+		for prefix, namespace := range declarations(s.Attrs) {
+			s.namespaces[prefix] = namespace
+			if _, ok := doc.Namespaces[prefix]; !ok {
+				doc.Namespaces[prefix] = namespace
+			}
+		}
+	}
+	for _, m := range defs.Messages {
+		doc.Messages[m.Name] = m
+	}
+	return doc, nil
+}
+
+// declarations returns the xmlns:prefix attributes, namespace by prefix
+func declarations(attrs []xml.Attr) map[string]string {
+	namespaces := map[string]string{}
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" {
+			namespaces[attr.Name.Local] = attr.Value
+		}
+	}
+	return namespaces
+}
+
+// qname is a resolved qualified name
+type qname struct {
+	Space string
+	Local string
+}
+
+// resolve the prefixed name, ex: tt:ReferenceToken
+func resolve(namespaces map[string]string, name string) qname {
+	prefix, local := "", name
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		prefix, local = name[:i], name[i+1:]
+	}
+	return qname{Space: namespaces[prefix], Local: local}
+}
+
+// prefixOf returns the prefix declared for the namespace
+func (doc *document) prefixOf(namespace string) string {
+	for prefix, ns := range doc.Namespaces {
+		if ns == namespace && prefix != "" {
+			return prefix
+		}
+	}
+	return ""
+}
@@ -0,0 +1,496 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// goType is a generated type, a struct or a simple type with its constants
+type goType struct {
+	Name string
+	Doc  string
+	// Underlying type of the simple types
+	Underlying string
+	Consts     []goConst
+	Struct     bool
+	Fields     []*goField
+	// XMLName of the requests, ex: tth:GetConfiguration
+	XMLName string
+}
+
+type goConst struct {
+	Name  string
+	Value string
+}
+
+type goField struct {
+	Name     string
+	Type     string
+	Tag      string
+	Embedded bool
+}
+
+// op is a service operation with its request and response types
+type op struct {
+	Name     string
+	Doc      string
+	Request  *goType
+	Response *goType
+}
+
+type generator struct {
+	doc     *document
+	service string
+	prefix  string
+	onvif   map[string]kind
+
+	complexTypes map[qname]*complexType
+	simpleTypes  map[qname]*simpleType
+	elements     map[qname]*element
+	schemas      map[interface{}]*schema
+
+	// names of the schema items in the package
+	names  map[interface{}]string
+	idents map[string]bool
+
+	types    []*goType
+	main     []*goType
+	ops      []op
+	warnings []string
+}
+
+func newGenerator(doc *document, service string, onvif map[string]kind) (*generator, error) {
+	g := &generator{
+		doc:          doc,
+		service:      service,
+		onvif:        onvif,
+		complexTypes: map[qname]*complexType{},
+		simpleTypes:  map[qname]*simpleType{},
+		elements:     map[qname]*element{},
+		schemas:      map[interface{}]*schema{},
+		names:        map[interface{}]string{},
+		idents:       map[string]bool{},
+	}
+
+	for _, s := range doc.Schemas {
+		for _, st := range s.SimpleTypes {
+			g.simpleTypes[qname{s.TargetNamespace, st.Name}] = st
+			g.schemas[st] = s
+			g.names[st] = g.ident(exported(st.Name))
+		}
+		for _, ct := range s.ComplexTypes {
+			g.complexTypes[qname{s.TargetNamespace, ct.Name}] = ct
+			g.schemas[ct] = s
+			g.names[ct] = g.ident(exported(ct.Name))
+		}
+	}
+	for _, s := range doc.Schemas {
+		for _, e := range s.Elements {
+			g.elements[qname{s.TargetNamespace, e.Name}] = e
+			g.schemas[e] = s
+			if e.ComplexType != nil || e.Type == "" {
+				g.names[e] = g.ident(exported(e.Name))
+			}
+		}
+	}
+
+	if len(doc.PortTypes) > 0 {
+		if g.prefix = doc.prefixOf(doc.TargetNamespace); g.prefix == "" {
+			return nil, fmt.Errorf("%s: no prefix declared for %s", doc.Path, doc.TargetNamespace)
+		}
+	}
+	return g, nil
+}
+
+// ident reserves the identifier, the colliding names get a suffix
+func (g *generator) ident(name string) string {
+	ident := name
+	for i := 2; g.idents[ident]; i++ {
+		ident = name + strconv.Itoa(i)
+	}
+	g.idents[ident] = true
+	return ident
+}
+
+func (g *generator) warnf(format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+	for _, w := range g.warnings {
+		if w == warning {
+			return
+		}
+	}
+	g.warnings = append(g.warnings, warning)
+}
+
+// generate builds the types and the operations
+func (g *generator) generate() {
+	requests := map[*element]bool{}
+	generated := map[*element]bool{}
+	var pairs [][2]*element
+	for _, pt := range g.doc.PortTypes {
+		for _, o := range pt.Operations {
+			input, output := g.messageElement(o.Input.Message), g.messageElement(o.Output.Message)
+			if input == nil || output == nil {
+				g.warnf("operation %s skipped: the messages are not defined in the schema", o.Name)
+				continue
+			}
+			requests[input] = true
+			pairs = append(pairs, [2]*element{input, output})
+			g.ops = append(g.ops, op{Name: o.Name, Doc: o.Documentation})
+		}
+	}
+
+	for _, s := range g.doc.Schemas {
+		for _, st := range s.SimpleTypes {
+			g.types = append(g.types, g.simpleType(s, st))
+		}
+		for _, ct := range s.ComplexTypes {
+			t := &goType{Name: g.names[ct], Doc: ct.Documentation, Struct: true}
+			g.types = append(g.types, t)
+			g.fields(s, ct, t)
+		}
+	}
+
+	for i, pair := range pairs {
+		for j, e := range pair {
+			if generated[e] {
+				// the element is used by several operations
+				t := g.findMain(g.names[e])
+				if j == 0 {
+					g.ops[i].Request = t
+				} else {
+					g.ops[i].Response = t
+				}
+				continue
+			}
+			generated[e] = true
+			t := g.elementType(e, requests[e])
+			g.main = append(g.main, t)
+			if j == 0 {
+				g.ops[i].Request = t
+			} else {
+				g.ops[i].Response = t
+			}
+		}
+	}
+
+	// the other elements are used by references
+	for _, s := range g.doc.Schemas {
+		for _, e := range s.Elements {
+			if !generated[e] && g.names[e] != "" {
+				g.types = append(g.types, g.elementType(e, false))
+			}
+		}
+	}
+}
+
+func (g *generator) findMain(name string) *goType {
+	for _, t := range g.main {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// messageElement returns the element of the message part, the message is a prefixed name
+func (g *generator) messageElement(name string) *element {
+	m, ok := g.doc.Messages[resolve(g.doc.Namespaces, name).Local]
+	if !ok || len(m.Parts) != 1 {
+		return nil
+	}
+	return g.elements[resolve(g.doc.Namespaces, m.Parts[0].Element)]
+}
+
+// elementType generates the type of a top level element, the requests have XMLName
+func (g *generator) elementType(e *element, request bool) *goType {
+	s := g.schemas[e]
+	name := g.names[e]
+	if name == "" {
+		name = g.ident(exported(e.Name))
+		g.names[e] = name
+	}
+	t := &goType{Name: name, Doc: e.Documentation, Struct: true}
+	if request {
+		t.XMLName = g.prefix + ":" + e.Name
+	}
+	switch {
+	case e.ComplexType != nil:
+		g.fields(s, e.ComplexType, t)
+	case e.Type != "":
+		typ, k := g.typeOf(s, e.Type, false)
+		if k == kindStruct {
+			t.Fields = append(t.Fields, &goField{Name: typ[strings.LastIndexByte(typ, '.')+1:], Type: typ, Embedded: true})
+		} else {
+			t.Fields = append(t.Fields, &goField{Name: "Value", Type: typ, Tag: ",chardata"})
+		}
+	}
+	return t
+}
+
+func (g *generator) simpleType(s *schema, st *simpleType) *goType {
+	t := &goType{Name: g.names[st], Doc: st.Documentation, Underlying: "xsd.String"}
+	switch {
+	case st.Restriction != nil:
+		if base, k := g.typeOf(s, st.Restriction.Base, true); k != kindAny {
+			t.Underlying = base
+		}
+		seen := map[string]bool{}
+		for _, enum := range st.Restriction.Enumerations {
+			name := t.Name + exported(enum.Value)
+			if enum.Value == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+			t.Consts = append(t.Consts, goConst{Name: g.ident(name), Value: enum.Value})
+		}
+	case st.List != nil:
+		if t.Doc == "" {
+			t.Doc = "space separated list of " + resolve(s.namespaces, st.List.ItemType).Local
+		}
+	}
+	return t
+}
+
+// fields adds the fields of the complex type
+func (g *generator) fields(s *schema, ct *complexType, t *goType) {
+	f := &fieldSet{t: t, names: map[string]bool{}}
+	if cc := ct.ComplexContent; cc != nil {
+		if ext := cc.Extension; ext != nil {
+			if base, k := g.typeOf(s, ext.Base, false); k == kindStruct {
+				f.add(&goField{Name: base[strings.LastIndexByte(base, '.')+1:], Type: base, Embedded: true})
+			} else {
+				g.warnf("%s: base type %s is unknown, its content is not generated", t.Name, ext.Base)
+			}
+			g.group(s, ext.Sequence, false, false, f)
+			g.group(s, ext.Choice, false, false, f)
+			g.attributes(s, ext.Attributes, f)
+		}
+		if res := cc.Restriction; res != nil {
+			g.group(s, res.Sequence, false, false, f)
+			g.group(s, res.Choice, false, false, f)
+			g.attributes(s, res.Attributes, f)
+		}
+	}
+	if sc := ct.SimpleContent; sc != nil && sc.Extension != nil {
+		typ, k := g.typeOf(s, sc.Extension.Base, true)
+		if k == kindAny {
+			typ = "xsd.String"
+		}
+		f.add(&goField{Name: "Value", Type: typ, Tag: ",chardata"})
+		g.attributes(s, sc.Extension.Attributes, f)
+	}
+	g.group(s, ct.Sequence, false, false, f)
+	g.group(s, ct.Choice, false, false, f)
+	g.group(s, ct.All, false, false, f)
+	g.attributes(s, ct.Attributes, f)
+}
+
+func (g *generator) group(s *schema, grp *group, optional, repeated bool, f *fieldSet) {
+	if grp == nil {
+		return
+	}
+	optional = optional || grp.Kind == "choice" || grp.MinOccurs == "0"
+	repeated = repeated || many(grp.MaxOccurs)
+	for _, p := range grp.Particles {
+		switch {
+		case p.Element != nil:
+			g.element(s, p.Element, optional, repeated, f)
+		case p.Group != nil:
+			g.group(s, p.Group, optional, repeated, f)
+		case p.Any && !f.any:
+			f.any = true
+			f.add(&goField{Name: "Any", Type: "[]xsd.AnyElement", Tag: ",any"})
+		}
+	}
+}
+
+func (g *generator) element(s *schema, e *element, optional, repeated bool, f *fieldSet) {
+	name, space, typ, k := e.Name, "", "", kindAny
+	if s.ElementFormDefault == "qualified" {
+		space = s.TargetNamespace
+	}
+
+	switch {
+	case e.Ref != "":
+		q := resolve(s.namespaces, e.Ref)
+		name, space = q.Local, q.Space
+		if ref, ok := g.elements[q]; ok {
+			if ref.Type != "" {
+				typ, k = g.typeOf(g.schemas[ref], ref.Type, false)
+			} else {
+				typ, k = g.names[ref], kindStruct
+			}
+		} else {
+			typ, k = g.external(q, false)
+		}
+	case e.Type != "":
+		typ, k = g.typeOf(s, e.Type, false)
+	case e.ComplexType != nil:
+		nested := &goType{Name: g.ident(f.t.Name + exported(e.Name)), Doc: e.Documentation, Struct: true}
+		g.types = append(g.types, nested)
+		g.fields(s, e.ComplexType, nested)
+		typ, k = nested.Name, kindStruct
+	case e.SimpleType != nil && e.SimpleType.Restriction != nil:
+		typ, k = g.typeOf(s, e.SimpleType.Restriction.Base, true)
+	default:
+		typ = "xsd.AnyElement"
+	}
+
+	tag := name
+	if space != "" {
+		tag = space + " " + name
+	}
+	switch {
+	case repeated || many(e.MaxOccurs):
+		typ = "[]" + typ
+	case optional || e.MinOccurs == "0":
+		if k == kindStruct || k == kindValue {
+			typ = "*" + typ
+		}
+		if k != kindAny {
+			tag += ",omitempty"
+		}
+	}
+	f.add(&goField{Name: exported(name), Type: typ, Tag: tag})
+}
+
+func (g *generator) attributes(s *schema, attrs []*attribute, f *fieldSet) {
+	for _, a := range attrs {
+		name, typ, k := a.Name, "xsd.AnySimpleType", kindString
+		switch {
+		case a.Ref != "":
+			name = resolve(s.namespaces, a.Ref).Local
+			typ = "xsd.String"
+		case a.Type != "":
+			typ, k = g.typeOf(s, a.Type, true)
+		case a.SimpleType != nil && a.SimpleType.Restriction != nil:
+			typ, k = g.typeOf(s, a.SimpleType.Restriction.Base, true)
+		}
+
+		tag := name + ",attr"
+		if a.Use != "required" {
+			if k == kindValue {
+				typ = "*" + typ
+			}
+			tag += ",omitempty"
+		}
+		fieldName := exported(name)
+		if f.names[fieldName] {
+			fieldName += "Attr"
+		}
+		f.add(&goField{Name: fieldName, Type: typ, Tag: tag})
+	}
+}
+
+// typeOf returns the Go type of the prefixed schema type
+func (g *generator) typeOf(s *schema, name string, attr bool) (string, kind) {
+	q := resolve(s.namespaces, name)
+	if st, ok := g.simpleTypes[q]; ok {
+		return g.names[st], g.simpleKind(st, 0)
+	}
+	if ct, ok := g.complexTypes[q]; ok {
+		return g.names[ct], kindStruct
+	}
+	return g.external(q, attr)
+}
+
+// external returns the Go type of a type defined outside of the document
+func (g *generator) external(q qname, attr bool) (string, kind) {
+	switch {
+	case q.Space == nsXMLSchema:
+		typ, ok := builtins[q.Local]
+		if !ok {
+			typ = "xsd.AnySimpleType"
+		}
+		if attr && typ == "xsd.AnyElement" {
+			return "xsd.AnySimpleType", kindString
+		}
+		k, ok := builtinKinds[typ]
+		if !ok {
+			k = kindString
+		}
+		return typ, k
+	case q.Space == nsSchema || q.Space == nsPACS:
+		if k, ok := g.onvif[q.Local]; ok && (!attr || k != kindStruct) {
+			return "onvif." + q.Local, k
+		}
+	}
+	if attr {
+		return "xsd.AnySimpleType", kindString
+	}
+	g.warnf("type %s of %s is unknown, xsd.AnyElement is used", q.Local, q.Space)
+	return "xsd.AnyElement", kindAny
+}
+
+func (g *generator) simpleKind(st *simpleType, depth int) kind {
+	if st.Restriction == nil || depth > 16 {
+		return kindString
+	}
+	q := resolve(g.schemas[st].namespaces, st.Restriction.Base)
+	if base, ok := g.simpleTypes[q]; ok {
+		return g.simpleKind(base, depth+1)
+	}
+	_, k := g.external(q, true)
+	return k
+}
+
+// fieldSet keeps the field names of a struct unique
+type fieldSet struct {
+	t     *goType
+	names map[string]bool
+	any   bool
+}
+
+func (f *fieldSet) add(field *goField) {
+	name := field.Name
+	for i := 2; f.names[field.Name]; i++ {
+		field.Name = name + strconv.Itoa(i)
+	}
+	f.names[field.Name] = true
+	f.t.Fields = append(f.t.Fields, field)
+}
+
+// many reports whether maxOccurs allows several elements
+func many(maxOccurs string) bool {
+	if maxOccurs == "unbounded" {
+		return true
+	}
+	n, err := strconv.Atoi(maxOccurs)
+	return err == nil && n > 1
+}
+
+// exported returns the Go name of the XML name, ex: ip-v4 is IpV4
+func exported(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	ident := b.String()
+	if ident == "" || unicode.IsDigit(rune(ident[0])) {
+		ident = "X" + ident
+	}
+	return ident
+}
+
+// sortedKeys returns the keys of the map in order
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+)
+
+// ignored namespaces are not used in the SOAP messages
+var ignored = map[string]bool{
+	nsXMLSchema: true,
+	"http://www.w3.org/2001/XMLSchema-instance": true,
+	"http://www.w3.org/XML/1998/namespace":      true,
+	nsWSDL:                                      true,
+	nsSOAP12:                                    true,
+	nsSOAP:                                      true,
+}
+
+// fixedPrefixes are the prefixes chosen by the library, the schema types are declared in xsd/onvif,
+// xmime and xop are used by the schema types but the WSDLs do not declare them
+var fixedPrefixes = map[string]string{
+	"onvif": nsSchema,
+	"xmime": "http://www.w3.org/2005/05/xmlmime",
+	"xop":   "http://www.w3.org/2004/08/xop/include",
+}
+
+// namespaceTable returns the prefixes of the namespaces declared in the documents,
+// the most used prefix of each namespace is chosen
+func namespaceTable(docs []*document) (map[string]string, []string) {
+	table := map[string]string{}
+	used := map[string]bool{}
+	for prefix, namespace := range fixedPrefixes {
+		table[prefix] = namespace
+		used[namespace] = true
+	}
+
+	counts := map[string]map[string]int{}
+	var namespaces []string
+	for _, doc := range docs {
+		for prefix, namespace := range doc.Namespaces {
+			if prefix == "" || ignored[namespace] || used[namespace] {
+				continue
+			}
+			if counts[namespace] == nil {
+				counts[namespace] = map[string]int{}
+				namespaces = append(namespaces, namespace)
+			}
+			counts[namespace][prefix]++
+		}
+	}
+	sort.Strings(namespaces)
+
+	var warnings []string
+	for _, namespace := range namespaces {
+		prefixes := make([]string, 0, len(counts[namespace]))
+		for prefix := range counts[namespace] {
+			prefixes = append(prefixes, prefix)
+		}
+		sort.Slice(prefixes, func(i, j int) bool {
+			ci, cj := counts[namespace][prefixes[i]], counts[namespace][prefixes[j]]
+			if ci != cj {
+				return ci > cj
+			}
+			return prefixes[i] < prefixes[j]
+		})
+		chosen := ""
+		for _, prefix := range prefixes {
+			if _, taken := table[prefix]; !taken {
+				chosen = prefix
+				break
+			}
+		}
+		if chosen == "" {
+			warnings = append(warnings, fmt.Sprintf("namespace %s skipped: the prefixes %v are taken", namespace, prefixes))
+			continue
+		}
+		table[chosen] = namespace
+	}
+	return table, warnings
+}
+
+// renderXlmns returns the source of networking/xlmns.go
+func renderXlmns(table map[string]string) ([]byte, error) {
+	prefixes := make([]string, 0, len(table))
+	for prefix := range table {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by onvif-gen from the WSDL and XSD files. DO NOT EDIT.\n\npackage networking\n\n")
+	b.WriteString("//Xlmns XML Scheam\nvar Xlmns = map[string]string{\n")
+	for _, prefix := range prefixes {
+		fmt.Fprintf(&b, "%q: %q,\n", prefix, table[prefix])
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
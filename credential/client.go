@@ -0,0 +1,167 @@
+package credential
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Credential service (tcr).
+// The device must expose the credential endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	// PageSize is the Limit of the list requests of the GetAll methods, 0 means the device default
+	PageSize int
+
+	dev networking.RequestCreator
+}
+
+// NewClient return Credential service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the credential service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (ServiceCapabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetSupportedFormatTypes return the formats of the identifier type, ex: pt:Card
+func (c *Client) GetSupportedFormatTypes(ctx context.Context, identifierTypeName string) ([]CredentialIdentifierFormatTypeInfo, error) {
+	resp := GetSupportedFormatTypesResponse{}
+	if err := c.call(ctx, GetSupportedFormatTypes{CredentialIdentifierTypeName: identifierTypeName}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.FormatTypeInfo, nil
+}
+
+// GetCredentialInfo return the credentials info by tokens
+func (c *Client) GetCredentialInfo(ctx context.Context, tokens ...onvif.ReferenceToken) ([]CredentialInfo, error) {
+	resp := GetCredentialInfoResponse{}
+	if err := c.call(ctx, GetCredentialInfo{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CredentialInfo, nil
+}
+
+// GetCredentialInfoList return a page of the credentials info and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetCredentialInfoList(ctx context.Context, limit int, startReference string) ([]CredentialInfo, string, error) {
+	resp := GetCredentialInfoListResponse{}
+	if err := c.call(ctx, GetCredentialInfoList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.CredentialInfo, resp.NextStartReference, nil
+}
+
+// GetAllCredentialInfo return the credentials info of all pages, see PageSize
+func (c *Client) GetAllCredentialInfo(ctx context.Context) ([]CredentialInfo, error) {
+	return networking.GetAll(ctx, c.GetCredentialInfoList, c.PageSize)
+}
+
+// GetCredentials return the credentials by tokens
+func (c *Client) GetCredentials(ctx context.Context, tokens ...onvif.ReferenceToken) ([]Credential, error) {
+	resp := GetCredentialsResponse{}
+	if err := c.call(ctx, GetCredentials{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Credential, nil
+}
+
+// GetCredentialList return a page of the credentials and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetCredentialList(ctx context.Context, limit int, startReference string) ([]Credential, string, error) {
+	resp := GetCredentialListResponse{}
+	if err := c.call(ctx, GetCredentialList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.Credential, resp.NextStartReference, nil
+}
+
+// GetAllCredentials return the credentials of all pages, see PageSize
+func (c *Client) GetAllCredentials(ctx context.Context) ([]Credential, error) {
+	return networking.GetAll(ctx, c.GetCredentialList, c.PageSize)
+}
+
+// CreateCredential create the credential with the initial state and return its token
+func (c *Client) CreateCredential(ctx context.Context, credential Credential, state CredentialState) (onvif.ReferenceToken, error) {
+	resp := CreateCredentialResponse{}
+	err := c.call(ctx, CreateCredential{Credential: credential, State: state}, &resp)
+	return resp.Token, err
+}
+
+// ModifyCredential replace the credential by token
+func (c *Client) ModifyCredential(ctx context.Context, credential Credential) error {
+	return c.call(ctx, ModifyCredential{Credential: credential}, &ModifyCredentialResponse{})
+}
+
+// DeleteCredential delete the credential
+func (c *Client) DeleteCredential(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteCredential{Token: token}, &DeleteCredentialResponse{})
+}
+
+// GetCredentialState return state of the credential
+func (c *Client) GetCredentialState(ctx context.Context, token onvif.ReferenceToken) (CredentialState, error) {
+	resp := GetCredentialStateResponse{}
+	err := c.call(ctx, GetCredentialState{Token: token}, &resp)
+	return resp.State, err
+}
+
+// EnableCredential enable the credential, reason is optional
+func (c *Client) EnableCredential(ctx context.Context, token onvif.ReferenceToken, reason onvif.Name) error {
+	return c.call(ctx, EnableCredential{Token: token, Reason: reason}, &EnableCredentialResponse{})
+}
+
+// DisableCredential disable the credential, reason is optional
+func (c *Client) DisableCredential(ctx context.Context, token onvif.ReferenceToken, reason onvif.Name) error {
+	return c.call(ctx, DisableCredential{Token: token, Reason: reason}, &DisableCredentialResponse{})
+}
+
+// ResetAntipassbackViolation reset the antipassback violation of the credential
+func (c *Client) ResetAntipassbackViolation(ctx context.Context, credentialToken onvif.ReferenceToken) error {
+	return c.call(ctx, ResetAntipassbackViolation{CredentialToken: credentialToken}, &ResetAntipassbackViolationResponse{})
+}
+
+// GetCredentialIdentifiers return the identifiers of the credential
+func (c *Client) GetCredentialIdentifiers(ctx context.Context, credentialToken onvif.ReferenceToken) ([]CredentialIdentifier, error) {
+	resp := GetCredentialIdentifiersResponse{}
+	if err := c.call(ctx, GetCredentialIdentifiers{CredentialToken: credentialToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CredentialIdentifier, nil
+}
+
+// SetCredentialIdentifier add the identifier or replace the one of the same type
+func (c *Client) SetCredentialIdentifier(ctx context.Context, credentialToken onvif.ReferenceToken, identifier CredentialIdentifier) error {
+	return c.call(ctx, SetCredentialIdentifier{CredentialToken: credentialToken, CredentialIdentifier: identifier}, &SetCredentialIdentifierResponse{})
+}
+
+// DeleteCredentialIdentifier delete the identifier of the type from the credential
+func (c *Client) DeleteCredentialIdentifier(ctx context.Context, credentialToken onvif.ReferenceToken, typeName onvif.Name) error {
+	return c.call(ctx, DeleteCredentialIdentifier{CredentialToken: credentialToken, CredentialIdentifierTypeName: typeName}, &DeleteCredentialIdentifierResponse{})
+}
+
+// GetCredentialAccessProfiles return the access profiles of the credential
+func (c *Client) GetCredentialAccessProfiles(ctx context.Context, credentialToken onvif.ReferenceToken) ([]CredentialAccessProfile, error) {
+	resp := GetCredentialAccessProfilesResponse{}
+	if err := c.call(ctx, GetCredentialAccessProfiles{CredentialToken: credentialToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CredentialAccessProfile, nil
+}
+
+// SetCredentialAccessProfiles add the access profiles to the credential or update their validity
+func (c *Client) SetCredentialAccessProfiles(ctx context.Context, credentialToken onvif.ReferenceToken, profiles ...CredentialAccessProfile) error {
+	return c.call(ctx, SetCredentialAccessProfiles{CredentialToken: credentialToken, CredentialAccessProfile: profiles}, &SetCredentialAccessProfilesResponse{})
+}
+
+// DeleteCredentialAccessProfiles remove the access profiles from the credential
+func (c *Client) DeleteCredentialAccessProfiles(ctx context.Context, credentialToken onvif.ReferenceToken, accessProfileTokens ...onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteCredentialAccessProfiles{CredentialToken: credentialToken, AccessProfileToken: accessProfileTokens}, &DeleteCredentialAccessProfilesResponse{})
+}
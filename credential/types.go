@@ -0,0 +1,270 @@
+package credential
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+type ServiceCapabilities struct {
+	//MaxLimit is the max number of items the device returns in one GetXxxList response
+	MaxLimit                                 int  `xml:"MaxLimit,attr"`
+	CredentialValiditySupported              bool `xml:"CredentialValiditySupported,attr"`
+	CredentialAccessProfileValiditySupported bool `xml:"CredentialAccessProfileValiditySupported,attr"`
+	ValiditySupportsTimeValue                bool `xml:"ValiditySupportsTimeValue,attr"`
+	MaxCredentials                           int  `xml:"MaxCredentials,attr"`
+	MaxAccessProfilesPerCredential           int  `xml:"MaxAccessProfilesPerCredential,attr"`
+	ResetAntipassbackSupported               bool `xml:"ResetAntipassbackSupported,attr"`
+	//SupportedIdentifierType ex: pt:Card, pt:PIN
+	SupportedIdentifierType []onvif.Name
+}
+
+type CredentialInfo struct {
+	Token       onvif.ReferenceToken `xml:"token,attr"`
+	Description xsd.String
+	//CredentialHolderReference is the user or the person the credential is issued to
+	CredentialHolderReference string
+	ValidFrom                 xsd.DateTime
+	ValidTo                   xsd.DateTime
+}
+
+type CredentialIdentifierType struct {
+	Name       onvif.Name `xml:"http://www.onvif.org/ver10/credential/wsdl Name"`
+	FormatType string     `xml:"http://www.onvif.org/ver10/credential/wsdl FormatType"`
+}
+
+// Deprecated: use CredentialIdentifierType, it decodes the responses too.
+type CredentialIdentifierTypeResponse = CredentialIdentifierType
+
+type CredentialIdentifier struct {
+	Type                       CredentialIdentifierType `xml:"http://www.onvif.org/ver10/credential/wsdl Type"`
+	ExemptedFromAuthentication bool                     `xml:"http://www.onvif.org/ver10/credential/wsdl ExemptedFromAuthentication"`
+	Value                      xsd.HexBinary            `xml:"http://www.onvif.org/ver10/credential/wsdl Value"`
+}
+
+// Deprecated: use CredentialIdentifier, it decodes the responses too.
+type CredentialIdentifierResponse = CredentialIdentifier
+
+type CredentialAccessProfile struct {
+	AccessProfileToken onvif.ReferenceToken `xml:"http://www.onvif.org/ver10/credential/wsdl AccessProfileToken"`
+	ValidFrom          xsd.DateTime         `xml:"http://www.onvif.org/ver10/credential/wsdl ValidFrom,omitempty"`
+	ValidTo            xsd.DateTime         `xml:"http://www.onvif.org/ver10/credential/wsdl ValidTo,omitempty"`
+}
+
+// Deprecated: use CredentialAccessProfile, it decodes the responses too.
+type CredentialAccessProfileResponse = CredentialAccessProfile
+
+// Attribute is a vendor specific name value pair (pt:Attribute)
+type Attribute struct {
+	Name  string `xml:"Name,attr"`
+	Value string `xml:"Value,attr,omitempty"`
+}
+
+// Credential the token is empty in CreateCredential, the device assigns it
+type Credential struct {
+	Token                     onvif.ReferenceToken      `xml:"token,attr"`
+	Description               xsd.String                `xml:"http://www.onvif.org/ver10/credential/wsdl Description,omitempty"`
+	CredentialHolderReference string                    `xml:"http://www.onvif.org/ver10/credential/wsdl CredentialHolderReference"`
+	ValidFrom                 xsd.DateTime              `xml:"http://www.onvif.org/ver10/credential/wsdl ValidFrom,omitempty"`
+	ValidTo                   xsd.DateTime              `xml:"http://www.onvif.org/ver10/credential/wsdl ValidTo,omitempty"`
+	CredentialIdentifier      []CredentialIdentifier    `xml:"http://www.onvif.org/ver10/credential/wsdl CredentialIdentifier"`
+	CredentialAccessProfile   []CredentialAccessProfile `xml:"http://www.onvif.org/ver10/credential/wsdl CredentialAccessProfile,omitempty"`
+	Attribute                 []Attribute               `xml:"http://www.onvif.org/ver10/credential/wsdl Attribute,omitempty"`
+}
+
+// Deprecated: use Credential, it decodes the responses too.
+type CredentialResponse = Credential
+
+type AntipassbackState struct {
+	AntipassbackViolated bool `xml:"http://www.onvif.org/ver10/credential/wsdl AntipassbackViolated"`
+}
+
+// Deprecated: use AntipassbackState, it decodes the responses too.
+type AntipassbackStateResponse = AntipassbackState
+
+type CredentialState struct {
+	Enabled           bool               `xml:"http://www.onvif.org/ver10/credential/wsdl Enabled"`
+	Reason            onvif.Name         `xml:"http://www.onvif.org/ver10/credential/wsdl Reason,omitempty"`
+	AntipassbackState *AntipassbackState `xml:"http://www.onvif.org/ver10/credential/wsdl AntipassbackState,omitempty"`
+}
+
+// Deprecated: use CredentialState, it decodes the responses too.
+type CredentialStateResponse = CredentialState
+
+type CredentialIdentifierFormatTypeInfo struct {
+	FormatType  string
+	Description xsd.String
+}
+
+//Credential main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tcr:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetSupportedFormatTypes struct {
+	XMLName                      string `xml:"tcr:GetSupportedFormatTypes"`
+	CredentialIdentifierTypeName string `xml:"tcr:CredentialIdentifierTypeName"`
+}
+
+type GetSupportedFormatTypesResponse struct {
+	FormatTypeInfo []CredentialIdentifierFormatTypeInfo
+}
+
+type GetCredentialInfo struct {
+	XMLName string                 `xml:"tcr:GetCredentialInfo"`
+	Token   []onvif.ReferenceToken `xml:"tcr:Token"`
+}
+
+type GetCredentialInfoResponse struct {
+	CredentialInfo []CredentialInfo
+}
+
+type GetCredentialInfoList struct {
+	XMLName        string `xml:"tcr:GetCredentialInfoList"`
+	Limit          int    `xml:"tcr:Limit,omitempty"`
+	StartReference string `xml:"tcr:StartReference,omitempty"`
+}
+
+type GetCredentialInfoListResponse struct {
+	NextStartReference string
+	CredentialInfo     []CredentialInfo
+}
+
+type GetCredentials struct {
+	XMLName string                 `xml:"tcr:GetCredentials"`
+	Token   []onvif.ReferenceToken `xml:"tcr:Token"`
+}
+
+type GetCredentialsResponse struct {
+	Credential []Credential
+}
+
+type GetCredentialList struct {
+	XMLName        string `xml:"tcr:GetCredentialList"`
+	Limit          int    `xml:"tcr:Limit,omitempty"`
+	StartReference string `xml:"tcr:StartReference,omitempty"`
+}
+
+type GetCredentialListResponse struct {
+	NextStartReference string
+	Credential         []Credential
+}
+
+type CreateCredential struct {
+	XMLName    string          `xml:"tcr:CreateCredential"`
+	Credential Credential      `xml:"tcr:Credential"`
+	State      CredentialState `xml:"tcr:State"`
+}
+
+type CreateCredentialResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type ModifyCredential struct {
+	XMLName    string     `xml:"tcr:ModifyCredential"`
+	Credential Credential `xml:"tcr:Credential"`
+}
+
+type ModifyCredentialResponse struct {
+}
+
+type DeleteCredential struct {
+	XMLName string               `xml:"tcr:DeleteCredential"`
+	Token   onvif.ReferenceToken `xml:"tcr:Token"`
+}
+
+type DeleteCredentialResponse struct {
+}
+
+type GetCredentialState struct {
+	XMLName string               `xml:"tcr:GetCredentialState"`
+	Token   onvif.ReferenceToken `xml:"tcr:Token"`
+}
+
+type GetCredentialStateResponse struct {
+	State CredentialState
+}
+
+type EnableCredential struct {
+	XMLName string               `xml:"tcr:EnableCredential"`
+	Token   onvif.ReferenceToken `xml:"tcr:Token"`
+	Reason  onvif.Name           `xml:"tcr:Reason,omitempty"`
+}
+
+type EnableCredentialResponse struct {
+}
+
+type DisableCredential struct {
+	XMLName string               `xml:"tcr:DisableCredential"`
+	Token   onvif.ReferenceToken `xml:"tcr:Token"`
+	Reason  onvif.Name           `xml:"tcr:Reason,omitempty"`
+}
+
+type DisableCredentialResponse struct {
+}
+
+type ResetAntipassbackViolation struct {
+	XMLName         string               `xml:"tcr:ResetAntipassbackViolation"`
+	CredentialToken onvif.ReferenceToken `xml:"tcr:CredentialToken"`
+}
+
+type ResetAntipassbackViolationResponse struct {
+}
+
+type GetCredentialIdentifiers struct {
+	XMLName         string               `xml:"tcr:GetCredentialIdentifiers"`
+	CredentialToken onvif.ReferenceToken `xml:"tcr:CredentialToken"`
+}
+
+type GetCredentialIdentifiersResponse struct {
+	CredentialIdentifier []CredentialIdentifier
+}
+
+type SetCredentialIdentifier struct {
+	XMLName              string               `xml:"tcr:SetCredentialIdentifier"`
+	CredentialToken      onvif.ReferenceToken `xml:"tcr:CredentialToken"`
+	CredentialIdentifier CredentialIdentifier `xml:"tcr:CredentialIdentifier"`
+}
+
+type SetCredentialIdentifierResponse struct {
+}
+
+type DeleteCredentialIdentifier struct {
+	XMLName                      string               `xml:"tcr:DeleteCredentialIdentifier"`
+	CredentialToken              onvif.ReferenceToken `xml:"tcr:CredentialToken"`
+	CredentialIdentifierTypeName onvif.Name           `xml:"tcr:CredentialIdentifierTypeName"`
+}
+
+type DeleteCredentialIdentifierResponse struct {
+}
+
+type GetCredentialAccessProfiles struct {
+	XMLName         string               `xml:"tcr:GetCredentialAccessProfiles"`
+	CredentialToken onvif.ReferenceToken `xml:"tcr:CredentialToken"`
+}
+
+type GetCredentialAccessProfilesResponse struct {
+	CredentialAccessProfile []CredentialAccessProfile
+}
+
+type SetCredentialAccessProfiles struct {
+	XMLName                 string                    `xml:"tcr:SetCredentialAccessProfiles"`
+	CredentialToken         onvif.ReferenceToken      `xml:"tcr:CredentialToken"`
+	CredentialAccessProfile []CredentialAccessProfile `xml:"tcr:CredentialAccessProfile"`
+}
+
+type SetCredentialAccessProfilesResponse struct {
+}
+
+type DeleteCredentialAccessProfiles struct {
+	XMLName            string                 `xml:"tcr:DeleteCredentialAccessProfiles"`
+	CredentialToken    onvif.ReferenceToken   `xml:"tcr:CredentialToken"`
+	AccessProfileToken []onvif.ReferenceToken `xml:"tcr:AccessProfileToken"`
+}
+
+type DeleteCredentialAccessProfilesResponse struct {
+}
@@ -0,0 +1,163 @@
+package deviceio
+
+import (
+	"context"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the DeviceIO service (tmd): relays, digital inputs, serial ports and outputs.
+// The device must expose the deviceio endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return DeviceIO service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return the number of the inputs and the outputs of each kind
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetVideoSources return tokens of the video sources
+func (c *Client) GetVideoSources(ctx context.Context) ([]onvif.ReferenceToken, error) {
+	resp := GetVideoSourcesResponse{}
+	if err := c.call(ctx, GetVideoSources{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Token, nil
+}
+
+// GetAudioSources return tokens of the audio sources
+func (c *Client) GetAudioSources(ctx context.Context) ([]onvif.ReferenceToken, error) {
+	resp := GetAudioSourcesResponse{}
+	if err := c.call(ctx, GetAudioSources{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Token, nil
+}
+
+// GetAudioOutputs return tokens of the audio outputs
+func (c *Client) GetAudioOutputs(ctx context.Context) ([]onvif.ReferenceToken, error) {
+	resp := GetAudioOutputsResponse{}
+	if err := c.call(ctx, GetAudioOutputs{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Token, nil
+}
+
+// GetVideoOutputs return the video outputs with their layouts
+func (c *Client) GetVideoOutputs(ctx context.Context) ([]onvif.VideoOutput, error) {
+	resp := GetVideoOutputsResponse{}
+	if err := c.call(ctx, GetVideoOutputs{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.VideoOutputs, nil
+}
+
+// GetRelayOutputs return the relay outputs with their settings
+func (c *Client) GetRelayOutputs(ctx context.Context) ([]onvif.RelayOutput, error) {
+	resp := GetRelayOutputsResponse{}
+	if err := c.call(ctx, GetRelayOutputs{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.RelayOutputs, nil
+}
+
+// GetRelayOutputOptions return the supported modes and delay times of the relay, all the relays for the empty token
+func (c *Client) GetRelayOutputOptions(ctx context.Context, relayOutputToken onvif.ReferenceToken) ([]RelayOutputOptions, error) {
+	resp := GetRelayOutputOptionsResponse{}
+	if err := c.call(ctx, GetRelayOutputOptions{RelayOutputToken: relayOutputToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.RelayOutputOptions, nil
+}
+
+// SetRelayOutputSettings change mode, delay time and idle state of the relay
+func (c *Client) SetRelayOutputSettings(ctx context.Context, relayOutput onvif.RelayOutput) error {
+	return c.call(ctx, SetRelayOutputSettings{RelayOutput: relayOutput}, &SetRelayOutputSettingsResponse{})
+}
+
+// SetRelayOutputState switch the relay to RelayActive or RelayInactive
+func (c *Client) SetRelayOutputState(ctx context.Context, relayOutputToken onvif.ReferenceToken, state onvif.RelayLogicalState) error {
+	return c.call(ctx, SetRelayOutputState{RelayOutputToken: relayOutputToken, LogicalState: state}, &SetRelayOutputStateResponse{})
+}
+
+// GetDigitalInputs return the digital inputs with their idle states
+func (c *Client) GetDigitalInputs(ctx context.Context) ([]onvif.DigitalInput, error) {
+	resp := GetDigitalInputsResponse{}
+	if err := c.call(ctx, GetDigitalInputs{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.DigitalInputs, nil
+}
+
+// GetDigitalInputConfigurationOptions return the supported idle states of the input, all the inputs for the empty token
+func (c *Client) GetDigitalInputConfigurationOptions(ctx context.Context, token onvif.ReferenceToken) (DigitalInputConfigurationInputOptions, error) {
+	resp := GetDigitalInputConfigurationOptionsResponse{}
+	err := c.call(ctx, GetDigitalInputConfigurationOptions{Token: token}, &resp)
+	return resp.DigitalInputOptions, err
+}
+
+// SetDigitalInputConfigurations change idle states of the digital inputs
+func (c *Client) SetDigitalInputConfigurations(ctx context.Context, inputs ...onvif.DigitalInput) error {
+	return c.call(ctx, SetDigitalInputConfigurations{DigitalInputs: inputs}, &SetDigitalInputConfigurationsResponse{})
+}
+
+// GetSerialPorts return the serial ports
+func (c *Client) GetSerialPorts(ctx context.Context) ([]SerialPort, error) {
+	resp := GetSerialPortsResponse{}
+	if err := c.call(ctx, GetSerialPorts{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.SerialPort, nil
+}
+
+// GetSerialPortConfiguration return configuration of the serial port
+func (c *Client) GetSerialPortConfiguration(ctx context.Context, serialPortToken onvif.ReferenceToken) (SerialPortConfiguration, error) {
+	resp := GetSerialPortConfigurationResponse{}
+	err := c.call(ctx, GetSerialPortConfiguration{SerialPortToken: serialPortToken}, &resp)
+	return resp.SerialPortConfiguration, err
+}
+
+// SetSerialPortConfiguration change configuration of the serial port
+func (c *Client) SetSerialPortConfiguration(ctx context.Context, configuration SerialPortConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetSerialPortConfiguration{SerialPortConfiguration: configuration, ForcePersistance: forcePersistence}, &SetSerialPortConfigurationResponse{})
+}
+
+// GetSerialPortConfigurationOptions return the supported baud rates, parity bits etc. of the serial port
+func (c *Client) GetSerialPortConfigurationOptions(ctx context.Context, serialPortToken onvif.ReferenceToken) (SerialPortConfigurationOptions, error) {
+	resp := GetSerialPortConfigurationOptionsResponse{}
+	err := c.call(ctx, GetSerialPortConfigurationOptions{SerialPortToken: serialPortToken}, &resp)
+	return resp.SerialPortOptions, err
+}
+
+// SendReceiveSerialCommand send data to the serial port and return the reply,
+// the device reads the reply until timeout, dataLength bytes or delimiter, 0 values are omitted
+func (c *Client) SendReceiveSerialCommand(ctx context.Context, serialPortToken onvif.ReferenceToken, data *SerialData, timeout time.Duration, dataLength int, delimiter string) (SerialData, error) {
+	request := SendReceiveSerialCommand{
+		Token:      serialPortToken,
+		SerialData: data,
+		DataLength: dataLength,
+		Delimiter:  delimiter,
+	}
+	if timeout > 0 {
+		request.TimeOut = xsd.NewDuration(timeout)
+	}
+
+	resp := SendReceiveSerialCommandResponse{}
+	err := c.call(ctx, request, &resp)
+	return resp.SerialData, err
+}
@@ -0,0 +1,273 @@
+package deviceio
+
+import (
+	"encoding/base64"
+
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Relay modes (tt:RelayMode)
+const (
+	RelayModeMonostable onvif.RelayMode = "Monostable"
+	RelayModeBistable   onvif.RelayMode = "Bistable"
+)
+
+// Relay idle states (tt:RelayIdleState)
+const (
+	RelayIdleClosed onvif.RelayIdleState = "closed"
+	RelayIdleOpen   onvif.RelayIdleState = "open"
+)
+
+// Relay logical states of SetRelayOutputState (tt:RelayLogicalState)
+const (
+	RelayActive   onvif.RelayLogicalState = "active"
+	RelayInactive onvif.RelayLogicalState = "inactive"
+)
+
+// Digital input idle states (tt:DigitalIdleState)
+const (
+	DigitalIdleClosed onvif.DigitalIdleState = "closed"
+	DigitalIdleOpen   onvif.DigitalIdleState = "open"
+)
+
+// Serial port types (tmd:SerialPortType)
+const (
+	SerialRS232           = "RS232"
+	SerialRS422HalfDuplex = "RS422HalfDuplex"
+	SerialRS422FullDuplex = "RS422FullDuplex"
+	SerialRS485HalfDuplex = "RS485HalfDuplex"
+	SerialRS485FullDuplex = "RS485FullDuplex"
+	SerialGeneric         = "Generic"
+)
+
+type Capabilities struct {
+	VideoSources        int  `xml:"VideoSources,attr"`
+	VideoOutputs        int  `xml:"VideoOutputs,attr"`
+	AudioSources        int  `xml:"AudioSources,attr"`
+	AudioOutputs        int  `xml:"AudioOutputs,attr"`
+	RelayOutputs        int  `xml:"RelayOutputs,attr"`
+	SerialPorts         int  `xml:"SerialPorts,attr"`
+	DigitalInputs       int  `xml:"DigitalInputs,attr"`
+	DigitalInputOptions bool `xml:"DigitalInputOptions,attr"`
+}
+
+type RelayOutputOptions struct {
+	Token onvif.ReferenceToken `xml:"token,attr"`
+	Mode  []onvif.RelayMode
+	//DelayTimes space separated list of the delay times in seconds, a range when Discrete is false
+	DelayTimes string
+	Discrete   bool
+}
+
+type DigitalInputConfigurationInputOptions struct {
+	IdleState []onvif.DigitalIdleState
+}
+
+type SerialPort struct {
+	Token onvif.ReferenceToken `xml:"token,attr"`
+}
+
+type SerialPortConfiguration struct {
+	Token           onvif.ReferenceToken `xml:"token,attr"`
+	Type            string               `xml:"type,attr"`
+	BaudRate        int                  `xml:"http://www.onvif.org/ver10/deviceIO/wsdl BaudRate"`
+	ParityBit       string               `xml:"http://www.onvif.org/ver10/deviceIO/wsdl ParityBit"`
+	CharacterLength int                  `xml:"http://www.onvif.org/ver10/deviceIO/wsdl CharacterLength"`
+	StopBit         float64              `xml:"http://www.onvif.org/ver10/deviceIO/wsdl StopBit"`
+}
+
+// Deprecated: use SerialPortConfiguration, it decodes the responses too.
+type SerialPortConfigurationResponse = SerialPortConfiguration
+
+type SerialPortConfigurationOptions struct {
+	Token               onvif.ReferenceToken `xml:"token,attr"`
+	BaudRateList        onvif.IntList
+	ParityBitList       ParityBitList
+	CharacterLengthList onvif.IntList
+	StopBitList         FloatList
+}
+
+type ParityBitList struct {
+	Items []string
+}
+
+type FloatList struct {
+	Items []float64
+}
+
+// SerialData is either Binary (base64) or String
+type SerialData struct {
+	Binary xsd.Base64Binary `xml:"http://www.onvif.org/ver10/deviceIO/wsdl Binary,omitempty"`
+	String string           `xml:"http://www.onvif.org/ver10/deviceIO/wsdl String,omitempty"`
+}
+
+// Deprecated: use SerialData, it decodes the responses too.
+type SerialDataResponse = SerialData
+
+// NewBinarySerialData return SerialData with data encoded into Binary
+func NewBinarySerialData(data []byte) SerialData {
+	return SerialData{Binary: xsd.Base64Binary(base64.StdEncoding.EncodeToString(data))}
+}
+
+// Bytes return the decoded Binary or String as is
+func (d SerialData) Bytes() ([]byte, error) {
+	if len(d.Binary) > 0 {
+		return base64.StdEncoding.DecodeString(string(d.Binary))
+	}
+	return []byte(d.String), nil
+}
+
+//DeviceIO main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tmd:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type GetVideoSources struct {
+	XMLName string `xml:"tmd:GetVideoSources"`
+}
+
+type GetVideoSourcesResponse struct {
+	Token []onvif.ReferenceToken
+}
+
+type GetAudioSources struct {
+	XMLName string `xml:"tmd:GetAudioSources"`
+}
+
+type GetAudioSourcesResponse struct {
+	Token []onvif.ReferenceToken
+}
+
+type GetAudioOutputs struct {
+	XMLName string `xml:"tmd:GetAudioOutputs"`
+}
+
+type GetAudioOutputsResponse struct {
+	Token []onvif.ReferenceToken
+}
+
+type GetVideoOutputs struct {
+	XMLName string `xml:"tmd:GetVideoOutputs"`
+}
+
+type GetVideoOutputsResponse struct {
+	VideoOutputs []onvif.VideoOutput
+}
+
+// GetRelayOutputs is the device service operation served by the deviceio endpoint
+type GetRelayOutputs struct {
+	XMLName string `xml:"tds:GetRelayOutputs"`
+}
+
+type GetRelayOutputsResponse struct {
+	RelayOutputs []onvif.RelayOutput
+}
+
+type GetRelayOutputOptions struct {
+	XMLName          string               `xml:"tmd:GetRelayOutputOptions"`
+	RelayOutputToken onvif.ReferenceToken `xml:"tmd:RelayOutputToken,omitempty"`
+}
+
+type GetRelayOutputOptionsResponse struct {
+	RelayOutputOptions []RelayOutputOptions
+}
+
+type SetRelayOutputSettings struct {
+	XMLName     string            `xml:"tmd:SetRelayOutputSettings"`
+	RelayOutput onvif.RelayOutput `xml:"tmd:RelayOutput"`
+}
+
+type SetRelayOutputSettingsResponse struct {
+}
+
+// SetRelayOutputState is the device service operation served by the deviceio endpoint
+type SetRelayOutputState struct {
+	XMLName          string                  `xml:"tds:SetRelayOutputState"`
+	RelayOutputToken onvif.ReferenceToken    `xml:"tds:RelayOutputToken"`
+	LogicalState     onvif.RelayLogicalState `xml:"tds:LogicalState"`
+}
+
+type SetRelayOutputStateResponse struct {
+}
+
+type GetDigitalInputs struct {
+	XMLName string `xml:"tmd:GetDigitalInputs"`
+}
+
+type GetDigitalInputsResponse struct {
+	DigitalInputs []onvif.DigitalInput
+}
+
+type GetDigitalInputConfigurationOptions struct {
+	XMLName string               `xml:"tmd:GetDigitalInputConfigurationOptions"`
+	Token   onvif.ReferenceToken `xml:"tmd:Token,omitempty"`
+}
+
+type GetDigitalInputConfigurationOptionsResponse struct {
+	DigitalInputOptions DigitalInputConfigurationInputOptions
+}
+
+type SetDigitalInputConfigurations struct {
+	XMLName       string               `xml:"tmd:SetDigitalInputConfigurations"`
+	DigitalInputs []onvif.DigitalInput `xml:"tmd:DigitalInputs"`
+}
+
+type SetDigitalInputConfigurationsResponse struct {
+}
+
+type GetSerialPorts struct {
+	XMLName string `xml:"tmd:GetSerialPorts"`
+}
+
+type GetSerialPortsResponse struct {
+	SerialPort []SerialPort
+}
+
+type GetSerialPortConfiguration struct {
+	XMLName         string               `xml:"tmd:GetSerialPortConfiguration"`
+	SerialPortToken onvif.ReferenceToken `xml:"tmd:SerialPortToken"`
+}
+
+type GetSerialPortConfigurationResponse struct {
+	SerialPortConfiguration SerialPortConfiguration
+}
+
+type SetSerialPortConfiguration struct {
+	XMLName                 string                  `xml:"tmd:SetSerialPortConfiguration"`
+	SerialPortConfiguration SerialPortConfiguration `xml:"tmd:SerialPortConfiguration"`
+	//ForcePersistance is spelled as in the wsdl
+	ForcePersistance bool `xml:"tmd:ForcePersistance"`
+}
+
+type SetSerialPortConfigurationResponse struct {
+}
+
+type GetSerialPortConfigurationOptions struct {
+	XMLName         string               `xml:"tmd:GetSerialPortConfigurationOptions"`
+	SerialPortToken onvif.ReferenceToken `xml:"tmd:SerialPortToken"`
+}
+
+type GetSerialPortConfigurationOptionsResponse struct {
+	SerialPortOptions SerialPortConfigurationOptions
+}
+
+// SendReceiveSerialCommand without SerialData only receives, the device stops reading
+// after TimeOut, DataLength bytes or Delimiter
+type SendReceiveSerialCommand struct {
+	XMLName    string               `xml:"tmd:SendReceiveSerialCommand"`
+	Token      onvif.ReferenceToken `xml:"tmd:Token,omitempty"`
+	SerialData *SerialData          `xml:"tmd:SerialData,omitempty"`
+	TimeOut    xsd.Duration         `xml:"tmd:TimeOut,omitempty"`
+	DataLength int                  `xml:"tmd:DataLength,omitempty"`
+	Delimiter  string               `xml:"tmd:Delimiter,omitempty"`
+}
+
+type SendReceiveSerialCommandResponse struct {
+	SerialData SerialData
+}
@@ -0,0 +1,88 @@
+package display
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Display service (tls): layouts and panes of the video outputs.
+// The panes show the streams of the receivers, see the receiver package.
+// The device must expose the display endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Display service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the display service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetLayout return the panes layout of the video output
+func (c *Client) GetLayout(ctx context.Context, videoOutput onvif.ReferenceToken) (onvif.Layout, error) {
+	resp := GetLayoutResponse{}
+	err := c.call(ctx, GetLayout{VideoOutput: videoOutput}, &resp)
+	return resp.Layout, err
+}
+
+// SetLayout set the panes layout of the video output, the areas are in [-1, 1] coordinates of the output
+func (c *Client) SetLayout(ctx context.Context, videoOutput onvif.ReferenceToken, layout onvif.Layout) error {
+	return c.call(ctx, SetLayout{VideoOutput: videoOutput, Layout: layout}, &SetLayoutResponse{})
+}
+
+// GetDisplayOptions return the layout options and the decoding capabilities of the video output
+func (c *Client) GetDisplayOptions(ctx context.Context, videoOutput onvif.ReferenceToken) (GetDisplayOptionsResponse, error) {
+	resp := GetDisplayOptionsResponse{}
+	err := c.call(ctx, GetDisplayOptions{VideoOutput: videoOutput}, &resp)
+	return resp, err
+}
+
+// GetPaneConfigurations return the configurations of all panes of the video output
+func (c *Client) GetPaneConfigurations(ctx context.Context, videoOutput onvif.ReferenceToken) ([]onvif.PaneConfiguration, error) {
+	resp := GetPaneConfigurationsResponse{}
+	if err := c.call(ctx, GetPaneConfigurations{VideoOutput: videoOutput}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PaneConfiguration, nil
+}
+
+// GetPaneConfiguration return the configuration of the pane
+func (c *Client) GetPaneConfiguration(ctx context.Context, videoOutput, pane onvif.ReferenceToken) (onvif.PaneConfiguration, error) {
+	resp := GetPaneConfigurationResponse{}
+	err := c.call(ctx, GetPaneConfiguration{VideoOutput: videoOutput, Pane: pane}, &resp)
+	return resp.PaneConfiguration, err
+}
+
+// SetPaneConfigurations set the configurations of several panes at once, ex: to swap the receivers of two panes
+func (c *Client) SetPaneConfigurations(ctx context.Context, videoOutput onvif.ReferenceToken, configurations ...onvif.PaneConfiguration) error {
+	return c.call(ctx, SetPaneConfigurations{VideoOutput: videoOutput, PaneConfiguration: configurations}, &SetPaneConfigurationsResponse{})
+}
+
+// SetPaneConfiguration set the configuration of the pane
+func (c *Client) SetPaneConfiguration(ctx context.Context, videoOutput onvif.ReferenceToken, configuration onvif.PaneConfiguration) error {
+	return c.call(ctx, SetPaneConfiguration{VideoOutput: videoOutput, PaneConfiguration: configuration}, &SetPaneConfigurationResponse{})
+}
+
+// CreatePaneConfiguration create the pane of the video output and return its token, the configuration token may be empty
+func (c *Client) CreatePaneConfiguration(ctx context.Context, videoOutput onvif.ReferenceToken, configuration onvif.PaneConfiguration) (onvif.ReferenceToken, error) {
+	resp := CreatePaneConfigurationResponse{}
+	err := c.call(ctx, CreatePaneConfiguration{VideoOutput: videoOutput, PaneConfiguration: configuration}, &resp)
+	return resp.PaneToken, err
+}
+
+// DeletePaneConfiguration delete the pane of the video output
+func (c *Client) DeletePaneConfiguration(ctx context.Context, videoOutput, paneToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeletePaneConfiguration{VideoOutput: videoOutput, PaneToken: paneToken}, &DeletePaneConfigurationResponse{})
+}
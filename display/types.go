@@ -0,0 +1,102 @@
+package display
+
+import "github.com/neirolis/onvif-go/xsd/onvif"
+
+type Capabilities struct {
+	//FixedLayout the layout of the video outputs can't be changed
+	FixedLayout bool `xml:"FixedLayout,attr"`
+}
+
+//Display main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tls:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type GetLayout struct {
+	XMLName     string               `xml:"tls:GetLayout"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+}
+
+type GetLayoutResponse struct {
+	Layout onvif.Layout
+}
+
+type SetLayout struct {
+	XMLName     string               `xml:"tls:SetLayout"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	Layout      onvif.Layout         `xml:"tls:Layout"`
+}
+
+type SetLayoutResponse struct {
+}
+
+type GetDisplayOptions struct {
+	XMLName     string               `xml:"tls:GetDisplayOptions"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+}
+
+type GetDisplayOptionsResponse struct {
+	LayoutOptions      *onvif.LayoutOptions
+	CodingCapabilities onvif.CodingCapabilities
+}
+
+type GetPaneConfigurations struct {
+	XMLName     string               `xml:"tls:GetPaneConfigurations"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+}
+
+type GetPaneConfigurationsResponse struct {
+	PaneConfiguration []onvif.PaneConfiguration
+}
+
+type GetPaneConfiguration struct {
+	XMLName     string               `xml:"tls:GetPaneConfiguration"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	Pane        onvif.ReferenceToken `xml:"tls:Pane"`
+}
+
+type GetPaneConfigurationResponse struct {
+	PaneConfiguration onvif.PaneConfiguration
+}
+
+type SetPaneConfigurations struct {
+	XMLName           string                    `xml:"tls:SetPaneConfigurations"`
+	VideoOutput       onvif.ReferenceToken      `xml:"tls:VideoOutput"`
+	PaneConfiguration []onvif.PaneConfiguration `xml:"tls:PaneConfiguration"`
+}
+
+type SetPaneConfigurationsResponse struct {
+}
+
+type SetPaneConfiguration struct {
+	XMLName           string                  `xml:"tls:SetPaneConfiguration"`
+	VideoOutput       onvif.ReferenceToken    `xml:"tls:VideoOutput"`
+	PaneConfiguration onvif.PaneConfiguration `xml:"tls:PaneConfiguration"`
+}
+
+type SetPaneConfigurationResponse struct {
+}
+
+type CreatePaneConfiguration struct {
+	XMLName           string                  `xml:"tls:CreatePaneConfiguration"`
+	VideoOutput       onvif.ReferenceToken    `xml:"tls:VideoOutput"`
+	PaneConfiguration onvif.PaneConfiguration `xml:"tls:PaneConfiguration"`
+}
+
+type CreatePaneConfigurationResponse struct {
+	PaneToken onvif.ReferenceToken
+}
+
+type DeletePaneConfiguration struct {
+	XMLName     string               `xml:"tls:DeletePaneConfiguration"`
+	VideoOutput onvif.ReferenceToken `xml:"tls:VideoOutput"`
+	PaneToken   onvif.ReferenceToken `xml:"tls:PaneToken"`
+}
+
+type DeletePaneConfigurationResponse struct {
+}
@@ -0,0 +1,110 @@
+package doorcontrol
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the DoorControl service (tdc).
+// The device must expose the doorcontrol endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	// PageSize is the Limit of the list requests of the GetAll methods, 0 means the device default
+	PageSize int
+
+	dev networking.RequestCreator
+}
+
+// NewClient return DoorControl service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the door control service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (ServiceCapabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetDoorInfoList return a page of the doors and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetDoorInfoList(ctx context.Context, limit int, startReference string) ([]DoorInfo, string, error) {
+	resp := GetDoorInfoListResponse{}
+	if err := c.call(ctx, GetDoorInfoList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.DoorInfo, resp.NextStartReference, nil
+}
+
+// GetAllDoorInfo return the doors info of all pages, see PageSize
+func (c *Client) GetAllDoorInfo(ctx context.Context) ([]DoorInfo, error) {
+	return networking.GetAll(ctx, c.GetDoorInfoList, c.PageSize)
+}
+
+// GetDoorInfo return the doors by tokens
+func (c *Client) GetDoorInfo(ctx context.Context, tokens ...onvif.ReferenceToken) ([]DoorInfo, error) {
+	resp := GetDoorInfoResponse{}
+	if err := c.call(ctx, GetDoorInfo{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.DoorInfo, nil
+}
+
+// GetDoorState return the physical state, alarms and mode of the door
+func (c *Client) GetDoorState(ctx context.Context, token onvif.ReferenceToken) (DoorState, error) {
+	resp := GetDoorStateResponse{}
+	err := c.call(ctx, GetDoorState{Token: token}, &resp)
+	return resp.DoorState, err
+}
+
+// AccessDoor grant a momentary access, the door is unlocked and locked again after the access time.
+// Token is the only required field of request.
+func (c *Client) AccessDoor(ctx context.Context, request AccessDoor) error {
+	return c.call(ctx, request, &AccessDoorResponse{})
+}
+
+// LockDoor lock the door
+func (c *Client) LockDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, LockDoor{Token: token}, &LockDoorResponse{})
+}
+
+// UnlockDoor unlock the door until it is locked again
+func (c *Client) UnlockDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, UnlockDoor{Token: token}, &UnlockDoorResponse{})
+}
+
+// BlockDoor lock the door and deny the access requests
+func (c *Client) BlockDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, BlockDoor{Token: token}, &BlockDoorResponse{})
+}
+
+// LockDownDoor lock the door and ignore the other commands until LockDownReleaseDoor
+func (c *Client) LockDownDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, LockDownDoor{Token: token}, &LockDownDoorResponse{})
+}
+
+// LockDownReleaseDoor release the lock down of the door
+func (c *Client) LockDownReleaseDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, LockDownReleaseDoor{Token: token}, &LockDownReleaseDoorResponse{})
+}
+
+// LockOpenDoor unlock the door and ignore the other commands until LockOpenReleaseDoor
+func (c *Client) LockOpenDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, LockOpenDoor{Token: token}, &LockOpenDoorResponse{})
+}
+
+// LockOpenReleaseDoor release the lock open of the door
+func (c *Client) LockOpenReleaseDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, LockOpenReleaseDoor{Token: token}, &LockOpenReleaseDoorResponse{})
+}
+
+// DoubleLockDoor lock the door with the double lock
+func (c *Client) DoubleLockDoor(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DoubleLockDoor{Token: token}, &DoubleLockDoorResponse{})
+}
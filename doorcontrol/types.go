@@ -0,0 +1,188 @@
+package doorcontrol
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Door modes (tdc:DoorMode)
+const (
+	DoorModeUnknown      = "Unknown"
+	DoorModeLocked       = "Locked"
+	DoorModeUnlocked     = "Unlocked"
+	DoorModeAccessed     = "Accessed"
+	DoorModeBlocked      = "Blocked"
+	DoorModeLockedDown   = "LockedDown"
+	DoorModeLockedOpen   = "LockedOpen"
+	DoorModeDoubleLocked = "DoubleLocked"
+)
+
+type ServiceCapabilities struct {
+	//MaxLimit is the max number of items the device returns in one GetXxxList response
+	MaxLimit uint32 `xml:"MaxLimit,attr"`
+}
+
+type DoorCapabilities struct {
+	Access               bool `xml:"Access,attr"`
+	AccessTimingOverride bool `xml:"AccessTimingOverride,attr"`
+	Lock                 bool `xml:"Lock,attr"`
+	Unlock               bool `xml:"Unlock,attr"`
+	Block                bool `xml:"Block,attr"`
+	DoubleLock           bool `xml:"DoubleLock,attr"`
+	LockDown             bool `xml:"LockDown,attr"`
+	LockOpen             bool `xml:"LockOpen,attr"`
+	DoorMonitor          bool `xml:"DoorMonitor,attr"`
+	LockMonitor          bool `xml:"LockMonitor,attr"`
+	DoubleLockMonitor    bool `xml:"DoubleLockMonitor,attr"`
+	Alarm                bool `xml:"Alarm,attr"`
+	Tamper               bool `xml:"Tamper,attr"`
+	Fault                bool `xml:"Fault,attr"`
+}
+
+type DoorInfo struct {
+	Token        onvif.ReferenceToken `xml:"token,attr"`
+	Name         onvif.Name
+	Description  xsd.String
+	Capabilities DoorCapabilities
+}
+
+type DoorTamper struct {
+	Reason string
+	//State Unknown, NotInTamper or TamperDetected
+	State string
+}
+
+type DoorFault struct {
+	Reason string
+	//State Unknown, NotInFault or FaultDetected
+	State string
+}
+
+type DoorState struct {
+	//DoorPhysicalState Unknown, Open, Closed or Fault
+	DoorPhysicalState string
+	//LockPhysicalState Unknown, Locked, Unlocked or Fault
+	LockPhysicalState       string
+	DoubleLockPhysicalState string
+	//Alarm Normal, DoorForcedOpen or DoorOpenTooLong
+	Alarm    string
+	Tamper   DoorTamper
+	Fault    DoorFault
+	DoorMode string
+}
+
+//DoorControl main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tdc:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetDoorInfoList struct {
+	XMLName        string `xml:"tdc:GetDoorInfoList"`
+	Limit          int    `xml:"tdc:Limit,omitempty"`
+	StartReference string `xml:"tdc:StartReference,omitempty"`
+}
+
+type GetDoorInfoListResponse struct {
+	NextStartReference string
+	DoorInfo           []DoorInfo
+}
+
+type GetDoorInfo struct {
+	XMLName string                 `xml:"tdc:GetDoorInfo"`
+	Token   []onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type GetDoorInfoResponse struct {
+	DoorInfo []DoorInfo
+}
+
+type GetDoorState struct {
+	XMLName string               `xml:"tdc:GetDoorState"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type GetDoorStateResponse struct {
+	DoorState DoorState
+}
+
+// AccessDoor the optional times override the door configuration for this access only
+type AccessDoor struct {
+	XMLName         string               `xml:"tdc:AccessDoor"`
+	Token           onvif.ReferenceToken `xml:"tdc:Token"`
+	UseExtendedTime *bool                `xml:"tdc:UseExtendedTime,omitempty"`
+	AccessTime      xsd.Duration         `xml:"tdc:AccessTime,omitempty"`
+	OpenTooLongTime xsd.Duration         `xml:"tdc:OpenTooLongTime,omitempty"`
+	PreAlarmTime    xsd.Duration         `xml:"tdc:PreAlarmTime,omitempty"`
+}
+
+type AccessDoorResponse struct {
+}
+
+type LockDoor struct {
+	XMLName string               `xml:"tdc:LockDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type LockDoorResponse struct {
+}
+
+type UnlockDoor struct {
+	XMLName string               `xml:"tdc:UnlockDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type UnlockDoorResponse struct {
+}
+
+type BlockDoor struct {
+	XMLName string               `xml:"tdc:BlockDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type BlockDoorResponse struct {
+}
+
+type LockDownDoor struct {
+	XMLName string               `xml:"tdc:LockDownDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type LockDownDoorResponse struct {
+}
+
+type LockDownReleaseDoor struct {
+	XMLName string               `xml:"tdc:LockDownReleaseDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type LockDownReleaseDoorResponse struct {
+}
+
+type LockOpenDoor struct {
+	XMLName string               `xml:"tdc:LockOpenDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type LockOpenDoorResponse struct {
+}
+
+type LockOpenReleaseDoor struct {
+	XMLName string               `xml:"tdc:LockOpenReleaseDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type LockOpenReleaseDoorResponse struct {
+}
+
+type DoubleLockDoor struct {
+	XMLName string               `xml:"tdc:DoubleLockDoor"`
+	Token   onvif.ReferenceToken `xml:"tdc:Token"`
+}
+
+type DoubleLockDoorResponse struct {
+}
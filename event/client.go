@@ -0,0 +1,136 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+// Client is a typed client of the Event service.
+// Subscription methods take the subscription reference returned by CreatePullPointSubscription or Subscribe,
+// the request is sent to its address instead of the events endpoint, with its reference parameters in the header.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Event service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+func (c *Client) callReference(ctx context.Context, reference EndpointReferenceTypeResponse, method, response interface{}) error {
+	req := c.dev.CreateRequest(method).WithContext(ctx).WithEndpoint(c.Address(string(reference.Address)))
+	for _, block := range reference.ReferenceParameters.headers() {
+		req.WithHeader(block)
+	}
+	return req.Do().Unmarshal(response)
+}
+
+// Address return the subscription reference address rewritten by the device NAT rules (ReplaceHostToXAddr).
+// The subscription is served by the web server of the device xaddr, so unlike the media uris (ReplaceHostKeepPort) the port is replaced too.
+func (c *Client) Address(address string) string {
+	if replacer, ok := c.dev.(networking.HostReplacer); ok {
+		if replaced, err := replacer.ReplaceHostToXAddr(address); err == nil {
+			return replaced
+		}
+	}
+	return address
+}
+
+// GetServiceCapabilities return capabilities of the event service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetEventProperties return the topics and the filter dialects supported by the device
+func (c *Client) GetEventProperties(ctx context.Context) (GetEventPropertiesResponse, error) {
+	resp := GetEventPropertiesResponse{}
+	err := c.call(ctx, GetEventProperties{}, &resp)
+	return resp, err
+}
+
+// CreatePullPointSubscription create a pull point, filter is optional, zero terminationTime uses the device default
+func (c *Client) CreatePullPointSubscription(ctx context.Context, filter *FilterType, terminationTime time.Duration) (CreatePullPointSubscriptionResponse, error) {
+	resp := CreatePullPointSubscriptionResponse{}
+	err := c.call(ctx, CreatePullPointSubscription{
+		Filter:                 filter,
+		InitialTerminationTime: relativeTime(terminationTime),
+	}, &resp)
+	return resp, err
+}
+
+// PullMessages wait up to timeout for the messages of the pull point
+func (c *Client) PullMessages(ctx context.Context, reference EndpointReferenceTypeResponse, timeout time.Duration, messageLimit int) (PullMessagesResponse, error) {
+	resp := PullMessagesResponse{}
+	err := c.callReference(ctx, reference, PullMessages{
+		Timeout:      xsd.NewDuration(timeout),
+		MessageLimit: xsd.Int(messageLimit),
+	}, &resp)
+	return resp, err
+}
+
+// Seek reposition the pull point to utcTime
+func (c *Client) Seek(ctx context.Context, reference EndpointReferenceTypeResponse, utcTime time.Time, reverse bool) error {
+	return c.callReference(ctx, reference, Seek{
+		UtcTime: xsd.DateTime(utcTime.UTC().Format(time.RFC3339)),
+		Reverse: xsd.Boolean(reverse),
+	}, &SeekResponse{})
+}
+
+// SetSynchronizationPoint request the current state of all the properties from the pull point
+func (c *Client) SetSynchronizationPoint(ctx context.Context, reference EndpointReferenceTypeResponse) error {
+	return c.callReference(ctx, reference, SetSynchronizationPoint{}, &SetSynchronizationPointResponse{})
+}
+
+// Subscribe create a push subscription, the device sends Notify to consumer address
+func (c *Client) Subscribe(ctx context.Context, consumer string, filter *FilterType, terminationTime time.Duration) (SubscribeResponse, error) {
+	resp := SubscribeResponse{}
+	err := c.call(ctx, Subscribe{
+		ConsumerReference:      EndpointReferenceType{Address: AttributedURIType(consumer)},
+		Filter:                 filter,
+		InitialTerminationTime: relativeTime(terminationTime),
+	}, &resp)
+	return resp, err
+}
+
+// Renew extend the subscription by terminationTime
+func (c *Client) Renew(ctx context.Context, reference EndpointReferenceTypeResponse, terminationTime time.Duration) (RenewResponse, error) {
+	resp := RenewResponse{}
+	err := c.callReference(ctx, reference, Renew{TerminationTime: relativeTime(terminationTime)}, &resp)
+	return resp, err
+}
+
+// Unsubscribe delete the subscription
+func (c *Client) Unsubscribe(ctx context.Context, reference EndpointReferenceTypeResponse) error {
+	return c.callReference(ctx, reference, Unsubscribe{}, &UnsubscribeResponse{})
+}
+
+// relativeTime return duration form of AbsoluteOrRelativeTimeType, empty for zero d
+func relativeTime(d time.Duration) TerminationTime {
+	if d <= 0 {
+		return ""
+	}
+	return TerminationTime(xsd.NewDuration(d))
+}
+
+// Remaining return the time left until terminationTime measured by the device clock (currentTime),
+// so it does not depend on the clock difference between the device and the host.
+func Remaining(currentTime CurrentTime, terminationTime TerminationTime) (time.Duration, error) {
+	current, err := xsd.DateTime(currentTime).Time()
+	if err != nil {
+		return 0, err
+	}
+	termination, err := xsd.DateTime(terminationTime).Time()
+	if err != nil {
+		return 0, err
+	}
+	return termination.Sub(current), nil
+}
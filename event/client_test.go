@@ -0,0 +1,137 @@
+package event
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+)
+
+const envelopeStart = `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:wsa="http://www.w3.org/2005/08/addressing" ` +
+	`xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2" xmlns:tev="http://www.onvif.org/ver10/events/wsdl" ` +
+	`xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:tns1="http://www.onvif.org/ver10/topics" ` +
+	`xmlns:wstop="http://docs.oasis-open.org/wsn/t-1"><s:Body>`
+
+const envelopeEnd = `</s:Body></s:Envelope>`
+
+// testRequest is a request received by testDevice
+type testRequest struct {
+	// Name of the body element, ex: PullMessages
+	Name string
+	// Path of the url, the subscriptions are served on their own path
+	Path string
+	Body []byte
+}
+
+// testDevice serves the event service and the subscriptions, handle returns the status and the content of the response body
+type testDevice struct {
+	*httptest.Server
+	handle func(r testRequest) (status int, body string)
+
+	mu       sync.Mutex
+	requests []testRequest
+}
+
+func newTestDevice(t *testing.T, handle func(r testRequest) (int, string)) *testDevice {
+	d := &testDevice{handle: handle}
+	d.Server = httptest.NewServer(http.HandlerFunc(d.serve))
+	t.Cleanup(d.Close)
+	return d
+}
+
+func (d *testDevice) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	req := testRequest{Name: bodyElement(body), Path: r.URL.Path, Body: body}
+
+	d.mu.Lock()
+	d.requests = append(d.requests, req)
+	d.mu.Unlock()
+
+	status, content := d.handle(req)
+	w.Header().Set("Content-Type", "application/soap+xml; charset=utf-8")
+	w.WriteHeader(status)
+	io.WriteString(w, envelopeStart+content+envelopeEnd)
+}
+
+// received return the requests by the name of the body element
+func (d *testDevice) received(name string) []testRequest {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var res []testRequest
+	for _, r := range d.requests {
+		if r.Name == name {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+func (d *testDevice) GetEndpoint(string) (string, error) { return d.URL + "/onvif/events", nil }
+func (d *testDevice) DeltaTime() time.Duration           { return 0 }
+
+func (d *testDevice) CreateRequest(method interface{}) *networking.Request {
+	return networking.NewRequest(d, method)
+}
+
+// bodyElement return the local name of the first element of the SOAP body
+func bodyElement(envelope []byte) string {
+	d := xml.NewDecoder(bytes.NewReader(envelope))
+	inBody := false
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if inBody {
+				return start.Name.Local
+			}
+			inBody = start.Name.Local == "Body"
+		}
+	}
+}
+
+// fault return the content of SOAP 1.2 fault with the subcode
+func fault(subcode string) string {
+	return `<s:Fault><s:Code><s:Value>s:Receiver</s:Value><s:Subcode><s:Value>` + subcode + `</s:Value></s:Subcode></s:Code>` +
+		`<s:Reason><s:Text xml:lang="en">` + subcode + `</s:Text></s:Reason></s:Fault>`
+}
+
+// subscribed return the content of wsnt:SubscribeResponse or tev:CreatePullPointSubscriptionResponse,
+// the reference has the SubscriptionId reference parameter
+func subscribed(name, address string, terminationTime time.Duration) string {
+	now := time.Now().UTC()
+	reference, _, _ := strings.Cut(name, ":")
+	reference += ":SubscriptionReference"
+	return `<` + name + `><` + reference + `><wsa:Address>` + address + `</wsa:Address>` +
+		`<wsa:ReferenceParameters><dn:SubscriptionId xmlns:dn="http://example.com/dn">42</dn:SubscriptionId></wsa:ReferenceParameters>` +
+		`</` + reference + `>` +
+		`<wsnt:CurrentTime>` + now.Format(time.RFC3339) + `</wsnt:CurrentTime>` +
+		`<wsnt:TerminationTime>` + now.Add(terminationTime).Format(time.RFC3339) + `</wsnt:TerminationTime></` + name + `>`
+}
+
+// renewed return the content of RenewResponse
+func renewed(terminationTime time.Duration) string {
+	now := time.Now().UTC()
+	return `<wsnt:RenewResponse><wsnt:TerminationTime>` + now.Add(terminationTime).Format(time.RFC3339) + `</wsnt:TerminationTime>` +
+		`<wsnt:CurrentTime>` + now.Format(time.RFC3339) + `</wsnt:CurrentTime></wsnt:RenewResponse>`
+}
+
+// notificationMessage return wsnt:NotificationMessage of the topic with the data items
+func notificationMessage(topic string, data ...string) string {
+	items := ""
+	for i := 0; i+1 < len(data); i += 2 {
+		items += `<tt:SimpleItem Name="` + data[i] + `" Value="` + data[i+1] + `"/>`
+	}
+	return `<wsnt:NotificationMessage><wsnt:Topic Dialect="http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet">` + topic + `</wsnt:Topic>` +
+		`<wsnt:Message><tt:Message UtcTime="2024-05-01T10:00:00Z" PropertyOperation="Changed">` +
+		`<tt:Source><tt:SimpleItem Name="VideoSourceConfigurationToken" Value="vsc0"/></tt:Source>` +
+		`<tt:Data>` + items + `</tt:Data></tt:Message></wsnt:Message></wsnt:NotificationMessage>`
+}
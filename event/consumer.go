@@ -0,0 +1,219 @@
+package event
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/neirolis/onvif-go/gosoap"
+	"github.com/neirolis/onvif-go/networking"
+)
+
+// maxNotifySize limits the body of Notify request
+const maxNotifySize = 4 << 20
+
+// NotificationConsumer receives events pushed by the device (WS-BaseNotification Notify).
+// It is a http.Handler, mount it on a server reachable from the device and pass its url as Address:
+//
+//	consumer := event.NewNotificationConsumer(dev, "http://192.168.1.10:8080/onvif/notify/cam1")
+//	http.Handle("/onvif/notify/cam1", consumer)
+//	messages, err := consumer.Subscribe(ctx)
+//
+// Subscribe creates the subscription with ConsumerReference set to Address, renews it and
+// resubscribes after errors or a device reboot. Notify requests received without an active subscription are dropped.
+// The device gets the response without waiting for the channel to be read, the messages are queued up to QueueSize
+// and the messages over the limit are dropped and reported to OnError.
+type NotificationConsumer struct {
+	client *Client
+
+	// Address is the url of the handler as seen from the device
+	Address string
+	// Filter is optional topic and message content filter of the subscription
+	Filter *FilterType
+	// TerminationTime requested for the subscription on create and renew
+	TerminationTime time.Duration
+	// RetryInterval delay before resubscribing after an error
+	RetryInterval time.Duration
+	// QueueSize limits the messages received and not read from the channel yet
+	QueueSize int
+	// OnError is called with the errors the consumer recovers from, optional
+	OnError func(error)
+
+	subscription subscription
+
+	mu sync.RWMutex
+	// queue is nil without an active subscription, it is never closed so Notify sends without the lock
+	queue chan Message
+}
+
+// NewNotificationConsumer return NotificationConsumer with the default settings, dev is usually *onvif.Device
+func NewNotificationConsumer(dev networking.RequestCreator, address string) *NotificationConsumer {
+	return &NotificationConsumer{
+		client:          NewClient(dev),
+		Address:         address,
+		TerminationTime: DefaultTerminationTime,
+		RetryInterval:   DefaultRetryInterval,
+		QueueSize:       DefaultQueueSize,
+	}
+}
+
+// Subscribe create the push subscription and keep it alive in background.
+// The error of the first Subscribe request is returned as is.
+// The channel is closed and the subscription is deleted when ctx is done.
+func (c *NotificationConsumer) Subscribe(ctx context.Context) (<-chan Message, error) {
+	if len(c.Address) == 0 {
+		return nil, errors.New("notification consumer address is empty")
+	}
+
+	c.subscription = subscription{client: c.client, terminationTime: c.TerminationTime}
+	if err := c.subscribe(ctx); err != nil {
+		return nil, err
+	}
+
+	queue := make(chan Message, c.QueueSize)
+	messages := make(chan Message)
+	done := make(chan struct{})
+
+	c.mu.Lock()
+	c.queue = queue
+	c.mu.Unlock()
+
+	go forward(queue, messages, done)
+	go c.run(ctx, done)
+	return messages, nil
+}
+
+// forward sends the queued messages to the channel until done, it is the only sender and closes the channel
+func forward(queue <-chan Message, messages chan<- Message, done <-chan struct{}) {
+	defer close(messages)
+	for {
+		select {
+		case message := <-queue:
+			select {
+			case messages <- message:
+			case <-done:
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func (c *NotificationConsumer) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	defer func() {
+		c.mu.Lock()
+		c.queue = nil
+		c.mu.Unlock()
+	}()
+	defer c.subscription.unsubscribe()
+
+	interval := c.TerminationTime / 6
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	for ctx.Err() == nil {
+		wait(ctx, interval)
+		if ctx.Err() != nil {
+			return
+		}
+
+		var err error
+		if !c.subscription.active() {
+			err = c.subscribe(ctx)
+		} else {
+			err = c.subscription.renew(ctx, interval)
+		}
+		if err != nil && ctx.Err() == nil {
+			if c.OnError != nil {
+				c.OnError(err)
+			}
+			c.subscription.unsubscribe()
+			wait(ctx, c.RetryInterval)
+		}
+	}
+}
+
+func (c *NotificationConsumer) subscribe(ctx context.Context) error {
+	resp, err := c.client.Subscribe(ctx, c.Address, c.Filter, c.TerminationTime)
+	if err != nil {
+		return err
+	}
+	if len(resp.SubscriptionReference.Address) == 0 {
+		return errors.New("subscription without address")
+	}
+
+	c.subscription.set(resp.SubscriptionReference, resp.CurrentTime, resp.TerminationTime)
+	return nil
+}
+
+// ServeHTTP accept Notify requests of the device and deliver the messages to the Subscribe channel
+func (c *NotificationConsumer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxNotifySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	notify, err := ParseNotify(body)
+	if err != nil {
+		if c.OnError != nil {
+			c.OnError(err)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	c.deliver(notify)
+}
+
+// deliver queues the messages, the messages over QueueSize are dropped
+func (c *NotificationConsumer) deliver(notify Notify) {
+	c.mu.RLock()
+	queue := c.queue
+	c.mu.RUnlock()
+
+	if queue == nil {
+		return
+	}
+
+	dropped := 0
+	for _, notification := range notify.NotificationMessage {
+		for _, message := range notification.Messages() {
+			select {
+			case queue <- message:
+			default:
+				dropped++
+			}
+		}
+	}
+	if dropped > 0 && c.OnError != nil {
+		c.OnError(fmt.Errorf("notification queue is full, %d messages dropped", dropped))
+	}
+}
+
+// ParseNotify decode the SOAP envelope of Notify request
+func ParseNotify(body []byte) (Notify, error) {
+	notify := Notify{}
+
+	content, err := gosoap.SoapMessage(body).Body()
+	if err != nil {
+		return notify, err
+	}
+
+	err = xml.Unmarshal([]byte(content), &notify)
+	return notify, err
+}
@@ -0,0 +1,176 @@
+package event
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func notify(messages ...string) string {
+	return envelopeStart + `<wsnt:Notify>` + strings.Join(messages, "") + `</wsnt:Notify>` + envelopeEnd
+}
+
+func post(t *testing.T, url, body string) int {
+	t.Helper()
+	resp, err := http.Post(url, "application/soap+xml", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func newTestConsumer(t *testing.T) (*NotificationConsumer, *testDevice, *httptest.Server) {
+	var dev *testDevice
+	dev = newTestDevice(t, func(r testRequest) (int, string) {
+		switch r.Name {
+		case "Subscribe":
+			return http.StatusOK, subscribed("wsnt:SubscribeResponse", dev.URL+"/subscription/1", time.Minute)
+		case "Renew":
+			return http.StatusOK, renewed(time.Minute)
+		case "Unsubscribe":
+			return http.StatusOK, `<wsnt:UnsubscribeResponse/>`
+		}
+		return http.StatusInternalServerError, fault("ter:ActionNotSupported")
+	})
+	consumer := NewNotificationConsumer(dev, "http://192.168.0.2:8080/notify")
+	server := httptest.NewServer(consumer)
+	t.Cleanup(server.Close)
+	return consumer, dev, server
+}
+
+func TestNotificationConsumer(t *testing.T) {
+	consumer, dev, server := newTestConsumer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if status := post(t, server.URL, notify(notificationMessage("tns1:VideoSource/MotionAlarm", "State", "true"))); status != http.StatusOK {
+		t.Errorf("Notify without subscription: status %d", status)
+	}
+
+	messages, err := consumer.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := dev.received("Subscribe"); len(got) != 1 || !strings.Contains(string(got[0].Body), "http://192.168.0.2:8080/notify") {
+		t.Fatalf("Subscribe requests: %v", got)
+	}
+
+	body := notify(
+		notificationMessage("tns1:VideoSource/MotionAlarm", "State", "true"),
+		notificationMessage("tns1:Device/Trigger/DigitalInput", "LogicalState", "false"),
+	)
+	if status := post(t, server.URL, body); status != http.StatusOK {
+		t.Fatalf("Notify: status %d", status)
+	}
+
+	for _, want := range []struct{ topic, name, value string }{
+		{"tns1:VideoSource/MotionAlarm", "State", "true"},
+		{"tns1:Device/Trigger/DigitalInput", "LogicalState", "false"},
+	} {
+		select {
+		case m := <-messages:
+			if string(m.Topic) != want.topic || m.Items()[want.name] != want.value || string(m.PropertyOperation) != "Changed" {
+				t.Errorf("message %+v, want %s %s=%s", m, want.topic, want.name, want.value)
+			}
+			if m.Items()["VideoSourceConfigurationToken"] != "vsc0" {
+				t.Errorf("source of %s: %+v", m.Topic, m.Source)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s is not delivered", want.topic)
+		}
+	}
+
+	if status := post(t, server.URL, "<not a soap"); status != http.StatusBadRequest {
+		t.Errorf("invalid Notify: status %d", status)
+	}
+	resp, err := http.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET: status %d", resp.StatusCode)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-messages:
+		if ok {
+			t.Error("message after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel is not closed")
+	}
+	if got := dev.received("Unsubscribe"); len(got) != 1 || got[0].Path != "/subscription/1" || !strings.Contains(string(got[0].Body), "SubscriptionId") {
+		t.Errorf("Unsubscribe requests: %v", got)
+	}
+
+	// the device keeps sending until the subscription expires
+	if status := post(t, server.URL, body); status != http.StatusOK {
+		t.Errorf("Notify after close: status %d", status)
+	}
+}
+
+func TestNotificationConsumerQueue(t *testing.T) {
+	consumer, _, server := newTestConsumer(t)
+	consumer.QueueSize = 2
+
+	var mu sync.Mutex
+	var errs []error
+	consumer.OnError = func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages, err := consumer.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// nobody reads the channel, the device gets the response anyway
+	done := make(chan int)
+	go func() {
+		var body []string
+		for i := 0; i < 5; i++ {
+			body = append(body, notificationMessage("tns1:VideoSource/MotionAlarm", "State", "true"))
+		}
+		done <- post(t, server.URL, notify(body...))
+	}()
+	select {
+	case status := <-done:
+		if status != http.StatusOK {
+			t.Fatalf("Notify: status %d", status)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Notify waits for the channel")
+	}
+
+	// one message may be taken by the forwarding goroutine already
+	received := 0
+	for received < 3 {
+		select {
+		case <-messages:
+			received++
+			continue
+		case <-time.After(100 * time.Millisecond):
+		}
+		break
+	}
+	if received < 2 || received > 3 {
+		t.Errorf("%d messages received, want the queue size", received)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "dropped") {
+		t.Errorf("OnError: %v", errs)
+	}
+}
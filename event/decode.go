@@ -0,0 +1,258 @@
+package event
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+// PropertyOperation of the property events
+type PropertyOperation string
+
+const (
+	OperationInitialized PropertyOperation = "Initialized"
+	OperationChanged     PropertyOperation = "Changed"
+	OperationDeleted     PropertyOperation = "Deleted"
+)
+
+// Common topics decoded by DefaultRegistry
+const (
+	TopicMotionAlarm    = "tns1:VideoSource/MotionAlarm"
+	TopicCellMotion     = "tns1:RuleEngine/CellMotionDetector/Motion"
+	TopicTamper         = "tns1:RuleEngine/TamperDetector/Tamper"
+	TopicSceneChange    = "tns1:VideoSource/GlobalSceneChange/ImagingService"
+	TopicDigitalInput   = "tns1:Device/Trigger/DigitalInput"
+	TopicRelay          = "tns1:Device/Trigger/Relay"
+	TopicFieldDetector  = "tns1:RuleEngine/FieldDetector/ObjectsInside"
+	TopicLineDetector   = "tns1:RuleEngine/LineDetector/Crossed"
+	TopicPresetInvoked  = "tns1:PTZController/PTZPresets/Invoked"
+	TopicPresetReached  = "tns1:PTZController/PTZPresets/Reached"
+	TopicPresetAborted  = "tns1:PTZController/PTZPresets/Aborted"
+	TopicPresetLeft     = "tns1:PTZController/PTZPresets/Left"
+	TopicStorageFailure = "tns1:Device/HardwareFailure/StorageFailure"
+)
+
+// Event is a decoded message, all the typed events embed EventBase
+type Event interface {
+	Base() EventBase
+}
+
+// EventBase contains the fields common for all the events
+type EventBase struct {
+	Topic     string
+	Time      time.Time
+	Operation PropertyOperation
+	// Source is the value of the first Source item, usually the token of video source, input, relay etc.
+	Source string
+}
+
+// Base return the common fields of the event
+func (e EventBase) Base() EventBase {
+	return e
+}
+
+// MotionAlarm tns1:VideoSource/MotionAlarm
+type MotionAlarm struct {
+	EventBase
+	State bool
+}
+
+// CellMotion tns1:RuleEngine/CellMotionDetector/Motion
+type CellMotion struct {
+	EventBase
+	Rule     string
+	IsMotion bool
+}
+
+// Tamper tns1:RuleEngine/TamperDetector/Tamper and tns1:VideoSource/GlobalSceneChange/ImagingService
+type Tamper struct {
+	EventBase
+	Rule  string
+	State bool
+}
+
+// DigitalInput tns1:Device/Trigger/DigitalInput
+type DigitalInput struct {
+	EventBase
+	LogicalState bool
+}
+
+// Relay tns1:Device/Trigger/Relay
+type Relay struct {
+	EventBase
+	// Active is true for the active LogicalState
+	Active bool
+}
+
+// FieldDetector tns1:RuleEngine/FieldDetector/ObjectsInside
+type FieldDetector struct {
+	EventBase
+	Rule     string
+	ObjectId string
+	IsInside bool
+}
+
+// LineDetector tns1:RuleEngine/LineDetector/Crossed
+type LineDetector struct {
+	EventBase
+	Rule     string
+	ObjectId string
+}
+
+// PTZPreset tns1:PTZController/PTZPresets/Invoked, Reached, Aborted and Left
+type PTZPreset struct {
+	EventBase
+	PresetToken string
+	PresetName  string
+}
+
+// StorageFailure tns1:Device/HardwareFailure/StorageFailure
+type StorageFailure struct {
+	EventBase
+	Failed bool
+}
+
+// GenericEvent is returned for the topics without registered decoder, Items has all the SimpleItems by name
+// and Elements all the ElementItems by name
+type GenericEvent struct {
+	EventBase
+	Items    map[string]string
+	Elements map[string]xsd.AnyElement
+}
+
+// Decoder converts message of the topic to the typed event, base is already filled from the message
+type Decoder func(base EventBase, message Message) (Event, error)
+
+// Registry maps topics to the decoders, it is safe for concurrent use
+type Registry struct {
+	mu       sync.RWMutex
+	decoders map[string]Decoder
+}
+
+// DefaultRegistry decodes the common ONVIF topics, used by Decode
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry return Registry with the decoders of the common ONVIF topics
+func NewRegistry() *Registry {
+	r := &Registry{decoders: make(map[string]Decoder)}
+
+	r.Register(TopicMotionAlarm, func(base EventBase, m Message) (Event, error) {
+		return MotionAlarm{EventBase: base, State: m.itemBool("State")}, nil
+	})
+	r.Register(TopicCellMotion, func(base EventBase, m Message) (Event, error) {
+		return CellMotion{EventBase: base, Rule: m.item("Rule"), IsMotion: m.itemBool("IsMotion")}, nil
+	})
+	r.Register(TopicTamper, func(base EventBase, m Message) (Event, error) {
+		return Tamper{EventBase: base, Rule: m.item("Rule"), State: m.itemBool("IsTamper")}, nil
+	})
+	r.Register(TopicSceneChange, func(base EventBase, m Message) (Event, error) {
+		return Tamper{EventBase: base, State: m.itemBool("State")}, nil
+	})
+	r.Register(TopicDigitalInput, func(base EventBase, m Message) (Event, error) {
+		return DigitalInput{EventBase: base, LogicalState: m.itemBool("LogicalState")}, nil
+	})
+	r.Register(TopicRelay, func(base EventBase, m Message) (Event, error) {
+		return Relay{EventBase: base, Active: strings.EqualFold(m.item("LogicalState"), "active")}, nil
+	})
+	r.Register(TopicFieldDetector, func(base EventBase, m Message) (Event, error) {
+		return FieldDetector{EventBase: base, Rule: m.item("Rule"), ObjectId: m.item("ObjectId"), IsInside: m.itemBool("IsInside")}, nil
+	})
+	r.Register(TopicLineDetector, func(base EventBase, m Message) (Event, error) {
+		return LineDetector{EventBase: base, Rule: m.item("Rule"), ObjectId: m.item("ObjectId")}, nil
+	})
+	for _, topic := range []string{TopicPresetInvoked, TopicPresetReached, TopicPresetAborted, TopicPresetLeft} {
+		r.Register(topic, func(base EventBase, m Message) (Event, error) {
+			return PTZPreset{EventBase: base, PresetToken: m.item("PresetToken"), PresetName: m.item("PresetName")}, nil
+		})
+	}
+	r.Register(TopicStorageFailure, func(base EventBase, m Message) (Event, error) {
+		return StorageFailure{EventBase: base, Failed: m.itemBool("Failed")}, nil
+	})
+
+	return r
+}
+
+// Register set the decoder of the topic, it replaces the previous one.
+// The prefix of topic is ignored on lookup, so tns1:Device/Trigger/Relay matches ns1:Device/Trigger/Relay too.
+func (r *Registry) Register(topic string, decoder Decoder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.decoders[topicKey(topic)] = decoder
+}
+
+// Decode return the typed event of the message, GenericEvent for unknown topics
+func (r *Registry) Decode(message Message) (Event, error) {
+	base := EventBase{
+		Topic:     string(message.Topic),
+		Operation: PropertyOperation(message.PropertyOperation),
+	}
+	base.Time, _ = message.UtcTime.Time()
+	if len(message.Source.SimpleItem) > 0 {
+		base.Source = string(message.Source.SimpleItem[0].Value)
+	}
+
+	r.mu.RLock()
+	decoder, ok := r.decoders[topicKey(base.Topic)]
+	r.mu.RUnlock()
+
+	if !ok {
+		return GenericEvent{EventBase: base, Items: message.Items(), Elements: message.Elements()}, nil
+	}
+	return decoder(base, message)
+}
+
+// Decode the message with DefaultRegistry
+func Decode(message Message) (Event, error) {
+	return DefaultRegistry.Decode(message)
+}
+
+// Items return all the SimpleItems of Source, Key and Data by name, Data wins on conflicts
+func (m Message) Items() map[string]string {
+	items := make(map[string]string)
+	for _, list := range [][]SimpleItem{m.Source.SimpleItem, m.Key.SimpleItem, m.Data.SimpleItem} {
+		for _, item := range list {
+			items[string(item.Name)] = string(item.Value)
+		}
+	}
+	return items
+}
+
+// Elements return all the ElementItems of Source, Key and Data by name, Data wins on conflicts
+func (m Message) Elements() map[string]xsd.AnyElement {
+	elements := make(map[string]xsd.AnyElement)
+	for _, list := range [][]ElementItem{m.Source.ElementItem, m.Key.ElementItem, m.Data.ElementItem} {
+		for _, item := range list {
+			elements[string(item.Name)] = item.Element
+		}
+	}
+	return elements
+}
+
+// item return the value of SimpleItem by name, Data is searched first
+func (m Message) item(name string) string {
+	for _, list := range [][]SimpleItem{m.Data.SimpleItem, m.Key.SimpleItem, m.Source.SimpleItem} {
+		for _, item := range list {
+			if string(item.Name) == name {
+				return string(item.Value)
+			}
+		}
+	}
+	return ""
+}
+
+func (m Message) itemBool(name string) bool {
+	value, _ := strconv.ParseBool(strings.TrimSpace(m.item(name)))
+	return value
+}
+
+// topicKey strip the namespace prefix of the topic
+func topicKey(topic string) string {
+	topic = strings.TrimSpace(topic)
+	if i := strings.Index(topic, ":"); i >= 0 && !strings.Contains(topic[:i], "/") {
+		return topic[i+1:]
+	}
+	return topic
+}
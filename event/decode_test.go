@@ -0,0 +1,258 @@
+package event
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// decodeNotification return the messages of wsnt:NotificationMessage as they are received from the device
+func decodeNotification(t *testing.T, notification string) []Message {
+	t.Helper()
+	var n NotificationMessage
+	if err := xml.Unmarshal([]byte(notification), &n); err != nil {
+		t.Fatal(err)
+	}
+	return n.Messages()
+}
+
+// message return wsnt:NotificationMessage of the topic, items are the content of tt:Message
+func message(topic, operation, items string) string {
+	return `<wsnt:NotificationMessage xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2" xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:tns1="http://www.onvif.org/ver10/topics">` +
+		`<wsnt:Topic Dialect="http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet"> ` + topic + ` </wsnt:Topic>` +
+		`<wsnt:Message><tt:Message UtcTime="2024-05-01T10:00:00.5Z" PropertyOperation="` + operation + `">` + items + `</tt:Message></wsnt:Message>` +
+		`</wsnt:NotificationMessage>`
+}
+
+func TestMessageItems(t *testing.T) {
+	messages := decodeNotification(t, message("tns1:RuleEngine/FieldDetector/ObjectsInside", "Changed",
+		`<tt:Source>
+			<tt:SimpleItem Name="VideoSourceConfigurationToken" Value="vsc0"/>
+			<tt:SimpleItem Name="Rule" Value="Field 1"/>
+		</tt:Source>
+		<tt:Key><tt:SimpleItem Name="ObjectId" Value="17"/></tt:Key>
+		<tt:Data>
+			<tt:SimpleItem Name="IsInside" Value="true"/>
+			<tt:ElementItem Name="Object">
+				<tt:Object ObjectId="17"><tt:Appearance><tt:Class><tt:Type Likelihood="0.9">Human</tt:Type></tt:Class></tt:Appearance></tt:Object>
+			</tt:ElementItem>
+		</tt:Data>`))
+	if len(messages) != 1 {
+		t.Fatalf("%d messages", len(messages))
+	}
+	m := messages[0]
+
+	if m.Topic != "tns1:RuleEngine/FieldDetector/ObjectsInside" || m.PropertyOperation != "Changed" {
+		t.Errorf("topic %q operation %q", m.Topic, m.PropertyOperation)
+	}
+	if want := []SimpleItem{{"VideoSourceConfigurationToken", "vsc0"}, {"Rule", "Field 1"}}; !reflect.DeepEqual(m.Source.SimpleItem, want) {
+		t.Errorf("source %+v", m.Source.SimpleItem)
+	}
+	if want := []SimpleItem{{"ObjectId", "17"}}; !reflect.DeepEqual(m.Key.SimpleItem, want) {
+		t.Errorf("key %+v", m.Key.SimpleItem)
+	}
+	if want := []SimpleItem{{"IsInside", "true"}}; !reflect.DeepEqual(m.Data.SimpleItem, want) {
+		t.Errorf("data %+v", m.Data.SimpleItem)
+	}
+
+	if len(m.Data.ElementItem) != 1 || m.Data.ElementItem[0].Name != "Object" {
+		t.Fatalf("element items %+v", m.Data.ElementItem)
+	}
+	var object struct {
+		ObjectId string `xml:"ObjectId,attr"`
+		Type     struct {
+			Likelihood float64 `xml:"Likelihood,attr"`
+			Value      string  `xml:",chardata"`
+		} `xml:"http://www.onvif.org/ver10/schema Appearance>Class>Type"`
+	}
+	element := m.Data.ElementItem[0].Element
+	if element.XMLName != (xml.Name{Space: "http://www.onvif.org/ver10/schema", Local: "Object"}) {
+		t.Errorf("element %v", element.XMLName)
+	}
+	// the element is kept with its namespaces, so it decodes on its own
+	out, err := xml.Marshal(element)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := xml.Unmarshal(out, &object); err != nil {
+		t.Fatal(err)
+	}
+	if object.ObjectId != "17" || object.Type.Value != "Human" || object.Type.Likelihood != 0.9 {
+		t.Errorf("object %+v of %s", object, out)
+	}
+
+	items := m.Items()
+	if want := map[string]string{"VideoSourceConfigurationToken": "vsc0", "Rule": "Field 1", "ObjectId": "17", "IsInside": "true"}; !reflect.DeepEqual(items, want) {
+		t.Errorf("items %v", items)
+	}
+	if elements := m.Elements(); len(elements) != 1 || elements["Object"].XMLName.Local != "Object" {
+		t.Errorf("elements %v", elements)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	source := `<tt:Source><tt:SimpleItem Name="VideoSourceConfigurationToken" Value="vsc0"/>` +
+		`<tt:SimpleItem Name="VideoAnalyticsConfigurationToken" Value="vac0"/><tt:SimpleItem Name="Rule" Value="MyMotion"/></tt:Source>`
+	base := func(topic string, operation PropertyOperation, source string) EventBase {
+		return EventBase{Topic: topic, Time: time.Date(2024, 5, 1, 10, 0, 0, 5e8, time.UTC), Operation: operation, Source: source}
+	}
+
+	tests := []struct {
+		name    string
+		message string
+		want    Event
+	}{
+		{
+			name:    "motion alarm",
+			message: message(TopicMotionAlarm, "Initialized", `<tt:Source><tt:SimpleItem Name="Source" Value="vs0"/></tt:Source><tt:Data><tt:SimpleItem Name="State" Value="true"/></tt:Data>`),
+			want:    MotionAlarm{EventBase: base(TopicMotionAlarm, OperationInitialized, "vs0"), State: true},
+		},
+		{
+			name:    "cell motion",
+			message: message(TopicCellMotion, "Changed", source+`<tt:Data><tt:SimpleItem Name="IsMotion" Value="1"/></tt:Data>`),
+			want:    CellMotion{EventBase: base(TopicCellMotion, OperationChanged, "vsc0"), Rule: "MyMotion", IsMotion: true},
+		},
+		{
+			name:    "tamper",
+			message: message(TopicTamper, "Changed", source+`<tt:Data><tt:SimpleItem Name="IsTamper" Value=" false "/></tt:Data>`),
+			want:    Tamper{EventBase: base(TopicTamper, OperationChanged, "vsc0"), Rule: "MyMotion"},
+		},
+		{
+			name:    "scene change",
+			message: message(TopicSceneChange, "Changed", `<tt:Source><tt:SimpleItem Name="Source" Value="vs0"/></tt:Source><tt:Data><tt:SimpleItem Name="State" Value="true"/></tt:Data>`),
+			want:    Tamper{EventBase: base(TopicSceneChange, OperationChanged, "vs0"), State: true},
+		},
+		{
+			name:    "digital input",
+			message: message(TopicDigitalInput, "Changed", `<tt:Source><tt:SimpleItem Name="InputToken" Value="DI_0"/></tt:Source><tt:Data><tt:SimpleItem Name="LogicalState" Value="true"/></tt:Data>`),
+			want:    DigitalInput{EventBase: base(TopicDigitalInput, OperationChanged, "DI_0"), LogicalState: true},
+		},
+		{
+			name:    "relay",
+			message: message(TopicRelay, "Changed", `<tt:Source><tt:SimpleItem Name="RelayToken" Value="relay_0"/></tt:Source><tt:Data><tt:SimpleItem Name="LogicalState" Value="active"/></tt:Data>`),
+			want:    Relay{EventBase: base(TopicRelay, OperationChanged, "relay_0"), Active: true},
+		},
+		{
+			name: "field detector",
+			message: message(TopicFieldDetector, "Changed", source+`<tt:Key><tt:SimpleItem Name="ObjectId" Value="17"/></tt:Key>`+
+				`<tt:Data><tt:SimpleItem Name="IsInside" Value="true"/></tt:Data>`),
+			want: FieldDetector{EventBase: base(TopicFieldDetector, OperationChanged, "vsc0"), Rule: "MyMotion", ObjectId: "17", IsInside: true},
+		},
+		{
+			name:    "line detector",
+			message: message(TopicLineDetector, "", source+`<tt:Data><tt:SimpleItem Name="ObjectId" Value="8"/></tt:Data>`),
+			want:    LineDetector{EventBase: base(TopicLineDetector, "", "vsc0"), Rule: "MyMotion", ObjectId: "8"},
+		},
+		{
+			name: "preset reached",
+			message: message(TopicPresetReached, "", `<tt:Source><tt:SimpleItem Name="PTZConfigurationToken" Value="ptz0"/></tt:Source>`+
+				`<tt:Data><tt:SimpleItem Name="PresetToken" Value="1"/><tt:SimpleItem Name="PresetName" Value="Gate"/></tt:Data>`),
+			want: PTZPreset{EventBase: base(TopicPresetReached, "", "ptz0"), PresetToken: "1", PresetName: "Gate"},
+		},
+		{
+			name:    "storage failure",
+			message: message(TopicStorageFailure, "Changed", `<tt:Source><tt:SimpleItem Name="Token" Value="sd0"/></tt:Source><tt:Data><tt:SimpleItem Name="Failed" Value="true"/></tt:Data>`),
+			want:    StorageFailure{EventBase: base(TopicStorageFailure, OperationChanged, "sd0"), Failed: true},
+		},
+		{
+			name:    "other prefix",
+			message: `<wsnt:NotificationMessage xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2" xmlns:tt="http://www.onvif.org/ver10/schema"><wsnt:Topic>ns0:VideoSource/MotionAlarm</wsnt:Topic><wsnt:Message><tt:Message UtcTime="2024-05-01T10:00:00.5Z" PropertyOperation="Deleted"><tt:Data><tt:SimpleItem Name="State" Value="false"/></tt:Data></tt:Message></wsnt:Message></wsnt:NotificationMessage>`,
+			want:    MotionAlarm{EventBase: base("ns0:VideoSource/MotionAlarm", OperationDeleted, "")},
+		},
+		{
+			name: "generic",
+			message: message("tns1:Monitoring/ProcessorUsage", "Changed", `<tt:Source><tt:SimpleItem Name="Token" Value="cpu"/></tt:Source>`+
+				`<tt:Data><tt:SimpleItem Name="Value" Value="12.5"/><tt:ElementItem Name="Details"><tt:Details>idle</tt:Details></tt:ElementItem></tt:Data>`),
+			want: GenericEvent{
+				EventBase: base("tns1:Monitoring/ProcessorUsage", OperationChanged, "cpu"),
+				Items:     map[string]string{"Token": "cpu", "Value": "12.5"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := decodeNotification(t, tt.message)
+			if len(messages) != 1 {
+				t.Fatalf("%d messages", len(messages))
+			}
+			got, err := Decode(messages[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if generic, ok := got.(GenericEvent); ok {
+				if element := generic.Elements["Details"]; element.InnerXML != "idle" {
+					t.Errorf("elements %+v", generic.Elements)
+				}
+				generic.Elements = nil
+				got = generic
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got  %+v\nwant %+v", got, tt.want)
+			}
+			if got.Base() != tt.want.Base() {
+				t.Errorf("base %+v", got.Base())
+			}
+		})
+	}
+}
+
+// vendorAlarm is the event of a vendor topic decoded from the ElementItem
+type vendorAlarm struct {
+	EventBase
+	Zone string
+}
+
+func TestRegistryVendorTopic(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("tnsvendor:Alarm/Intrusion", func(base EventBase, m Message) (Event, error) {
+		var zone struct {
+			Name string `xml:"Name,attr"`
+		}
+		out, err := xml.Marshal(m.Elements()["Zone"])
+		if err == nil {
+			err = xml.Unmarshal(out, &zone)
+		}
+		return vendorAlarm{EventBase: base, Zone: zone.Name}, err
+	})
+
+	messages := decodeNotification(t, message("tnsvendor:Alarm/Intrusion", "Changed",
+		`<tt:Data><tt:ElementItem Name="Zone"><v:Zone xmlns:v="urn:vendor" Name="north"/></tt:ElementItem></tt:Data>`))
+	event, err := registry.Decode(messages[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alarm, ok := event.(vendorAlarm); !ok || alarm.Zone != "north" || alarm.Operation != OperationChanged {
+		t.Errorf("event %+v", event)
+	}
+
+	// DefaultRegistry is not changed
+	if event, _ := Decode(messages[0]); reflect.TypeOf(event) != reflect.TypeOf(GenericEvent{}) {
+		t.Errorf("default registry decoded %T", event)
+	}
+
+	// the decoder replaces the built-in one
+	registry.Register(TopicDigitalInput, func(base EventBase, m Message) (Event, error) {
+		return GenericEvent{EventBase: base}, nil
+	})
+	messages = decodeNotification(t, message(TopicDigitalInput, "Changed", `<tt:Data><tt:SimpleItem Name="LogicalState" Value="true"/></tt:Data>`))
+	if event, _ := registry.Decode(messages[0]); reflect.TypeOf(event) != reflect.TypeOf(GenericEvent{}) {
+		t.Errorf("replaced decoder returned %T", event)
+	}
+}
+
+func TestTopicKey(t *testing.T) {
+	tests := map[string]string{
+		"tns1:Device/Trigger/Relay":   "Device/Trigger/Relay",
+		" ns2:Device/Trigger/Relay ":  "Device/Trigger/Relay",
+		"Device/Trigger/Relay":        "Device/Trigger/Relay",
+		"Device/Trigger:Relay":        "Device/Trigger:Relay",
+		"tnsaxis:CameraApplication/X": "CameraApplication/X",
+		"":                            "",
+	}
+	for topic, want := range tests {
+		if got := topicKey(topic); got != want {
+			t.Errorf("topicKey(%q) = %q, want %q", topic, got, want)
+		}
+	}
+}
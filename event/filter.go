@@ -0,0 +1,167 @@
+package event
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+// Filter dialects
+const (
+	// TopicDialectConcreteSet is the ONVIF topic expression dialect, topics are separated by "|"
+	TopicDialectConcreteSet = "http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet"
+	// TopicDialectConcrete is the WS-Topics dialect of a single concrete topic
+	TopicDialectConcrete = "http://docs.oasis-open.org/wsn/t-1/TopicExpression/Concrete"
+	// MessageContentDialectItemFilter is the ONVIF message content dialect (XPath on SimpleItem)
+	MessageContentDialectItemFilter = "http://www.onvif.org/ver10/tev/messageContentFilter/ItemFilter"
+)
+
+// schemaNamespace is the namespace of tt:SimpleItem used by the message content expressions
+const schemaNamespace = "http://www.onvif.org/ver10/schema"
+
+// FilterBuilder composes FilterType of Subscribe and CreatePullPointSubscription
+// with the dialects and the namespace declarations set.
+//
+//	filter, err := event.NewFilterBuilder().
+//		Topic("tns1:VideoSource/MotionAlarm", "tns1:Device/Trigger/DigitalInput").
+//		SimpleItem("State", "true").
+//		Build()
+type FilterBuilder struct {
+	topics     []string
+	items      []string
+	namespaces map[string]string
+}
+
+// NewFilterBuilder return empty FilterBuilder, prefixes of TopicNamespaces are known to it
+func NewFilterBuilder() *FilterBuilder {
+	return &FilterBuilder{namespaces: make(map[string]string)}
+}
+
+// Topic add concrete topics, ex: tns1:RuleEngine/CellMotionDetector/Motion
+func (b *FilterBuilder) Topic(paths ...string) *FilterBuilder {
+	for _, path := range paths {
+		if path = strings.TrimSpace(path); len(path) > 0 {
+			b.topics = append(b.topics, path)
+		}
+	}
+	return b
+}
+
+// TopicNode add the topics of the nodes found in GetEventProperties TopicSet, their namespaces are declared too
+func (b *FilterBuilder) TopicNode(nodes ...*TopicNode) *FilterBuilder {
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+		if prefix, _, ok := strings.Cut(node.Path, ":"); ok && len(node.Namespace) > 0 {
+			b.Namespace(prefix, node.Namespace)
+		}
+		b.Topic(node.Path)
+	}
+	return b
+}
+
+// Namespace declare the topic prefix which is not in TopicNamespaces
+func (b *FilterBuilder) Namespace(prefix, namespace string) *FilterBuilder {
+	b.namespaces[prefix] = namespace
+	return b
+}
+
+// SimpleItem match messages having SimpleItem name=value in any of Source, Key or Data
+func (b *FilterBuilder) SimpleItem(name, value string) *FilterBuilder {
+	return b.item("//tt:SimpleItem", name, value)
+}
+
+// Source match messages having SimpleItem name=value in Source
+func (b *FilterBuilder) Source(name, value string) *FilterBuilder {
+	return b.item("//tt:Source/tt:SimpleItem", name, value)
+}
+
+// Data match messages having SimpleItem name=value in Data
+func (b *FilterBuilder) Data(name, value string) *FilterBuilder {
+	return b.item("//tt:Data/tt:SimpleItem", name, value)
+}
+
+func (b *FilterBuilder) item(path, name, value string) *FilterBuilder {
+	b.items = append(b.items, fmt.Sprintf("boolean(%s[@Name=%s and @Value=%s])", path, xpathLiteral(name), xpathLiteral(value)))
+	return b
+}
+
+// Build return the filter, nil if neither topics nor items were added.
+// Items are combined with "and". An error is returned for a topic prefix without namespace.
+func (b *FilterBuilder) Build() (*FilterType, error) {
+	if len(b.topics) == 0 && len(b.items) == 0 {
+		return nil, nil
+	}
+
+	filter := &FilterType{}
+
+	if len(b.topics) > 0 {
+		namespaces := make(map[string]string)
+		for _, topic := range b.topics {
+			i := strings.Index(topic, ":")
+			if i < 0 {
+				continue
+			}
+			prefix := topic[:i]
+			namespace, ok := b.namespaces[prefix]
+			if !ok {
+				namespace, ok = TopicNamespaces[prefix]
+			}
+			if !ok {
+				return nil, fmt.Errorf("unknown namespace of topic prefix %q", prefix)
+			}
+			namespaces[prefix] = namespace
+		}
+
+		filter.TopicExpression = &TopicExpressionType{
+			Dialect:    TopicDialectConcreteSet,
+			TopicKinds: xsd.String(strings.Join(b.topics, "|")),
+			Namespaces: xmlnsAttrs(namespaces),
+		}
+	}
+
+	if len(b.items) > 0 {
+		filter.MessageContent = &QueryExpressionType{
+			Dialect:     MessageContentDialectItemFilter,
+			MessageKind: xsd.String(strings.Join(b.items, " and ")),
+			Namespaces:  xmlnsAttrs(map[string]string{"tt": schemaNamespace}),
+		}
+	}
+
+	return filter, nil
+}
+
+// xmlnsAttrs return xmlns:prefix attributes sorted by prefix, the literal names are kept by the request marshaling
+func xmlnsAttrs(namespaces map[string]string) []xml.Attr {
+	prefixes := make([]string, 0, len(namespaces))
+	for prefix := range namespaces {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	attrs := make([]xml.Attr, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: "xmlns:" + prefix}, Value: namespaces[prefix]})
+	}
+	return attrs
+}
+
+// xpathLiteral quote s for XPath 1.0, which has no escaping inside the string literals
+func xpathLiteral(s string) string {
+	if !strings.Contains(s, `"`) {
+		return `"` + s + `"`
+	}
+	if !strings.Contains(s, "'") {
+		return "'" + s + "'"
+	}
+
+	parts := strings.Split(s, `"`)
+	for i, part := range parts {
+		parts[i] = `"` + part + `"`
+	}
+	return "concat(" + strings.Join(parts, `, '"', `) + ")"
+}
@@ -0,0 +1,172 @@
+package event
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// filterElement is the decoded TopicExpression or MessageContent of the marshaled filter
+type filterElement struct {
+	Dialect string `xml:"Dialect,attr"`
+	// Namespaces are the xmlns declarations as prefix -> namespace
+	Namespaces map[string]string
+	Text       string
+}
+
+func (e *filterElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	e.Namespaces = make(map[string]string)
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == "xmlns":
+			e.Namespaces[attr.Name.Local] = attr.Value
+		case attr.Name.Local == "Dialect":
+			e.Dialect = attr.Value
+		}
+	}
+	var text struct {
+		Text string `xml:",chardata"`
+	}
+	err := d.DecodeElement(&text, &start)
+	e.Text = text.Text
+	return err
+}
+
+// marshalFilter return the filter elements as the device receives them in CreatePullPointSubscription
+func marshalFilter(t *testing.T, filter *FilterType) (topic, content *filterElement) {
+	t.Helper()
+	out, err := xml.Marshal(CreatePullPointSubscription{Filter: filter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var request struct {
+		Filter struct {
+			TopicExpression *filterElement
+			MessageContent  *filterElement
+		}
+	}
+	if err := xml.Unmarshal(out, &request); err != nil {
+		t.Fatalf("%s: %v", out, err)
+	}
+	return request.Filter.TopicExpression, request.Filter.MessageContent
+}
+
+func TestFilterBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *FilterBuilder
+		topic   *filterElement
+		content *filterElement
+	}{
+		{
+			name:    "topics",
+			builder: NewFilterBuilder().Topic("tns1:VideoSource/MotionAlarm", " tns1:Device/Trigger/DigitalInput ", ""),
+			topic: &filterElement{
+				Dialect:    TopicDialectConcreteSet,
+				Namespaces: map[string]string{"tns1": "http://www.onvif.org/ver10/topics"},
+				Text:       "tns1:VideoSource/MotionAlarm|tns1:Device/Trigger/DigitalInput",
+			},
+		},
+		{
+			name: "vendor namespace",
+			builder: NewFilterBuilder().
+				Topic("tnsaxis:CameraApplicationPlatform/VMD/Camera1Profile1", "tnsvendor:Alarm").
+				Namespace("tnsvendor", "http://www.example.com/event/topics").
+				Namespace("tnsaxis", "http://www.example.com/axis"),
+			topic: &filterElement{
+				Dialect: TopicDialectConcreteSet,
+				Namespaces: map[string]string{
+					"tnsaxis":   "http://www.example.com/axis",
+					"tnsvendor": "http://www.example.com/event/topics",
+				},
+				Text: "tnsaxis:CameraApplicationPlatform/VMD/Camera1Profile1|tnsvendor:Alarm",
+			},
+		},
+		{
+			name:    "items",
+			builder: NewFilterBuilder().SimpleItem("State", "true").Source("VideoSourceConfigurationToken", "vsc0").Data("IsMotion", "false"),
+			content: &filterElement{
+				Dialect:    MessageContentDialectItemFilter,
+				Namespaces: map[string]string{"tt": "http://www.onvif.org/ver10/schema"},
+				Text: `boolean(//tt:SimpleItem[@Name="State" and @Value="true"]) and ` +
+					`boolean(//tt:Source/tt:SimpleItem[@Name="VideoSourceConfigurationToken" and @Value="vsc0"]) and ` +
+					`boolean(//tt:Data/tt:SimpleItem[@Name="IsMotion" and @Value="false"])`,
+			},
+		},
+		{
+			name:    "topic and item",
+			builder: NewFilterBuilder().Topic("tns1:RuleEngine/CellMotionDetector/Motion").Data("IsMotion", "true"),
+			topic: &filterElement{
+				Dialect:    TopicDialectConcreteSet,
+				Namespaces: map[string]string{"tns1": "http://www.onvif.org/ver10/topics"},
+				Text:       "tns1:RuleEngine/CellMotionDetector/Motion",
+			},
+			content: &filterElement{
+				Dialect:    MessageContentDialectItemFilter,
+				Namespaces: map[string]string{"tt": "http://www.onvif.org/ver10/schema"},
+				Text:       `boolean(//tt:Data/tt:SimpleItem[@Name="IsMotion" and @Value="true"])`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := tt.builder.Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			topic, content := marshalFilter(t, filter)
+			if !reflect.DeepEqual(topic, tt.topic) {
+				t.Errorf("TopicExpression %+v\nwant %+v", topic, tt.topic)
+			}
+			if !reflect.DeepEqual(content, tt.content) {
+				t.Errorf("MessageContent %+v\nwant %+v", content, tt.content)
+			}
+		})
+	}
+}
+
+func TestFilterBuilderEmpty(t *testing.T) {
+	filter, err := NewFilterBuilder().Topic(" ").Build()
+	if filter != nil || err != nil {
+		t.Errorf("filter %+v error %v, want nil", filter, err)
+	}
+	out, err := xml.Marshal(CreatePullPointSubscription{Filter: filter})
+	if err != nil || strings.Contains(string(out), "Filter") {
+		t.Errorf("request %s error %v, want no Filter", out, err)
+	}
+}
+
+func TestFilterBuilderUnknownPrefix(t *testing.T) {
+	_, err := NewFilterBuilder().Topic("tns1:VideoSource/MotionAlarm", "tnsunknown:Alarm").Build()
+	if err == nil || !strings.Contains(err.Error(), `"tnsunknown"`) {
+		t.Errorf("error %v, want the unknown prefix", err)
+	}
+}
+
+func TestXPathLiteral(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{`true`, `"true"`},
+		{``, `""`},
+		{`say "hi"`, `'say "hi"'`},
+		{`it's`, `"it's"`},
+		{`a"b'c`, `concat("a", '"', "b'c")`},
+		{`"'"`, `concat("", '"', "'", '"', "")`},
+	}
+	for _, tt := range tests {
+		if got := xpathLiteral(tt.value); got != tt.want {
+			t.Errorf("xpathLiteral(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+
+	filter, err := NewFilterBuilder().SimpleItem("Rule", `a"b'c`).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, content := marshalFilter(t, filter); content.Text != `boolean(//tt:SimpleItem[@Name="Rule" and @Value=concat("a", '"', "b'c")])` {
+		t.Errorf("MessageContent %s", content.Text)
+	}
+}
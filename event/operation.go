@@ -25,7 +25,7 @@ type Subscribe struct { //http://docs.oasis-open.org/wsn/b-2.xsd
 	ConsumerReference      EndpointReferenceType `xml:"wsnt:ConsumerReference"`
 	Filter                 *FilterType           `xml:"wsnt:Filter"`
 	SubscriptionPolicy     *SubscriptionPolicy   `xml:"wsnt:SubscriptionPolicy"`
-	InitialTerminationTime TerminationTime       `xml:"wsnt:InitialTerminationTime"`
+	InitialTerminationTime TerminationTime       `xml:"wsnt:InitialTerminationTime,omitempty"`
 }
 
 //SubscribeResponse message for subscribe event topic
@@ -50,7 +50,7 @@ type RenewResponse struct { //http://docs.oasis-open.org/wsn/b-2.xsd
 //Unsubscribe action for Unsubscribe event topic
 type Unsubscribe struct { //http://docs.oasis-open.org/wsn/b-2.xsd
 	XMLName struct{} `xml:"wsnt:Unsubscribe"`
-	Any     string
+	Any     string   `xml:",innerxml"`
 }
 
 //UnsubscribeResponse message for Unsubscribe event topic
@@ -63,8 +63,8 @@ type UnsubscribeResponse struct { //http://docs.oasis-open.org/wsn/b-2.xsd
 type CreatePullPointSubscription struct {
 	XMLName                string              `xml:"tev:CreatePullPointSubscription"`
 	Filter                 *FilterType         `xml:"tev:Filter"`
-	InitialTerminationTime TerminationTime     `xml:"tev:InitialTerminationTime"`
-	SubscriptionPolicy     *SubscriptionPolicy `xml:"tev:sSubscriptionPolicy"`
+	InitialTerminationTime TerminationTime     `xml:"tev:InitialTerminationTime,omitempty"`
+	SubscriptionPolicy     *SubscriptionPolicy `xml:"tev:SubscriptionPolicy"`
 }
 
 //CreatePullPointSubscriptionResponse action
@@ -103,7 +103,7 @@ type PullMessages struct {
 type PullMessagesResponse struct {
 	CurrentTime         CurrentTime
 	TerminationTime     TerminationTime
-	NotificationMessage NotificationMessage
+	NotificationMessage []NotificationMessage
 }
 
 //PullMessagesFaultResponse response type
@@ -134,5 +134,5 @@ type SetSynchronizationPointResponse struct {
 
 type Notify struct {
 	XMLName             string `xml:"Notify"`
-	NotificationMessage NotificationMessage
+	NotificationMessage []NotificationMessage
 }
@@ -0,0 +1,133 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+)
+
+// PullPointSubscriber and NotificationConsumer defaults
+const (
+	DefaultTerminationTime = time.Minute
+	DefaultPullTimeout     = 10 * time.Second
+	DefaultMessageLimit    = 100
+	DefaultRetryInterval   = 5 * time.Second
+	DefaultQueueSize       = 100
+)
+
+// PullPointSubscriber receives events of the device through a pull point subscription.
+// It renews the subscription before its termination time and recreates it
+// after a fault, a connection error or a device reboot.
+//
+//	subscriber := event.NewPullPointSubscriber(dev)
+//	messages, err := subscriber.Subscribe(ctx)
+//	for message := range messages {
+//		fmt.Println(message.Topic, message.Data.SimpleItem)
+//	}
+type PullPointSubscriber struct {
+	client *Client
+
+	// Filter is optional topic and message content filter of the subscription
+	Filter *FilterType
+	// TerminationTime requested for the subscription on create and renew
+	TerminationTime time.Duration
+	// PullTimeout of PullMessages, the http client timeout of the device must be greater
+	PullTimeout time.Duration
+	// MessageLimit max messages returned by one PullMessages
+	MessageLimit int
+	// RetryInterval delay before recreating the subscription after an error
+	RetryInterval time.Duration
+	// OnError is called with the errors the subscriber recovers from, optional
+	OnError func(error)
+
+	subscription subscription
+}
+
+// NewPullPointSubscriber return PullPointSubscriber with the default settings, dev is usually *onvif.Device
+func NewPullPointSubscriber(dev networking.RequestCreator) *PullPointSubscriber {
+	return &PullPointSubscriber{
+		client:          NewClient(dev),
+		TerminationTime: DefaultTerminationTime,
+		PullTimeout:     DefaultPullTimeout,
+		MessageLimit:    DefaultMessageLimit,
+		RetryInterval:   DefaultRetryInterval,
+	}
+}
+
+// Subscribe create the subscription and start pulling in background.
+// The error of the first CreatePullPointSubscription is returned as is, so bad credentials or filters are reported at once.
+// The channel is closed and the subscription is deleted when ctx is done.
+func (s *PullPointSubscriber) Subscribe(ctx context.Context) (<-chan Message, error) {
+	s.subscription = subscription{client: s.client, terminationTime: s.TerminationTime}
+	if err := s.create(ctx); err != nil {
+		return nil, err
+	}
+
+	messages := make(chan Message)
+	go s.run(ctx, messages)
+	return messages, nil
+}
+
+func (s *PullPointSubscriber) run(ctx context.Context, messages chan<- Message) {
+	defer close(messages)
+	defer s.subscription.unsubscribe()
+
+	for ctx.Err() == nil {
+		if !s.subscription.active() {
+			if err := s.create(ctx); err != nil {
+				s.fail(ctx, err)
+				continue
+			}
+		}
+
+		if err := s.subscription.renew(ctx, s.PullTimeout); err != nil {
+			s.fail(ctx, err)
+			continue
+		}
+
+		resp, err := s.client.PullMessages(ctx, s.subscription.reference, s.PullTimeout, s.MessageLimit)
+		if err != nil {
+			s.fail(ctx, err)
+			continue
+		}
+		s.subscription.update(resp.CurrentTime, resp.TerminationTime)
+
+		for _, notification := range resp.NotificationMessage {
+			for _, message := range notification.Messages() {
+				select {
+				case messages <- message:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *PullPointSubscriber) create(ctx context.Context) error {
+	resp, err := s.client.CreatePullPointSubscription(ctx, s.Filter, s.TerminationTime)
+	if err != nil {
+		return err
+	}
+	if len(resp.SubscriptionReference.Address) == 0 {
+		return errors.New("pull point subscription without address")
+	}
+
+	s.subscription.set(resp.SubscriptionReference, resp.CurrentTime, resp.TerminationTime)
+	return nil
+}
+
+// fail drop the subscription and wait RetryInterval, the next loop creates a new one
+func (s *PullPointSubscriber) fail(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	if s.OnError != nil {
+		s.OnError(err)
+	}
+
+	s.subscription.unsubscribe()
+	wait(ctx, s.RetryInterval)
+}
@@ -0,0 +1,249 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+)
+
+// pulled return the content of PullMessagesResponse with the notifications
+func pulled(terminationTime time.Duration, notifications ...string) string {
+	now := time.Now().UTC()
+	return `<tev:PullMessagesResponse><tev:CurrentTime>` + now.Format(time.RFC3339) + `</tev:CurrentTime>` +
+		`<tev:TerminationTime>` + now.Add(terminationTime).Format(time.RFC3339) + `</tev:TerminationTime>` +
+		strings.Join(notifications, "") + `</tev:PullMessagesResponse>`
+}
+
+// referenceHeader return the SubscriptionId reference parameter of the SOAP header, empty if it is not sent
+func referenceHeader(t *testing.T, envelope []byte) string {
+	t.Helper()
+	var request struct {
+		Header struct {
+			SubscriptionId struct {
+				IsReferenceParameter string `xml:"http://www.w3.org/2005/08/addressing IsReferenceParameter,attr"`
+				Value                string `xml:",chardata"`
+			} `xml:"http://example.com/dn SubscriptionId"`
+		}
+	}
+	if err := xml.NewDecoder(bytes.NewReader(envelope)).Decode(&request); err != nil {
+		t.Fatal(err)
+	}
+	if request.Header.SubscriptionId.IsReferenceParameter != "true" {
+		return ""
+	}
+	return request.Header.SubscriptionId.Value
+}
+
+// pullPointDevice serves the pull point subscriptions, pull returns the response of the n-th PullMessages
+type pullPointDevice struct {
+	*testDevice
+	// terminationTime of the created subscriptions
+	terminationTime time.Duration
+
+	mu            sync.Mutex
+	subscriptions int
+	pulls         int
+}
+
+func newPullPointDevice(t *testing.T, terminationTime time.Duration, pull func(n int) (int, string)) *pullPointDevice {
+	d := &pullPointDevice{terminationTime: terminationTime}
+	d.testDevice = newTestDevice(t, func(r testRequest) (int, string) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		switch r.Name {
+		case "CreatePullPointSubscription":
+			d.subscriptions++
+			return http.StatusOK, subscribed("tev:CreatePullPointSubscriptionResponse", d.URL+"/subscription/1", d.terminationTime)
+		case "PullMessages":
+			d.pulls++
+			// the device holds the request until the messages or the timeout
+			time.Sleep(5 * time.Millisecond)
+			return pull(d.pulls)
+		case "Renew":
+			return http.StatusOK, renewed(time.Minute)
+		case "Unsubscribe":
+			return http.StatusOK, `<wsnt:UnsubscribeResponse/>`
+		}
+		return http.StatusBadRequest, fault("ter:ActionNotSupported")
+	})
+	return d
+}
+
+func newTestSubscriber(dev networking.RequestCreator) (*PullPointSubscriber, *[]error) {
+	var mu sync.Mutex
+	var errs []error
+	subscriber := NewPullPointSubscriber(dev)
+	subscriber.PullTimeout = 100 * time.Millisecond
+	subscriber.RetryInterval = 10 * time.Millisecond
+	subscriber.OnError = func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}
+	return subscriber, &errs
+}
+
+// receive n messages or fail after a second
+func receive(t *testing.T, messages <-chan Message, n int) []Message {
+	t.Helper()
+	var res []Message
+	timeout := time.After(time.Second)
+	for len(res) < n {
+		select {
+		case m, ok := <-messages:
+			if !ok {
+				t.Fatalf("channel is closed after %d messages", len(res))
+			}
+			res = append(res, m)
+		case <-timeout:
+			t.Fatalf("%d messages received, want %d", len(res), n)
+		}
+	}
+	return res
+}
+
+func TestPullPointSubscriber(t *testing.T) {
+	dev := newPullPointDevice(t, time.Minute, func(n int) (int, string) {
+		if n == 1 {
+			return http.StatusOK, pulled(time.Minute,
+				notificationMessage("tns1:VideoSource/MotionAlarm", "State", "true"),
+				notificationMessage("tns1:Device/Trigger/DigitalInput", "LogicalState", "true"))
+		}
+		return http.StatusOK, pulled(time.Minute)
+	})
+	subscriber, errs := newTestSubscriber(dev)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := subscriber.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := receive(t, messages, 2)
+	if got[0].Topic != "tns1:VideoSource/MotionAlarm" || got[1].Items()["LogicalState"] != "true" {
+		t.Errorf("messages %+v", got)
+	}
+
+	cancel()
+	for range messages {
+	}
+	if len(*errs) > 0 {
+		t.Errorf("errors %v", *errs)
+	}
+	if renews := dev.received("Renew"); len(renews) > 0 {
+		t.Errorf("%d Renew requests of the subscription far from the termination", len(renews))
+	}
+
+	// the requests of the subscription are sent to its address with the reference parameters
+	unsubscribes := dev.received("Unsubscribe")
+	if len(unsubscribes) != 1 {
+		t.Fatalf("%d Unsubscribe requests after cancel", len(unsubscribes))
+	}
+	for _, r := range append(dev.received("PullMessages"), unsubscribes...) {
+		if r.Path != "/subscription/1" {
+			t.Errorf("%s is sent to %s", r.Name, r.Path)
+		}
+		if id := referenceHeader(t, r.Body); id != "42" {
+			t.Errorf("%s SubscriptionId header %q", r.Name, id)
+		}
+	}
+	if r := dev.received("CreatePullPointSubscription"); r[0].Path != "/onvif/events" || referenceHeader(t, r[0].Body) != "" {
+		t.Errorf("CreatePullPointSubscription is sent to %s", r[0].Path)
+	}
+}
+
+func TestPullPointSubscriberRenew(t *testing.T) {
+	// the subscription terminates in 1s, it is renewed when less than PullTimeout+TerminationTime/3 is left,
+	// the renewed one terminates in a minute
+	dev := newPullPointDevice(t, time.Second, func(n int) (int, string) {
+		return http.StatusOK, pulled(time.Minute)
+	})
+	subscriber, errs := newTestSubscriber(dev)
+	subscriber.TerminationTime = 3 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	messages, err := subscriber.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range messages {
+	}
+
+	renews := dev.received("Renew")
+	if len(renews) != 1 {
+		t.Fatalf("%d Renew requests, want 1 before the termination", len(renews))
+	}
+	if renews[0].Path != "/subscription/1" || referenceHeader(t, renews[0].Body) != "42" {
+		t.Errorf("Renew is sent to %s without the reference parameters", renews[0].Path)
+	}
+	if !strings.Contains(string(renews[0].Body), "PT3S") {
+		t.Errorf("Renew does not request the TerminationTime: %s", renews[0].Body)
+	}
+	if dev.subscriptions != 1 || len(*errs) > 0 {
+		t.Errorf("%d subscriptions, errors %v", dev.subscriptions, *errs)
+	}
+}
+
+func TestPullPointSubscriberRecreate(t *testing.T) {
+	// the device reboots, the pull point is unknown to it
+	dev := newPullPointDevice(t, time.Minute, func(n int) (int, string) {
+		switch n {
+		case 1:
+			return http.StatusOK, pulled(time.Minute, notificationMessage("tns1:VideoSource/MotionAlarm", "State", "true"))
+		case 2:
+			return http.StatusBadRequest, fault("wsrf-rw:ResourceUnknownFault")
+		case 3:
+			return http.StatusOK, pulled(time.Minute, notificationMessage("tns1:VideoSource/MotionAlarm", "State", "false"))
+		}
+		return http.StatusOK, pulled(time.Minute)
+	})
+	subscriber, errs := newTestSubscriber(dev)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := subscriber.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := receive(t, messages, 2)
+	if got[0].Items()["State"] != "true" || got[1].Items()["State"] != "false" {
+		t.Errorf("messages %+v", got)
+	}
+	cancel()
+	for range messages {
+	}
+
+	if dev.subscriptions != 2 {
+		t.Errorf("%d subscriptions, want the recreated one", dev.subscriptions)
+	}
+	if len(*errs) != 1 || !strings.Contains((*errs)[0].Error(), "ResourceUnknownFault") {
+		t.Errorf("errors %v, want the fault", *errs)
+	}
+	// the unknown subscription and the recreated one
+	if n := len(dev.received("Unsubscribe")); n != 2 {
+		t.Errorf("%d Unsubscribe requests", n)
+	}
+}
+
+func TestPullPointSubscriberCreateError(t *testing.T) {
+	dev := newTestDevice(t, func(r testRequest) (int, string) {
+		return http.StatusBadRequest, fault("ter:NotAuthorized")
+	})
+	subscriber, _ := newTestSubscriber(dev)
+
+	messages, err := subscriber.Subscribe(context.Background())
+	if messages != nil || !strings.Contains(err.Error(), "ter:NotAuthorized") {
+		t.Errorf("error %v, want the fault", err)
+	}
+	if n := len(dev.received("CreatePullPointSubscription")); n != 1 {
+		t.Errorf("%d CreatePullPointSubscription requests, the first error is not retried", n)
+	}
+}
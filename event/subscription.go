@@ -0,0 +1,78 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+const unsubscribeTimeout = 5 * time.Second
+
+// subscription keeps the reference and the local termination time of a pull point or push subscription
+type subscription struct {
+	client          *Client
+	terminationTime time.Duration
+
+	reference EndpointReferenceTypeResponse
+	deadline  time.Time
+}
+
+// active reports whether the subscription exists on the device
+func (s *subscription) active() bool {
+	return len(s.reference.Address) > 0
+}
+
+func (s *subscription) set(reference EndpointReferenceTypeResponse, currentTime CurrentTime, terminationTime TerminationTime) {
+	s.reference = reference
+	s.deadline = time.Time{}
+	s.update(currentTime, terminationTime)
+}
+
+// update set the local time of the subscription termination, unparsable times keep the previous deadline
+func (s *subscription) update(currentTime CurrentTime, terminationTime TerminationTime) {
+	remaining, err := Remaining(currentTime, terminationTime)
+	if err != nil {
+		if s.deadline.IsZero() {
+			s.deadline = time.Now().Add(s.terminationTime)
+		}
+		return
+	}
+	s.deadline = time.Now().Add(remaining)
+}
+
+// renew the subscription when less than margin and a third of the termination time is left
+func (s *subscription) renew(ctx context.Context, margin time.Duration) error {
+	if s.deadline.IsZero() || time.Until(s.deadline) > margin+s.terminationTime/3 {
+		return nil
+	}
+
+	resp, err := s.client.Renew(ctx, s.reference, s.terminationTime)
+	if err != nil {
+		return err
+	}
+	s.update(resp.CurrentTime, resp.TerminationTime)
+	return nil
+}
+
+// unsubscribe delete the subscription on the device, errors are ignored as the device may be rebooted
+func (s *subscription) unsubscribe() {
+	if !s.active() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), unsubscribeTimeout)
+	defer cancel()
+	_ = s.client.Unsubscribe(ctx, s.reference)
+
+	s.reference = EndpointReferenceTypeResponse{}
+	s.deadline = time.Time{}
+}
+
+// wait d or until ctx is done
+func wait(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,216 @@
+package event
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// TopicNamespaces maps conventional topic prefixes to the namespaces, used to build topic paths and filters.
+// Vendor namespaces may be added by the application.
+var TopicNamespaces = map[string]string{
+	"tns1":    "http://www.onvif.org/ver10/topics",
+	"tnsaxis": "http://www.axis.com/2009/event/topics",
+}
+
+// TopicNode is a node of the topic tree advertised by GetEventProperties
+type TopicNode struct {
+	// Name is the local name of the topic element
+	Name string
+	// Path is the concrete topic expression, ex: tns1:RuleEngine/CellMotionDetector/Motion
+	Path string
+	// Namespace of the Path prefix, empty when the device did not declare it.
+	// FilterBuilder.TopicNode declares it in the filter.
+	Namespace string
+	// IsTopic is wstop:topic attribute, true when the node can be subscribed to
+	IsTopic bool
+	// MessageDescription describes the items of the messages, nil for the intermediate nodes
+	MessageDescription *MessageDescription
+	Children           []*TopicNode
+}
+
+// MessageDescription describes the message of the topic (tt:MessageDescription)
+type MessageDescription struct {
+	// IsProperty means the topic carries a state with Initialized/Changed/Deleted operations
+	IsProperty bool `xml:"IsProperty,attr"`
+	Source     ItemListDescription
+	Key        ItemListDescription
+	Data       ItemListDescription
+}
+
+// ItemListDescription lists the items of Source, Key or Data of the message
+type ItemListDescription struct {
+	SimpleItemDescription  []SimpleItemDescription
+	ElementItemDescription []ElementItemDescription
+}
+
+// SimpleItemDescription is the name and the xsd type of SimpleItem, ex: IsMotion xs:boolean
+type SimpleItemDescription struct {
+	Name string `xml:"Name,attr"`
+	Type string `xml:"Type,attr"`
+}
+
+// ElementItemDescription is the name and the xsd type of ElementItem
+type ElementItemDescription struct {
+	Name string `xml:"Name,attr"`
+	Type string `xml:"Type,attr"`
+}
+
+// UnmarshalXML decode the topic elements of the arbitrary names into the Topics tree
+func (t *TopicSetType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	namespaces := topicSetNamespaces(start.Attr, nil)
+	// generated prefixes of the namespaces declared outside the TopicSet, ex: on the Envelope
+	generated := make(map[string]string)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if strings.EqualFold(token.Name.Local, "documentation") {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			path, namespace := token.Name.Local, token.Name.Space
+			prefix := topicPrefix(namespace, topicSetNamespaces(token.Attr, namespaces))
+			if prefix == namespace && strings.ContainsAny(namespace, ":/") {
+				if _, ok := generated[namespace]; !ok {
+					generated[namespace] = "ns" + strconv.Itoa(len(generated)+1)
+				}
+				prefix = generated[namespace]
+			}
+			if prefix == namespace {
+				// the prefix is not declared at all
+				namespace = ""
+			}
+			if len(prefix) > 0 {
+				path = prefix + ":" + path
+			}
+			node, err := decodeTopicNode(d, token, path, namespace)
+			if err != nil {
+				return err
+			}
+			t.Topics = append(t.Topics, node)
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func decodeTopicNode(d *xml.Decoder, start xml.StartElement, path, namespace string) (*TopicNode, error) {
+	node := &TopicNode{
+		Name:      start.Name.Local,
+		Path:      path,
+		Namespace: namespace,
+	}
+
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "topic" {
+			node.IsTopic = strings.TrimSpace(attr.Value) == "true"
+		}
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			switch token.Name.Local {
+			case "MessageDescription":
+				description := &MessageDescription{}
+				if err := d.DecodeElement(description, &token); err != nil {
+					return nil, err
+				}
+				node.MessageDescription = description
+			case "documentation":
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+			default:
+				child, err := decodeTopicNode(d, token, node.Path+"/"+token.Name.Local, namespace)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+		case xml.EndElement:
+			return node, nil
+		}
+	}
+}
+
+// topicSetNamespaces collect xmlns declarations as namespace -> prefix
+func topicSetNamespaces(attrs []xml.Attr, parent map[string]string) map[string]string {
+	namespaces := make(map[string]string, len(parent))
+	for namespace, prefix := range parent {
+		namespaces[namespace] = prefix
+	}
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" {
+			namespaces[attr.Value] = attr.Name.Local
+		}
+	}
+	return namespaces
+}
+
+// topicPrefix return the prefix of the root topic element.
+// Space is the namespace when it is declared inside the response, or the raw prefix otherwise.
+// The namespace itself is returned when it is not declared on the TopicSet.
+func topicPrefix(space string, namespaces map[string]string) string {
+	for prefix, namespace := range TopicNamespaces {
+		if namespace == space {
+			return prefix
+		}
+	}
+	if prefix, ok := namespaces[space]; ok {
+		return prefix
+	}
+	return space
+}
+
+// Walk call fn for every node of the tree in depth-first order, until fn returns false
+func (t *TopicSetType) Walk(fn func(node *TopicNode) bool) {
+	var walk func(nodes []*TopicNode) bool
+	walk = func(nodes []*TopicNode) bool {
+		for _, node := range nodes {
+			if !fn(node) || !walk(node.Children) {
+				return false
+			}
+		}
+		return true
+	}
+	walk(t.Topics)
+}
+
+// List return the nodes which can be subscribed to (wstop:topic="true" or with MessageDescription)
+func (t *TopicSetType) List() []*TopicNode {
+	var nodes []*TopicNode
+	t.Walk(func(node *TopicNode) bool {
+		if node.IsTopic || node.MessageDescription != nil {
+			nodes = append(nodes, node)
+		}
+		return true
+	})
+	return nodes
+}
+
+// Find return the node by path, ex: tns1:RuleEngine/CellMotionDetector/Motion, nil if not found
+func (t *TopicSetType) Find(path string) *TopicNode {
+	var found *TopicNode
+	t.Walk(func(node *TopicNode) bool {
+		if node.Path == path {
+			found = node
+			return false
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,160 @@
+package event
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// eventProperties is GetEventPropertiesResponse of a camera, the vendor topic namespace is declared
+// on the response element and the other one on the TopicSet
+const eventProperties = `<tev:GetEventPropertiesResponse xmlns:tnsvendor="http://www.example.com/2011/event/topics">
+	<tev:TopicNamespaceLocation>http://www.onvif.org/onvif/ver10/topics/topicns.xml</tev:TopicNamespaceLocation>
+	<wsnt:FixedTopicSet>true</wsnt:FixedTopicSet>
+	<wstop:TopicSet xmlns:tnsacme="http://www.acme.com/event/topics">
+		<tns1:VideoSource wstop:topic="false">
+			<MotionAlarm wstop:topic="true">
+				<tt:MessageDescription IsProperty="true">
+					<tt:Source><tt:SimpleItemDescription Name="Source" Type="tt:ReferenceToken"/></tt:Source>
+					<tt:Data><tt:SimpleItemDescription Name="State" Type="xs:boolean"/></tt:Data>
+				</tt:MessageDescription>
+			</MotionAlarm>
+		</tns1:VideoSource>
+		<tns1:RuleEngine>
+			<wstop:documentation>rules</wstop:documentation>
+			<CellMotionDetector>
+				<Motion wstop:topic="true">
+					<tt:MessageDescription IsProperty="true">
+						<tt:Source>
+							<tt:SimpleItemDescription Name="VideoSourceConfigurationToken" Type="tt:ReferenceToken"/>
+							<tt:SimpleItemDescription Name="VideoAnalyticsConfigurationToken" Type="tt:ReferenceToken"/>
+							<tt:SimpleItemDescription Name="Rule" Type="xs:string"/>
+						</tt:Source>
+						<tt:Data><tt:SimpleItemDescription Name="IsMotion" Type="xs:boolean"/></tt:Data>
+					</tt:MessageDescription>
+				</Motion>
+			</CellMotionDetector>
+		</tns1:RuleEngine>
+		<tnsvendor:LineDetection>
+			<Alarm wstop:topic="true">
+				<tt:MessageDescription>
+					<tt:Data><tt:ElementItemDescription Name="Object" Type="tt:Object"/></tt:Data>
+				</tt:MessageDescription>
+			</Alarm>
+		</tnsvendor:LineDetection>
+		<tnsacme:Tampering wstop:topic="true"/>
+	</wstop:TopicSet>
+	<wsnt:TopicExpressionDialect>http://www.onvif.org/ver10/tev/topicExpression/ConcreteSet</wsnt:TopicExpressionDialect>
+	<wsnt:TopicExpressionDialect>http://docs.oasis-open.org/wsn/t-1/TopicExpression/ConcreteSet</wsnt:TopicExpressionDialect>
+	<tev:MessageContentFilterDialect>http://www.onvif.org/ver10/tev/messageContentFilter/ItemFilter</tev:MessageContentFilterDialect>
+	<tev:MessageContentSchemaLocation>http://www.onvif.org/onvif/ver10/schema/onvif.xsd</tev:MessageContentSchemaLocation>
+</tev:GetEventPropertiesResponse>`
+
+func testTopicSet(t *testing.T) TopicSet {
+	t.Helper()
+	dev := newTestDevice(t, func(r testRequest) (int, string) {
+		return http.StatusOK, eventProperties
+	})
+	properties, err := NewClient(dev).GetEventProperties(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return properties.TopicSet
+}
+
+func TestTopicSet(t *testing.T) {
+	topics := testTopicSet(t)
+
+	var paths []string
+	topics.Walk(func(node *TopicNode) bool {
+		paths = append(paths, node.Path)
+		return true
+	})
+	want := []string{
+		"tns1:VideoSource",
+		"tns1:VideoSource/MotionAlarm",
+		"tns1:RuleEngine",
+		"tns1:RuleEngine/CellMotionDetector",
+		"tns1:RuleEngine/CellMotionDetector/Motion",
+		"ns1:LineDetection",
+		"ns1:LineDetection/Alarm",
+		"tnsacme:Tampering",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths\n%q\nwant\n%q", paths, want)
+	}
+
+	var list []string
+	for _, node := range topics.List() {
+		list = append(list, node.Path)
+	}
+	if want := []string{"tns1:VideoSource/MotionAlarm", "tns1:RuleEngine/CellMotionDetector/Motion", "ns1:LineDetection/Alarm", "tnsacme:Tampering"}; !reflect.DeepEqual(list, want) {
+		t.Errorf("topics %q, want %q", list, want)
+	}
+
+	motion := topics.Find("tns1:RuleEngine/CellMotionDetector/Motion")
+	if motion == nil {
+		t.Fatal("motion topic is not found")
+	}
+	if motion.Name != "Motion" || !motion.IsTopic || motion.Namespace != "http://www.onvif.org/ver10/topics" {
+		t.Errorf("node %+v", motion)
+	}
+	description := motion.MessageDescription
+	if description == nil || !description.IsProperty || len(description.Source.SimpleItemDescription) != 3 {
+		t.Fatalf("description %+v", description)
+	}
+	if item := description.Data.SimpleItemDescription; len(item) != 1 || item[0] != (SimpleItemDescription{Name: "IsMotion", Type: "xs:boolean"}) {
+		t.Errorf("data %+v", item)
+	}
+
+	alarm := topics.Find("ns1:LineDetection/Alarm")
+	if alarm == nil || alarm.Namespace != "http://www.example.com/2011/event/topics" {
+		t.Fatalf("vendor node %+v", alarm)
+	}
+	if item := alarm.MessageDescription.Data.ElementItemDescription; len(item) != 1 || item[0].Name != "Object" {
+		t.Errorf("element items %+v", item)
+	}
+
+	if topics.Find("tns1:RuleEngine/CellMotionDetector/Motion/documentation") != nil || topics.Find("tns1:Unknown") != nil {
+		t.Error("unexpected node")
+	}
+}
+
+func TestFilterBuilderTopicNode(t *testing.T) {
+	topics := testTopicSet(t)
+
+	filter, err := NewFilterBuilder().
+		TopicNode(topics.Find("tns1:RuleEngine/CellMotionDetector/Motion"), topics.Find("ns1:LineDetection/Alarm"), topics.Find("tnsacme:Tampering")).
+		TopicNode(topics.Find("tns1:Missing")).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expression := filter.TopicExpression
+	if want := "tns1:RuleEngine/CellMotionDetector/Motion|ns1:LineDetection/Alarm|tnsacme:Tampering"; string(expression.TopicKinds) != want {
+		t.Errorf("topics %q, want %q", expression.TopicKinds, want)
+	}
+	wantNamespaces := []xml.Attr{
+		{Name: xml.Name{Local: "xmlns:ns1"}, Value: "http://www.example.com/2011/event/topics"},
+		{Name: xml.Name{Local: "xmlns:tns1"}, Value: "http://www.onvif.org/ver10/topics"},
+		{Name: xml.Name{Local: "xmlns:tnsacme"}, Value: "http://www.acme.com/event/topics"},
+	}
+	if !reflect.DeepEqual(expression.Namespaces, wantNamespaces) {
+		t.Errorf("namespaces %+v\nwant %+v", expression.Namespaces, wantNamespaces)
+	}
+
+	// the device resolves the prefixes of the expression by the declarations on the element
+	out, err := xml.Marshal(expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range []string{`xmlns:ns1="http://www.example.com/2011/event/topics"`, `xmlns:tnsacme="http://www.acme.com/event/topics"`} {
+		if !strings.Contains(string(out), decl) {
+			t.Errorf("%s does not declare %s", out, decl)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package event
 
 import (
+	"encoding/xml"
+	"strings"
+
 	"github.com/neirolis/onvif-go/xsd"
 )
 
@@ -28,15 +31,25 @@ type Message struct {
 	UtcTime           xsd.DateTime `xml:"UtcTime,attr"`
 	PropertyOperation xsd.String   `xml:"PropertyOperation,attr"`
 	Source            MessageSource
+	Key               MessageKey
 	Data              MessageData
+	// Topic is not a part of tt:Message, it is copied from the NotificationMessage by NotificationMessage.Messages
+	Topic xsd.String `xml:"-"`
 }
 
 type MessageSource struct {
-	SimpleItem []SimpleItem
+	SimpleItem  []SimpleItem
+	ElementItem []ElementItem
+}
+
+type MessageKey struct {
+	SimpleItem  []SimpleItem
+	ElementItem []ElementItem
 }
 
 type MessageData struct {
-	SimpleItem []SimpleItem
+	SimpleItem  []SimpleItem
+	ElementItem []ElementItem
 }
 
 type SimpleItem struct {
@@ -44,6 +57,13 @@ type SimpleItem struct {
 	Value xsd.String `xml:"Value,attr"`
 }
 
+// ElementItem carries the complex value of the item, ex: tt:Object of the analytics or tt:Polygon
+type ElementItem struct {
+	Name xsd.String `xml:"Name,attr"`
+	// Element is the value element, its type is told by ElementItemDescription of the topic
+	Element xsd.AnyElement `xml:",any"`
+}
+
 //ActionType for AttributedURIType
 type ActionType AttributedURIType
 
@@ -80,7 +100,32 @@ type EndpointReference EndpointReferenceType
 
 //ReferenceParametersType in ws-addr
 type ReferenceParametersType struct { //wsa https://www.w3.org/2005/08/addressing/ws-addr.xsd
-	//Here can be anyAttribute
+	//Elements are opaque to the client, ex: the SubscriptionId of Axis devices
+	Elements []xsd.AnyElement `xml:",any"`
+}
+
+const wsaNamespace = "http://www.w3.org/2005/08/addressing"
+
+// headers return the reference parameters as the header blocks of the requests to the endpoint,
+// each one is marked with wsa:IsReferenceParameter (WS-Addressing 1.0 SOAP Binding, 2.3)
+func (p ReferenceParametersType) headers() []xsd.AnyElement {
+	blocks := make([]xsd.AnyElement, 0, len(p.Elements))
+	for _, el := range p.Elements {
+		namespaces := make(map[string]string, len(el.Namespaces)+1)
+		for prefix, namespace := range el.Namespaces {
+			namespaces[prefix] = namespace
+		}
+		if _, taken := namespaces["wsa"]; !taken {
+			namespaces["wsa"] = wsaNamespace
+		}
+		el.Namespaces = namespaces
+		el.Attr = append(el.Attr[:len(el.Attr):len(el.Attr)], xml.Attr{
+			Name:  xml.Name{Space: wsaNamespace, Local: "IsReferenceParameter"},
+			Value: "true",
+		})
+		blocks = append(blocks, el)
+	}
+	return blocks
 }
 
 //Metadata in ws-addr
@@ -93,12 +138,12 @@ type MetadataType struct { //wsa https://www.w3.org/2005/08/addressing/ws-addr.x
 }
 
 //TopicSet alias
-type TopicSet TopicSetType //wstop http://docs.oasis-open.org/wsn/t-1.xsd
+type TopicSet = TopicSetType //wstop http://docs.oasis-open.org/wsn/t-1.xsd
 
-//TopicSetType alias
+//TopicSetType is the topic tree of the device, see topic.go
 type TopicSetType struct { //wstop http://docs.oasis-open.org/wsn/t-1.xsd
 	ExtensibleDocumented
-	//here can be any element
+	Topics []*TopicNode
 }
 
 //ExtensibleDocumented struct
@@ -124,10 +169,22 @@ type NotificationMessageHolderType struct {
 //NotificationMessage Alias
 type NotificationMessage NotificationMessageHolderType //wsnt http://docs.oasis-open.org/wsn/b-2.xsd
 
+//Messages return the messages of the notification with Topic filled
+func (n NotificationMessage) Messages() []Message {
+	messages := make([]Message, 0, len(n.Message.Messages))
+	for _, message := range n.Message.Messages {
+		message.Topic = xsd.String(strings.TrimSpace(string(n.Topic.TopicKinds)))
+		messages = append(messages, message)
+	}
+	return messages
+}
+
 //QueryExpressionType struct for wsnt:MessageContent
 type QueryExpressionType struct { //wsnt http://docs.oasis-open.org/wsn/b-2.xsd
 	Dialect     xsd.AnyURI `xml:"Dialect,attr"`
 	MessageKind xsd.String `xml:",chardata"` // boolean(ncex:Producer="15")
+	// Namespaces declares the prefixes used by MessageKind, see FilterBuilder
+	Namespaces []xml.Attr `xml:",any,attr"`
 }
 
 //MessageContentType Alias
@@ -140,6 +197,8 @@ type QueryExpression QueryExpressionType
 type TopicExpressionType struct { //wsnt http://docs.oasis-open.org/wsn/b-2.xsd
 	Dialect    xsd.AnyURI `xml:"Dialect,attr"`
 	TopicKinds xsd.String `xml:",chardata"`
+	// Namespaces declares the prefixes used by TopicKinds, see FilterBuilder
+	Namespaces []xml.Attr `xml:",any,attr"`
 }
 
 //Topic Alias
@@ -0,0 +1,275 @@
+package gosoap
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	soapEnvelopeNamespace = "http://www.w3.org/2003/05/soap-envelope"
+	soapEncodingNamespace = "http://www.w3.org/2003/05/soap-encoding"
+)
+
+// Envelope is a SOAP envelope which is built once and encoded straight to a writer.
+// Unlike SoapMessage the document is not parsed again on every change, the header blocks
+// and the body are marshaled by encoding/xml when the envelope is written.
+//
+// The elements of the namespaces declared on the envelope are written with the prefixes,
+// xml.Marshal writes them with xmlns="namespace" for the fields tagged with the namespace.
+type Envelope struct {
+	// Namespaces declared on the envelope, namespace by prefix
+	Namespaces map[string]string
+	// Header blocks, ex: Security
+	Header []interface{}
+	// Body payload
+	Body interface{}
+}
+
+// NewEnvelope return an empty envelope with the namespaces, the map is not copied
+func NewEnvelope(namespaces map[string]string) *Envelope {
+	return &Envelope{Namespaces: namespaces}
+}
+
+// AddHeader add the header block
+func (env *Envelope) AddHeader(block interface{}) {
+	env.Header = append(env.Header, block)
+}
+
+// AddWSSecurity add the WS-Security UsernameToken header
+func (env *Envelope) AddWSSecurity(username, password string, deltaTime time.Duration) {
+	env.AddHeader(NewSecurity(username, password, deltaTime))
+}
+
+// AddTo add the WS-Addressing To header
+func (env *Envelope) AddTo(address string) {
+	env.AddHeader(To{Operation: address})
+}
+
+// SetBody set the body payload
+func (env *Envelope) SetBody(body interface{}) {
+	env.Body = body
+}
+
+// Bytes return the encoded envelope
+func (env *Envelope) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := env.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteTo encode the envelope to w
+func (env *Envelope) WriteTo(w io.Writer) (int64, error) {
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	prefixes := make([]string, 0, len(env.Namespaces))
+	namespaces := make(map[string]string, len(env.Namespaces))
+	for prefix, namespace := range env.Namespaces {
+		prefixes = append(prefixes, prefix)
+		namespaces[namespace] = prefix
+	}
+	sort.Strings(prefixes)
+
+	bw.WriteString(`<?xml version="1.0" encoding="UTF-8"?><soap-env:Envelope xmlns:soap-env="`)
+	bw.WriteString(soapEnvelopeNamespace)
+	bw.WriteString(`" xmlns:soap-enc="`)
+	bw.WriteString(soapEncodingNamespace)
+	bw.WriteByte('"')
+	for _, prefix := range prefixes {
+		bw.WriteString(" xmlns:")
+		bw.WriteString(prefix)
+		bw.WriteString(`="`)
+		escapeAttr(bw, env.Namespaces[prefix])
+		bw.WriteByte('"')
+	}
+	bw.WriteString("><soap-env:Header>")
+
+	p := &prefixWriter{w: bw, declared: env.Namespaces, prefixes: namespaces}
+	var buf bytes.Buffer
+	for _, block := range env.Header {
+		if err := p.write(&buf, block); err != nil {
+			return cw.n, err
+		}
+	}
+	bw.WriteString("</soap-env:Header><soap-env:Body>")
+	if env.Body != nil {
+		if err := p.write(&buf, env.Body); err != nil {
+			return cw.n, err
+		}
+	}
+	bw.WriteString("</soap-env:Body></soap-env:Envelope>")
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// String return the encoded envelope, the error is ignored
+func (env *Envelope) String() string {
+	data, _ := env.Bytes()
+	return string(data)
+}
+
+// prefixWriter writes the marshaled values with the prefixes declared on the envelope
+type prefixWriter struct {
+	w *bufio.Writer
+	// declared namespace by prefix
+	declared map[string]string
+	// prefixes prefix by namespace
+	prefixes map[string]string
+	// scopes of the written elements
+	scopes []scope
+}
+
+// scope of an element, the default namespace and the prefixes declared by the element
+type scope struct {
+	space    string
+	prefixes map[string]string
+}
+
+func (p *prefixWriter) write(buf *bytes.Buffer, v interface{}) error {
+	buf.Reset()
+	if err := xml.NewEncoder(buf).Encode(v); err != nil {
+		return err
+	}
+
+	p.scopes = append(p.scopes[:0], scope{})
+	d := xml.NewDecoder(buf)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			p.start(t)
+		case xml.EndElement:
+			p.w.WriteString("</")
+			p.w.WriteString(p.qualify(t.Name, len(p.scopes)-1))
+			p.w.WriteByte('>')
+			p.scopes = p.scopes[:len(p.scopes)-1]
+		case xml.CharData:
+			escapeText(p.w, t)
+		case xml.Comment:
+			p.w.WriteString("<!--")
+			p.w.Write(t)
+			p.w.WriteString("-->")
+		}
+	}
+}
+
+func (p *prefixWriter) start(t xml.StartElement) {
+	parent := p.scopes[len(p.scopes)-1]
+	current := scope{space: parent.space}
+	for _, attr := range t.Attr {
+		if attr.Name.Space == "xmlns" {
+			if current.prefixes == nil {
+				current.prefixes = map[string]string{}
+			}
+			current.prefixes[attr.Value] = attr.Name.Local
+		}
+	}
+	p.scopes = append(p.scopes, current)
+	depth := len(p.scopes) - 1
+
+	p.w.WriteByte('<')
+	name, ok := p.prefixed(t.Name, depth)
+	if !ok {
+		// the default namespace is used for the unknown namespaces
+		name = t.Name.Local
+		if t.Name.Space != parent.space {
+			p.scopes[depth].space = t.Name.Space
+			p.w.WriteString(name)
+			p.w.WriteString(` xmlns="`)
+			escapeAttr(p.w, t.Name.Space)
+			p.w.WriteByte('"')
+			name = ""
+		}
+	}
+	p.w.WriteString(name)
+
+	for _, attr := range t.Attr {
+		if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+			continue
+		}
+		p.w.WriteByte(' ')
+		if attr.Name.Space == "xmlns" {
+			p.w.WriteString("xmlns:")
+			p.w.WriteString(attr.Name.Local)
+		} else if name, ok := p.prefixed(attr.Name, depth); ok {
+			p.w.WriteString(name)
+		} else {
+			p.w.WriteString(attr.Name.Local)
+		}
+		p.w.WriteString(`="`)
+		escapeAttr(p.w, attr.Value)
+		p.w.WriteByte('"')
+	}
+	p.w.WriteByte('>')
+}
+
+// qualify returns the name of the end element, written as the start element
+func (p *prefixWriter) qualify(name xml.Name, depth int) string {
+	if prefixed, ok := p.prefixed(name, depth); ok {
+		return prefixed
+	}
+	return name.Local
+}
+
+// prefixed returns the prefixed name if the namespace has a prefix: a prefix declared by the marshaled
+// elements (kept for the captured content, ex: xsd.AnyElement), the prefix of the envelope, or a prefix
+// of the envelope left unbound by the prefixed tags, ex: xml:"tds:GetDeviceInformation"
+func (p *prefixWriter) prefixed(name xml.Name, depth int) (string, bool) {
+	if name.Space == "" {
+		return "", false
+	}
+	for i := depth; i >= 0; i-- {
+		if prefix, ok := p.scopes[i].prefixes[name.Space]; ok {
+			return prefix + ":" + name.Local, true
+		}
+	}
+	if prefix, ok := p.prefixes[name.Space]; ok {
+		return prefix + ":" + name.Local, true
+	}
+	if _, ok := p.declared[name.Space]; ok {
+		return name.Space + ":" + name.Local, true
+	}
+	if name.Space == "xml" || strings.HasPrefix(name.Space, "http://www.w3.org/XML/1998/namespace") {
+		return "xml:" + name.Local, true
+	}
+	return "", false
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&#34;",
+		"\r", "&#xD;", "\n", "&#xA;", "\t", "&#x9;")
+)
+
+func escapeText(w *bufio.Writer, data []byte) {
+	textEscaper.WriteString(w, string(data))
+}
+
+func escapeAttr(w *bufio.Writer, s string) {
+	attrEscaper.WriteString(w, s)
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 	"errors"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/beevik/etree"
@@ -72,13 +73,84 @@ func (msg SoapMessage) Body() (string, error) {
 		return "", errors.New("body childs not found")
 	}
 
-	doc.SetRoot(bodyChilds[0])
-	doc.IndentTabs()
+	// the namespaces declared in the envelope are kept, the content is decoded by the namespaces
+	content := bodyChilds[0]
+	for _, parent := range []*etree.Element{body, root} {
+		for _, attr := range parent.Attr {
+			isNamespace := attr.Space == "xmlns" || attr.Space == "" && attr.Key == "xmlns"
+			if isNamespace && content.SelectAttr(attr.FullKey()) == nil {
+				content.CreateAttr(attr.FullKey(), attr.Value)
+			}
+		}
+	}
+
+	declareQNamePrefixes(content, namespaces(root, body))
+
+	// the content is not indented again, the whitespace of the captured elements is kept
+	doc.SetRoot(content)
 	res, _ := doc.WriteToString()
 
 	return res, nil
 }
 
+// qname is a value which may be a QName, ex: xsi:type="tt:Type"
+var qname = regexp.MustCompile(`^\s*([A-Za-z_][\w.-]*):[A-Za-z_][\w.-]*\s*$`)
+
+// namespaces returns the namespace declarations of the elements, namespace by prefix
+func namespaces(elements ...*etree.Element) map[string]string {
+	declared := map[string]string{}
+	for _, el := range elements {
+		for _, attr := range el.Attr {
+			if attr.Space == "xmlns" {
+				declared[attr.Key] = attr.Value
+			}
+		}
+	}
+	return declared
+}
+
+// declareQNamePrefixes declares the prefixes used by the QName values on the elements which use them.
+// The decoder resolves the prefixes of the names only, without the declaration the values of
+// the content kept as is (xsd.AnyElement) would lose their namespaces.
+func declareQNamePrefixes(el *etree.Element, inScope map[string]string) {
+	own := namespaces(el)
+	if len(own) > 0 {
+		scope := make(map[string]string, len(inScope)+len(own))
+		for prefix, namespace := range inScope {
+			scope[prefix] = namespace
+		}
+		for prefix, namespace := range own {
+			scope[prefix] = namespace
+		}
+		inScope = scope
+	}
+
+	values := []string{el.Text()}
+	for _, attr := range el.Attr {
+		if attr.Space != "xmlns" {
+			values = append(values, attr.Value)
+		}
+	}
+	for _, value := range values {
+		match := qname.FindStringSubmatch(value)
+		if match == nil {
+			continue
+		}
+		prefix := match[1]
+		if _, ok := own[prefix]; ok {
+			continue
+		}
+		if namespace, ok := inScope[prefix]; ok {
+			el.CreateAttr("xmlns:"+prefix, namespace)
+			own[prefix] = namespace
+		}
+	}
+
+	for _, child := range el.ChildElements() {
+		declareQNamePrefixes(child, inScope)
+	}
+}
+
 //AddStringBodyContent for Envelope
 func (msg *SoapMessage) AddStringBodyContent(data string) {
 	doc := etree.NewDocument()
@@ -0,0 +1,558 @@
+package media
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Media service.
+// Every method resolves the media endpoint, authenticates with the device credentials
+// and returns *networking.SOAPFault when the device replies with a fault.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Media service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the media service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetVideoSources return all the video sources of the device
+func (c *Client) GetVideoSources(ctx context.Context) ([]onvif.VideoSource, error) {
+	resp := GetVideoSourcesResponse{}
+	if err := c.call(ctx, GetVideoSources{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.VideoSources, nil
+}
+
+// GetAudioSources return all the audio sources of the device
+func (c *Client) GetAudioSources(ctx context.Context) ([]onvif.AudioSource, error) {
+	resp := GetAudioSourcesResponse{}
+	if err := c.call(ctx, GetAudioSources{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.AudioSources, nil
+}
+
+// GetAudioOutputs return all the audio outputs of the device
+func (c *Client) GetAudioOutputs(ctx context.Context) ([]onvif.AudioOutput, error) {
+	resp := GetAudioOutputsResponse{}
+	if err := c.call(ctx, GetAudioOutputs{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.AudioOutputs, nil
+}
+
+// CreateProfile create an empty profile, token is optional
+func (c *Client) CreateProfile(ctx context.Context, name onvif.Name, token onvif.ReferenceToken) (onvif.Profile, error) {
+	resp := CreateProfileResponse{}
+	err := c.call(ctx, CreateProfile{Name: name, Token: token}, &resp)
+	return resp.Profile, err
+}
+
+// GetProfile return the profile by token
+func (c *Client) GetProfile(ctx context.Context, profileToken onvif.ReferenceToken) (onvif.Profile, error) {
+	resp := GetProfileResponse{}
+	err := c.call(ctx, GetProfile{ProfileToken: profileToken}, &resp)
+	return resp.Profile, err
+}
+
+// GetProfiles return all the media profiles of the device
+func (c *Client) GetProfiles(ctx context.Context) ([]onvif.Profile, error) {
+	resp := GetProfilesResponse{}
+	if err := c.call(ctx, GetProfiles{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Profiles, nil
+}
+
+// DeleteProfile delete the profile, fixed profiles can't be deleted
+func (c *Client) DeleteProfile(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteProfile{ProfileToken: profileToken}, &DeleteProfileResponse{})
+}
+
+// AddVideoEncoderConfiguration add the VideoEncoder configuration to the profile
+func (c *Client) AddVideoEncoderConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddVideoEncoderConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddVideoEncoderConfigurationResponse{})
+}
+
+// RemoveVideoEncoderConfiguration remove the VideoEncoder configuration from the profile
+func (c *Client) RemoveVideoEncoderConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveVideoEncoderConfiguration{ProfileToken: profileToken}, &RemoveVideoEncoderConfigurationResponse{})
+}
+
+// AddVideoSourceConfiguration add the VideoSource configuration to the profile
+func (c *Client) AddVideoSourceConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddVideoSourceConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddVideoSourceConfigurationResponse{})
+}
+
+// RemoveVideoSourceConfiguration remove the VideoSource configuration from the profile
+func (c *Client) RemoveVideoSourceConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveVideoSourceConfiguration{ProfileToken: profileToken}, &RemoveVideoSourceConfigurationResponse{})
+}
+
+// AddAudioEncoderConfiguration add the AudioEncoder configuration to the profile
+func (c *Client) AddAudioEncoderConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddAudioEncoderConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddAudioEncoderConfigurationResponse{})
+}
+
+// RemoveAudioEncoderConfiguration remove the AudioEncoder configuration from the profile
+func (c *Client) RemoveAudioEncoderConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveAudioEncoderConfiguration{ProfileToken: profileToken}, &RemoveAudioEncoderConfigurationResponse{})
+}
+
+// AddAudioSourceConfiguration add the AudioSource configuration to the profile
+func (c *Client) AddAudioSourceConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddAudioSourceConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddAudioSourceConfigurationResponse{})
+}
+
+// RemoveAudioSourceConfiguration remove the AudioSource configuration from the profile
+func (c *Client) RemoveAudioSourceConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveAudioSourceConfiguration{ProfileToken: profileToken}, &RemoveAudioSourceConfigurationResponse{})
+}
+
+// AddPTZConfiguration add the PTZ configuration to the profile
+func (c *Client) AddPTZConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddPTZConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddPTZConfigurationResponse{})
+}
+
+// RemovePTZConfiguration remove the PTZ configuration from the profile
+func (c *Client) RemovePTZConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemovePTZConfiguration{ProfileToken: profileToken}, &RemovePTZConfigurationResponse{})
+}
+
+// AddVideoAnalyticsConfiguration add the VideoAnalytics configuration to the profile
+func (c *Client) AddVideoAnalyticsConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddVideoAnalyticsConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddVideoAnalyticsConfigurationResponse{})
+}
+
+// RemoveVideoAnalyticsConfiguration remove the VideoAnalytics configuration from the profile
+func (c *Client) RemoveVideoAnalyticsConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveVideoAnalyticsConfiguration{ProfileToken: profileToken}, &RemoveVideoAnalyticsConfigurationResponse{})
+}
+
+// AddMetadataConfiguration add the Metadata configuration to the profile
+func (c *Client) AddMetadataConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddMetadataConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddMetadataConfigurationResponse{})
+}
+
+// RemoveMetadataConfiguration remove the Metadata configuration from the profile
+func (c *Client) RemoveMetadataConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveMetadataConfiguration{ProfileToken: profileToken}, &RemoveMetadataConfigurationResponse{})
+}
+
+// AddAudioOutputConfiguration add the AudioOutput configuration to the profile
+func (c *Client) AddAudioOutputConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddAudioOutputConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddAudioOutputConfigurationResponse{})
+}
+
+// RemoveAudioOutputConfiguration remove the AudioOutput configuration from the profile
+func (c *Client) RemoveAudioOutputConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveAudioOutputConfiguration{ProfileToken: profileToken}, &RemoveAudioOutputConfigurationResponse{})
+}
+
+// AddAudioDecoderConfiguration add the AudioDecoder configuration to the profile
+func (c *Client) AddAudioDecoderConfiguration(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) error {
+	return c.call(ctx, AddAudioDecoderConfiguration{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &AddAudioDecoderConfigurationResponse{})
+}
+
+// RemoveAudioDecoderConfiguration remove the AudioDecoder configuration from the profile
+func (c *Client) RemoveAudioDecoderConfiguration(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemoveAudioDecoderConfiguration{ProfileToken: profileToken}, &RemoveAudioDecoderConfigurationResponse{})
+}
+
+// GetVideoSourceConfigurations return all the VideoSource configurations of the device
+func (c *Client) GetVideoSourceConfigurations(ctx context.Context) ([]onvif.VideoSourceConfiguration, error) {
+	resp := GetVideoSourceConfigurationsResponse{}
+	if err := c.call(ctx, GetVideoSourceConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetVideoSourceConfiguration return the VideoSource configuration by token
+func (c *Client) GetVideoSourceConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.VideoSourceConfiguration, error) {
+	resp := GetVideoSourceConfigurationResponse{}
+	err := c.call(ctx, GetVideoSourceConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleVideoSourceConfigurations return the VideoSource configurations compatible with the profile
+func (c *Client) GetCompatibleVideoSourceConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.VideoSourceConfiguration, error) {
+	resp := GetCompatibleVideoSourceConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleVideoSourceConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetVideoSourceConfiguration modify the VideoSource configuration
+func (c *Client) SetVideoSourceConfiguration(ctx context.Context, configuration onvif.VideoSourceConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetVideoSourceConfiguration{Configuration: configuration, ForcePersistence: xsd.Boolean(forcePersistence)}, &SetVideoSourceConfigurationResponse{})
+}
+
+// GetVideoSourceConfigurationOptions return the VideoSource configuration options, both tokens are optional
+func (c *Client) GetVideoSourceConfigurationOptions(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) (onvif.VideoSourceConfigurationOptions, error) {
+	resp := GetVideoSourceConfigurationOptionsResponse{}
+	err := c.call(ctx, GetVideoSourceConfigurationOptions{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// GetVideoEncoderConfigurations return all the VideoEncoder configurations of the device
+func (c *Client) GetVideoEncoderConfigurations(ctx context.Context) ([]onvif.VideoEncoderConfiguration, error) {
+	resp := GetVideoEncoderConfigurationsResponse{}
+	if err := c.call(ctx, GetVideoEncoderConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetVideoEncoderConfiguration return the VideoEncoder configuration by token
+func (c *Client) GetVideoEncoderConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.VideoEncoderConfiguration, error) {
+	resp := GetVideoEncoderConfigurationResponse{}
+	err := c.call(ctx, GetVideoEncoderConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleVideoEncoderConfigurations return the VideoEncoder configurations compatible with the profile
+func (c *Client) GetCompatibleVideoEncoderConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.VideoEncoderConfiguration, error) {
+	resp := GetCompatibleVideoEncoderConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleVideoEncoderConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetVideoEncoderConfiguration modify the VideoEncoder configuration
+func (c *Client) SetVideoEncoderConfiguration(ctx context.Context, configuration onvif.VideoEncoderConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetVideoEncoderConfiguration{Configuration: configuration, ForcePersistence: xsd.Boolean(forcePersistence)}, &SetVideoEncoderConfigurationResponse{})
+}
+
+// GetVideoEncoderConfigurationOptions return the VideoEncoder configuration options, both tokens are optional
+func (c *Client) GetVideoEncoderConfigurationOptions(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) (onvif.VideoEncoderConfigurationOptions, error) {
+	resp := GetVideoEncoderConfigurationOptionsResponse{}
+	err := c.call(ctx, GetVideoEncoderConfigurationOptions{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// GetAudioSourceConfigurations return all the AudioSource configurations of the device
+func (c *Client) GetAudioSourceConfigurations(ctx context.Context) ([]onvif.AudioSourceConfiguration, error) {
+	resp := GetAudioSourceConfigurationsResponse{}
+	if err := c.call(ctx, GetAudioSourceConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetAudioSourceConfiguration return the AudioSource configuration by token
+func (c *Client) GetAudioSourceConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.AudioSourceConfiguration, error) {
+	resp := GetAudioSourceConfigurationResponse{}
+	err := c.call(ctx, GetAudioSourceConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleAudioSourceConfigurations return the AudioSource configurations compatible with the profile
+func (c *Client) GetCompatibleAudioSourceConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.AudioSourceConfiguration, error) {
+	resp := GetCompatibleAudioSourceConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleAudioSourceConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetAudioSourceConfiguration modify the AudioSource configuration
+func (c *Client) SetAudioSourceConfiguration(ctx context.Context, configuration onvif.AudioSourceConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetAudioSourceConfiguration{Configuration: configuration, ForcePersistence: xsd.Boolean(forcePersistence)}, &SetAudioSourceConfigurationResponse{})
+}
+
+// GetAudioSourceConfigurationOptions return the AudioSource configuration options, both tokens are optional
+func (c *Client) GetAudioSourceConfigurationOptions(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) (onvif.AudioSourceConfigurationOptions, error) {
+	resp := GetAudioSourceConfigurationOptionsResponse{}
+	err := c.call(ctx, GetAudioSourceConfigurationOptions{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// GetAudioEncoderConfigurations return all the AudioEncoder configurations of the device
+func (c *Client) GetAudioEncoderConfigurations(ctx context.Context) ([]onvif.AudioEncoderConfiguration, error) {
+	resp := GetAudioEncoderConfigurationsResponse{}
+	if err := c.call(ctx, GetAudioEncoderConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetAudioEncoderConfiguration return the AudioEncoder configuration by token
+func (c *Client) GetAudioEncoderConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.AudioEncoderConfiguration, error) {
+	resp := GetAudioEncoderConfigurationResponse{}
+	err := c.call(ctx, GetAudioEncoderConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleAudioEncoderConfigurations return the AudioEncoder configurations compatible with the profile
+func (c *Client) GetCompatibleAudioEncoderConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.AudioEncoderConfiguration, error) {
+	resp := GetCompatibleAudioEncoderConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleAudioEncoderConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetAudioEncoderConfiguration modify the AudioEncoder configuration
+func (c *Client) SetAudioEncoderConfiguration(ctx context.Context, configuration onvif.AudioEncoderConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetAudioEncoderConfiguration{Configuration: configuration, ForcePersistence: xsd.Boolean(forcePersistence)}, &SetAudioEncoderConfigurationResponse{})
+}
+
+// GetAudioEncoderConfigurationOptions return the AudioEncoder configuration options, both tokens are optional
+func (c *Client) GetAudioEncoderConfigurationOptions(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) (onvif.AudioEncoderConfigurationOptions, error) {
+	resp := GetAudioEncoderConfigurationOptionsResponse{}
+	err := c.call(ctx, GetAudioEncoderConfigurationOptions{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// GetVideoAnalyticsConfigurations return all the VideoAnalytics configurations of the device
+func (c *Client) GetVideoAnalyticsConfigurations(ctx context.Context) ([]onvif.VideoAnalyticsConfiguration, error) {
+	resp := GetVideoAnalyticsConfigurationsResponse{}
+	if err := c.call(ctx, GetVideoAnalyticsConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetVideoAnalyticsConfiguration return the VideoAnalytics configuration by token
+func (c *Client) GetVideoAnalyticsConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.VideoAnalyticsConfiguration, error) {
+	resp := GetVideoAnalyticsConfigurationResponse{}
+	err := c.call(ctx, GetVideoAnalyticsConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleVideoAnalyticsConfigurations return the VideoAnalytics configurations compatible with the profile
+func (c *Client) GetCompatibleVideoAnalyticsConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.VideoAnalyticsConfiguration, error) {
+	resp := GetCompatibleVideoAnalyticsConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleVideoAnalyticsConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetVideoAnalyticsConfiguration modify the VideoAnalytics configuration
+func (c *Client) SetVideoAnalyticsConfiguration(ctx context.Context, configuration onvif.VideoAnalyticsConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetVideoAnalyticsConfiguration{Configuration: configuration, ForcePersistence: forcePersistence}, &SetVideoAnalyticsConfigurationResponse{})
+}
+
+// GetMetadataConfigurations return all the Metadata configurations of the device
+func (c *Client) GetMetadataConfigurations(ctx context.Context) ([]onvif.MetadataConfiguration, error) {
+	resp := GetMetadataConfigurationsResponse{}
+	if err := c.call(ctx, GetMetadataConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetMetadataConfiguration return the Metadata configuration by token
+func (c *Client) GetMetadataConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.MetadataConfiguration, error) {
+	resp := GetMetadataConfigurationResponse{}
+	err := c.call(ctx, GetMetadataConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleMetadataConfigurations return the Metadata configurations compatible with the profile
+func (c *Client) GetCompatibleMetadataConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.MetadataConfiguration, error) {
+	resp := GetCompatibleMetadataConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleMetadataConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetMetadataConfiguration modify the Metadata configuration
+func (c *Client) SetMetadataConfiguration(ctx context.Context, configuration onvif.MetadataConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetMetadataConfiguration{Configuration: configuration, ForcePersistence: xsd.Boolean(forcePersistence)}, &SetMetadataConfigurationResponse{})
+}
+
+// GetMetadataConfigurationOptions return the Metadata configuration options, both tokens are optional
+func (c *Client) GetMetadataConfigurationOptions(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) (onvif.MetadataConfigurationOptions, error) {
+	resp := GetMetadataConfigurationOptionsResponse{}
+	err := c.call(ctx, GetMetadataConfigurationOptions{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// GetAudioOutputConfigurations return all the AudioOutput configurations of the device
+func (c *Client) GetAudioOutputConfigurations(ctx context.Context) ([]onvif.AudioOutputConfiguration, error) {
+	resp := GetAudioOutputConfigurationsResponse{}
+	if err := c.call(ctx, GetAudioOutputConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetAudioOutputConfiguration return the AudioOutput configuration by token
+func (c *Client) GetAudioOutputConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.AudioOutputConfiguration, error) {
+	resp := GetAudioOutputConfigurationResponse{}
+	err := c.call(ctx, GetAudioOutputConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleAudioOutputConfigurations return the AudioOutput configurations compatible with the profile
+func (c *Client) GetCompatibleAudioOutputConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.AudioOutputConfiguration, error) {
+	resp := GetCompatibleAudioOutputConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleAudioOutputConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetAudioOutputConfiguration modify the AudioOutput configuration
+func (c *Client) SetAudioOutputConfiguration(ctx context.Context, configuration onvif.AudioOutputConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetAudioOutputConfiguration{Configuration: configuration, ForcePersistence: forcePersistence}, &SetAudioOutputConfigurationResponse{})
+}
+
+// GetAudioOutputConfigurationOptions return the AudioOutput configuration options, both tokens are optional
+func (c *Client) GetAudioOutputConfigurationOptions(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) (onvif.AudioOutputConfigurationOptions, error) {
+	resp := GetAudioOutputConfigurationOptionsResponse{}
+	err := c.call(ctx, GetAudioOutputConfigurationOptions{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// GetAudioDecoderConfigurations return all the AudioDecoder configurations of the device
+func (c *Client) GetAudioDecoderConfigurations(ctx context.Context) ([]onvif.AudioDecoderConfiguration, error) {
+	resp := GetAudioDecoderConfigurationsResponse{}
+	if err := c.call(ctx, GetAudioDecoderConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetAudioDecoderConfiguration return the AudioDecoder configuration by token
+func (c *Client) GetAudioDecoderConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.AudioDecoderConfiguration, error) {
+	resp := GetAudioDecoderConfigurationResponse{}
+	err := c.call(ctx, GetAudioDecoderConfiguration{ConfigurationToken: configurationToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetCompatibleAudioDecoderConfigurations return the AudioDecoder configurations compatible with the profile
+func (c *Client) GetCompatibleAudioDecoderConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.AudioDecoderConfiguration, error) {
+	resp := GetCompatibleAudioDecoderConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleAudioDecoderConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetAudioDecoderConfiguration modify the AudioDecoder configuration
+func (c *Client) SetAudioDecoderConfiguration(ctx context.Context, configuration onvif.AudioDecoderConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetAudioDecoderConfiguration{Configuration: configuration, ForcePersistence: xsd.Boolean(forcePersistence)}, &SetAudioDecoderConfigurationResponse{})
+}
+
+// GetAudioDecoderConfigurationOptions return the AudioDecoder configuration options, both tokens are optional
+func (c *Client) GetAudioDecoderConfigurationOptions(ctx context.Context, profileToken, configurationToken onvif.ReferenceToken) (onvif.AudioDecoderConfigurationOptions, error) {
+	resp := GetAudioDecoderConfigurationOptionsResponse{}
+	err := c.call(ctx, GetAudioDecoderConfigurationOptions{ProfileToken: profileToken, ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// GetGuaranteedNumberOfVideoEncoderInstances return the guaranteed number of encoder instances for the video source configuration
+func (c *Client) GetGuaranteedNumberOfVideoEncoderInstances(ctx context.Context, configurationToken onvif.ReferenceToken) (GetGuaranteedNumberOfVideoEncoderInstancesResponse, error) {
+	resp := GetGuaranteedNumberOfVideoEncoderInstancesResponse{}
+	err := c.call(ctx, GetGuaranteedNumberOfVideoEncoderInstances{ConfigurationToken: configurationToken}, &resp)
+	return resp, err
+}
+
+// GetStreamUri return the stream uri of the profile
+func (c *Client) GetStreamUri(ctx context.Context, profileToken onvif.ReferenceToken, setup onvif.StreamSetup) (onvif.MediaUri, error) {
+	resp := GetStreamUriResponse{}
+	err := c.call(ctx, GetStreamUri{ProfileToken: profileToken, StreamSetup: setup}, &resp)
+	return resp.MediaUri, err
+}
+
+// GetSnapshotUri return the JPEG snapshot uri of the profile
+func (c *Client) GetSnapshotUri(ctx context.Context, profileToken onvif.ReferenceToken) (onvif.MediaUri, error) {
+	resp := GetSnapshotUriResponse{}
+	err := c.call(ctx, GetSnapshotUri{ProfileToken: profileToken}, &resp)
+	return resp.MediaUri, err
+}
+
+// StartMulticastStreaming start multicast streaming of the profile
+func (c *Client) StartMulticastStreaming(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, StartMulticastStreaming{ProfileToken: profileToken}, &StartMulticastStreamingResponse{})
+}
+
+// StopMulticastStreaming stop multicast streaming of the profile
+func (c *Client) StopMulticastStreaming(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, StopMulticastStreaming{ProfileToken: profileToken}, &StopMulticastStreamingResponse{})
+}
+
+// SetSynchronizationPoint request an I-frame in the streams of the profile
+func (c *Client) SetSynchronizationPoint(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, SetSynchronizationPoint{ProfileToken: profileToken}, &SetSynchronizationPointResponse{})
+}
+
+// GetVideoSourceModes return the modes of the video source
+func (c *Client) GetVideoSourceModes(ctx context.Context, videoSourceToken onvif.ReferenceToken) ([]onvif.VideoSourceMode, error) {
+	resp := GetVideoSourceModesResponse{}
+	if err := c.call(ctx, GetVideoSourceModes{VideoSourceToken: videoSourceToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.VideoSourceModes, nil
+}
+
+// SetVideoSourceMode change the mode of the video source, returns true if the device reboots
+func (c *Client) SetVideoSourceMode(ctx context.Context, videoSourceToken, videoSourceModeToken onvif.ReferenceToken) (bool, error) {
+	resp := SetVideoSourceModeResponse{}
+	err := c.call(ctx, SetVideoSourceMode{VideoSourceToken: videoSourceToken, VideoSourceModeToken: videoSourceModeToken}, &resp)
+	return resp.Reboot, err
+}
+
+// GetOSDs return the OSDs, configurationToken is optional
+func (c *Client) GetOSDs(ctx context.Context, configurationToken onvif.ReferenceToken) ([]onvif.OSDConfiguration, error) {
+	resp := GetOSDsResponse{}
+	if err := c.call(ctx, GetOSDs{ConfigurationToken: configurationToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.OSDs, nil
+}
+
+// GetOSD return the OSD by token
+func (c *Client) GetOSD(ctx context.Context, osdToken onvif.ReferenceToken) (onvif.OSDConfiguration, error) {
+	resp := GetOSDResponse{}
+	err := c.call(ctx, GetOSD{OSDToken: osdToken}, &resp)
+	return resp.OSD, err
+}
+
+// GetOSDOptions return the OSD options of the video source configuration
+func (c *Client) GetOSDOptions(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.OSDConfigurationOptions, error) {
+	resp := GetOSDOptionsResponse{}
+	err := c.call(ctx, GetOSDOptions{ConfigurationToken: configurationToken}, &resp)
+	return resp.OSDOptions, err
+}
+
+// SetOSD modify the OSD
+func (c *Client) SetOSD(ctx context.Context, osd onvif.OSDConfiguration) error {
+	return c.call(ctx, SetOSD{OSD: osd}, &SetOSDResponse{})
+}
+
+// CreateOSD create the OSD and return its token
+func (c *Client) CreateOSD(ctx context.Context, osd onvif.OSDConfiguration) (onvif.ReferenceToken, error) {
+	resp := CreateOSDResponse{}
+	err := c.call(ctx, CreateOSD{OSD: osd}, &resp)
+	return resp.OSDToken, err
+}
+
+// DeleteOSD delete the OSD
+func (c *Client) DeleteOSD(ctx context.Context, osdToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteOSD{OSDToken: osdToken}, &DeleteOSDResponse{})
+}
@@ -0,0 +1,220 @@
+package media2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// ErrProfileNotFound returned by GetProfile when the device replies with an empty profile list
+var ErrProfileNotFound = errors.New("profile not found")
+
+// Client is a typed client of the Media2 (ver20) service.
+// The device must expose the media2 endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Media2 service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the media2 service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// CreateProfile create a profile with the given configurations and return its token
+func (c *Client) CreateProfile(ctx context.Context, name onvif.Name, configurations ...ConfigurationRef) (onvif.ReferenceToken, error) {
+	resp := CreateProfileResponse{}
+	err := c.call(ctx, CreateProfile{Name: name, Configuration: configurations}, &resp)
+	return resp.Token, err
+}
+
+// GetProfiles return all the media profiles of the device.
+// types limits the configurations included into the profiles (ConfigurationVideoEncoder etc.),
+// without types the profiles contain only names and tokens.
+func (c *Client) GetProfiles(ctx context.Context, types ...string) ([]MediaProfile, error) {
+	resp := GetProfilesResponse{}
+	if err := c.call(ctx, GetProfiles{Type: types}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Profiles, nil
+}
+
+// GetProfile return the profile by token with the configurations of types
+func (c *Client) GetProfile(ctx context.Context, token onvif.ReferenceToken, types ...string) (MediaProfile, error) {
+	resp := GetProfilesResponse{}
+	if err := c.call(ctx, GetProfiles{Token: token, Type: types}, &resp); err != nil {
+		return MediaProfile{}, err
+	}
+	if len(resp.Profiles) == 0 {
+		return MediaProfile{}, ErrProfileNotFound
+	}
+	return resp.Profiles[0], nil
+}
+
+// AddConfiguration add the configurations to the profile, name is optional and renames the profile
+func (c *Client) AddConfiguration(ctx context.Context, profileToken onvif.ReferenceToken, name onvif.Name, configurations ...ConfigurationRef) error {
+	return c.call(ctx, AddConfiguration{ProfileToken: profileToken, Name: name, Configuration: configurations}, &AddConfigurationResponse{})
+}
+
+// RemoveConfiguration remove the configurations from the profile
+func (c *Client) RemoveConfiguration(ctx context.Context, profileToken onvif.ReferenceToken, configurations ...ConfigurationRef) error {
+	return c.call(ctx, RemoveConfiguration{ProfileToken: profileToken, Configuration: configurations}, &RemoveConfigurationResponse{})
+}
+
+// DeleteProfile delete the profile by token
+func (c *Client) DeleteProfile(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteProfile{Token: token}, &DeleteProfileResponse{})
+}
+
+// GetVideoSourceConfigurations return video source configurations, both tokens are optional filters
+func (c *Client) GetVideoSourceConfigurations(ctx context.Context, configurationToken, profileToken onvif.ReferenceToken) ([]onvif.VideoSourceConfiguration, error) {
+	resp := GetVideoSourceConfigurationsResponse{}
+	if err := c.call(ctx, GetVideoSourceConfigurations{ConfigurationToken: configurationToken, ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// GetVideoEncoderConfigurations return video encoder configurations, both tokens are optional filters
+func (c *Client) GetVideoEncoderConfigurations(ctx context.Context, configurationToken, profileToken onvif.ReferenceToken) ([]onvif.VideoEncoder2Configuration, error) {
+	resp := GetVideoEncoderConfigurationsResponse{}
+	if err := c.call(ctx, GetVideoEncoderConfigurations{ConfigurationToken: configurationToken, ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetVideoEncoderConfiguration modify the video encoder configuration
+func (c *Client) SetVideoEncoderConfiguration(ctx context.Context, configuration onvif.VideoEncoder2Configuration) error {
+	return c.call(ctx, SetVideoEncoderConfiguration{Configuration: configuration}, &SetVideoEncoderConfigurationResponse{})
+}
+
+// GetVideoEncoderConfigurationOptions return the encoder options (one per encoding), both tokens are optional
+func (c *Client) GetVideoEncoderConfigurationOptions(ctx context.Context, configurationToken, profileToken onvif.ReferenceToken) ([]onvif.VideoEncoder2ConfigurationOptions, error) {
+	resp := GetVideoEncoderConfigurationOptionsResponse{}
+	if err := c.call(ctx, GetVideoEncoderConfigurationOptions{ConfigurationToken: configurationToken, ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Options, nil
+}
+
+// GetVideoEncoderInstances return the number of encoder instances available for the video source configuration
+func (c *Client) GetVideoEncoderInstances(ctx context.Context, configurationToken onvif.ReferenceToken) (EncoderInstanceInfo, error) {
+	resp := GetVideoEncoderInstancesResponse{}
+	err := c.call(ctx, GetVideoEncoderInstances{ConfigurationToken: configurationToken}, &resp)
+	return resp.Info, err
+}
+
+// GetStreamUri return the stream uri of the profile for protocol (ProtocolRtspUnicast etc.)
+func (c *Client) GetStreamUri(ctx context.Context, profileToken onvif.ReferenceToken, protocol string) (xsd.AnyURI, error) {
+	resp := GetStreamUriResponse{}
+	err := c.call(ctx, GetStreamUri{ProfileToken: profileToken, Protocol: protocol}, &resp)
+	return resp.Uri, err
+}
+
+// GetSnapshotUri return the http snapshot uri of the profile
+func (c *Client) GetSnapshotUri(ctx context.Context, profileToken onvif.ReferenceToken) (xsd.AnyURI, error) {
+	resp := GetSnapshotUriResponse{}
+	err := c.call(ctx, GetSnapshotUri{ProfileToken: profileToken}, &resp)
+	return resp.Uri, err
+}
+
+// SetSynchronizationPoint request an I-frame on the streams of the profile
+func (c *Client) SetSynchronizationPoint(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, SetSynchronizationPoint{ProfileToken: profileToken}, &SetSynchronizationPointResponse{})
+}
+
+// GetVideoSourceModes return the modes of the video source
+func (c *Client) GetVideoSourceModes(ctx context.Context, videoSourceToken onvif.ReferenceToken) ([]VideoSourceMode, error) {
+	resp := GetVideoSourceModesResponse{}
+	if err := c.call(ctx, GetVideoSourceModes{VideoSourceToken: videoSourceToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.VideoSourceModes, nil
+}
+
+// SetVideoSourceMode switch the video source mode, returns true when the device is going to reboot
+func (c *Client) SetVideoSourceMode(ctx context.Context, videoSourceToken, modeToken onvif.ReferenceToken) (bool, error) {
+	resp := SetVideoSourceModeResponse{}
+	err := c.call(ctx, SetVideoSourceMode{VideoSourceToken: videoSourceToken, VideoSourceModeToken: modeToken}, &resp)
+	return resp.Reboot, err
+}
+
+// GetOSDs return the OSDs, both tokens are optional filters
+func (c *Client) GetOSDs(ctx context.Context, osdToken, configurationToken onvif.ReferenceToken) ([]onvif.OSDConfiguration, error) {
+	resp := GetOSDsResponse{}
+	if err := c.call(ctx, GetOSDs{OSDToken: osdToken, ConfigurationToken: configurationToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.OSDs, nil
+}
+
+// GetOSDOptions return the OSD options of the video source configuration
+func (c *Client) GetOSDOptions(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.OSDConfigurationOptions, error) {
+	resp := GetOSDOptionsResponse{}
+	err := c.call(ctx, GetOSDOptions{ConfigurationToken: configurationToken}, &resp)
+	return resp.OSDOptions, err
+}
+
+// SetOSD modify the OSD
+func (c *Client) SetOSD(ctx context.Context, osd onvif.OSDConfiguration) error {
+	return c.call(ctx, SetOSD{OSD: osd}, &SetOSDResponse{})
+}
+
+// CreateOSD create the OSD and return its token
+func (c *Client) CreateOSD(ctx context.Context, osd onvif.OSDConfiguration) (onvif.ReferenceToken, error) {
+	resp := CreateOSDResponse{}
+	err := c.call(ctx, CreateOSD{OSD: osd}, &resp)
+	return resp.OSDToken, err
+}
+
+// DeleteOSD delete the OSD by token
+func (c *Client) DeleteOSD(ctx context.Context, osdToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteOSD{OSDToken: osdToken}, &DeleteOSDResponse{})
+}
+
+// GetMasks return the privacy masks, both tokens are optional filters
+func (c *Client) GetMasks(ctx context.Context, token, configurationToken onvif.ReferenceToken) ([]Mask, error) {
+	resp := GetMasksResponse{}
+	if err := c.call(ctx, GetMasks{Token: token, ConfigurationToken: configurationToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Masks, nil
+}
+
+// GetMaskOptions return the mask options of the video source configuration
+func (c *Client) GetMaskOptions(ctx context.Context, configurationToken onvif.ReferenceToken) (MaskOptions, error) {
+	resp := GetMaskOptionsResponse{}
+	err := c.call(ctx, GetMaskOptions{ConfigurationToken: configurationToken}, &resp)
+	return resp.Options, err
+}
+
+// SetMask modify the mask, mask.Token is required
+func (c *Client) SetMask(ctx context.Context, mask Mask) error {
+	return c.call(ctx, SetMask{Mask: mask}, &SetMaskResponse{})
+}
+
+// CreateMask create the mask and return its token
+func (c *Client) CreateMask(ctx context.Context, mask Mask) (onvif.ReferenceToken, error) {
+	resp := CreateMaskResponse{}
+	err := c.call(ctx, CreateMask{Mask: mask}, &resp)
+	return resp.Token, err
+}
+
+// DeleteMask delete the mask by token
+func (c *Client) DeleteMask(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteMask{Token: token}, &DeleteMaskResponse{})
+}
@@ -0,0 +1,368 @@
+package media2
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Configuration types of ConfigurationRef.Type and GetProfiles Type filter (tr2:ConfigurationEnumeration)
+const (
+	ConfigurationAll          = "All"
+	ConfigurationVideoSource  = "VideoSource"
+	ConfigurationVideoEncoder = "VideoEncoder"
+	ConfigurationAudioSource  = "AudioSource"
+	ConfigurationAudioEncoder = "AudioEncoder"
+	ConfigurationAudioOutput  = "AudioOutput"
+	ConfigurationAudioDecoder = "AudioDecoder"
+	ConfigurationMetadata     = "Metadata"
+	ConfigurationAnalytics    = "Analytics"
+	ConfigurationPTZ          = "PTZ"
+)
+
+// Transport protocols of GetStreamUri (tr2:TransportProtocol).
+// Protocol is a plain string, so values added by newer spec versions may be passed as well.
+const (
+	ProtocolRtspUnicast   = "RtspUnicast"
+	ProtocolRtspMulticast = "RtspMulticast"
+	ProtocolRTSP          = "RTSP"
+	ProtocolRtspOverHttp  = "RtspOverHttp"
+)
+
+// Mask types (tr2:MaskType)
+const (
+	MaskColor     = "Color"
+	MaskPixelated = "Pixelated"
+	MaskBlurred   = "Blurred"
+)
+
+type Capabilities struct {
+	SnapshotUri           bool `xml:"SnapshotUri,attr"`
+	Rotation              bool `xml:"Rotation,attr"`
+	VideoSourceMode       bool `xml:"VideoSourceMode,attr"`
+	OSD                   bool `xml:"OSD,attr"`
+	TemporaryOSDText      bool `xml:"TemporaryOSDText,attr"`
+	Mask                  bool `xml:"Mask,attr"`
+	SourceMask            bool `xml:"SourceMask,attr"`
+	ProfileCapabilities   ProfileCapabilities
+	StreamingCapabilities StreamingCapabilities
+}
+
+type ProfileCapabilities struct {
+	MaximumNumberOfProfiles int `xml:"MaximumNumberOfProfiles,attr"`
+	//ConfigurationsSupported space separated list of the configuration types
+	ConfigurationsSupported string `xml:"ConfigurationsSupported,attr"`
+}
+
+type StreamingCapabilities struct {
+	RTSPStreaming       bool       `xml:"RTSPStreaming,attr"`
+	RTPMulticast        bool       `xml:"RTPMulticast,attr"`
+	RTP_RTSP_TCP        bool       `xml:"RTP_RTSP_TCP,attr"`
+	NonAggregateControl bool       `xml:"NonAggregateControl,attr"`
+	RTSPWebSocketUri    xsd.AnyURI `xml:"RTSPWebSocketUri,attr"`
+	AutoStartMulticast  bool       `xml:"AutoStartMulticast,attr"`
+}
+
+type ConfigurationRef struct {
+	Type  string               `xml:"http://www.onvif.org/ver20/media/wsdl Type"`
+	Token onvif.ReferenceToken `xml:"http://www.onvif.org/ver20/media/wsdl Token,omitempty"`
+}
+
+type ConfigurationSet struct {
+	VideoSource  *onvif.VideoSourceConfiguration    `xml:"VideoSource"`
+	AudioSource  *onvif.AudioSourceConfiguration    `xml:"AudioSource"`
+	VideoEncoder *onvif.VideoEncoder2Configuration  `xml:"VideoEncoder"`
+	AudioEncoder *onvif.AudioEncoder2Configuration  `xml:"AudioEncoder"`
+	Analytics    *onvif.VideoAnalyticsConfiguration `xml:"Analytics"`
+	PTZ          *onvif.PTZConfiguration            `xml:"PTZ"`
+	Metadata     *onvif.MetadataConfiguration       `xml:"Metadata"`
+	AudioOutput  *onvif.AudioOutputConfiguration    `xml:"AudioOutput"`
+	AudioDecoder *onvif.AudioDecoderConfiguration   `xml:"AudioDecoder"`
+}
+
+type MediaProfile struct {
+	Token          onvif.ReferenceToken `xml:"token,attr"`
+	Fixed          bool                 `xml:"fixed,attr"`
+	Name           onvif.Name
+	Configurations ConfigurationSet
+}
+
+type Mask struct {
+	Token                    onvif.ReferenceToken `xml:"token,attr,omitempty"`
+	VideoSourceConfiguration onvif.ReferenceToken `xml:"VideoSourceConfiguration,attr,omitempty"`
+	ConfigurationToken       onvif.ReferenceToken `xml:"http://www.onvif.org/ver20/media/wsdl ConfigurationToken"`
+	Polygon                  onvif.Polygon        `xml:"http://www.onvif.org/ver20/media/wsdl Polygon"`
+	Type                     string               `xml:"http://www.onvif.org/ver20/media/wsdl Type"`
+	Color                    *onvif.Color         `xml:"http://www.onvif.org/ver20/media/wsdl Color,omitempty"`
+	Enabled                  bool                 `xml:"http://www.onvif.org/ver20/media/wsdl Enabled"`
+}
+
+// Deprecated: use Mask, it decodes the responses too.
+type MaskResponse = Mask
+
+type MaskOptions struct {
+	RectangleOnly   bool `xml:"RectangleOnly,attr"`
+	SingleColorOnly bool `xml:"SingleColorOnly,attr"`
+	MaxMasks        int
+	MaxPoints       int
+	Types           []string
+	Color           onvif.ColorOptions
+}
+
+type EncoderInstance struct {
+	Encoding string
+	Number   int
+}
+
+type EncoderInstanceInfo struct {
+	Codec []EncoderInstance
+	Total int
+}
+
+type VideoSourceMode struct {
+	Token         onvif.ReferenceToken `xml:"token,attr"`
+	Enabled       bool                 `xml:"Enabled,attr"`
+	MaxFramerate  float64
+	MaxResolution onvif.VideoResolution
+	//Encodings space separated list of the supported encodings
+	Encodings   string
+	Reboot      bool
+	Description string
+}
+
+//Media2 main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tr2:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type CreateProfile struct {
+	XMLName       string             `xml:"tr2:CreateProfile"`
+	Name          onvif.Name         `xml:"tr2:Name"`
+	Configuration []ConfigurationRef `xml:"tr2:Configuration,omitempty"`
+}
+
+type CreateProfileResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type GetProfiles struct {
+	XMLName string               `xml:"tr2:GetProfiles"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+	Type    []string             `xml:"tr2:Type,omitempty"`
+}
+
+type GetProfilesResponse struct {
+	Profiles []MediaProfile
+}
+
+type AddConfiguration struct {
+	XMLName       string               `xml:"tr2:AddConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Name          onvif.Name           `xml:"tr2:Name,omitempty"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration,omitempty"`
+}
+
+type AddConfigurationResponse struct {
+}
+
+type RemoveConfiguration struct {
+	XMLName       string               `xml:"tr2:RemoveConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration"`
+}
+
+type RemoveConfigurationResponse struct {
+}
+
+type DeleteProfile struct {
+	XMLName string               `xml:"tr2:DeleteProfile"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+type DeleteProfileResponse struct {
+}
+
+type GetVideoSourceConfigurations struct {
+	XMLName            string               `xml:"tr2:GetVideoSourceConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoSourceConfigurationsResponse struct {
+	Configurations []onvif.VideoSourceConfiguration
+}
+
+type GetVideoEncoderConfigurations struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderConfigurations"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoEncoderConfigurationsResponse struct {
+	Configurations []onvif.VideoEncoder2Configuration
+}
+
+type SetVideoEncoderConfiguration struct {
+	XMLName       string                           `xml:"tr2:SetVideoEncoderConfiguration"`
+	Configuration onvif.VideoEncoder2Configuration `xml:"tr2:Configuration"`
+}
+
+type SetVideoEncoderConfigurationResponse struct {
+}
+
+type GetVideoEncoderConfigurationOptions struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderConfigurationOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+type GetVideoEncoderConfigurationOptionsResponse struct {
+	Options []onvif.VideoEncoder2ConfigurationOptions
+}
+
+type GetVideoEncoderInstances struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderInstances"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type GetVideoEncoderInstancesResponse struct {
+	Info EncoderInstanceInfo
+}
+
+type GetStreamUri struct {
+	XMLName      string               `xml:"tr2:GetStreamUri"`
+	Protocol     string               `xml:"tr2:Protocol"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type GetStreamUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type GetSnapshotUri struct {
+	XMLName      string               `xml:"tr2:GetSnapshotUri"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type GetSnapshotUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type SetSynchronizationPoint struct {
+	XMLName      string               `xml:"tr2:SetSynchronizationPoint"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+type SetSynchronizationPointResponse struct {
+}
+
+type GetVideoSourceModes struct {
+	XMLName          string               `xml:"tr2:GetVideoSourceModes"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+}
+
+type GetVideoSourceModesResponse struct {
+	VideoSourceModes []VideoSourceMode
+}
+
+type SetVideoSourceMode struct {
+	XMLName              string               `xml:"tr2:SetVideoSourceMode"`
+	VideoSourceToken     onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+	VideoSourceModeToken onvif.ReferenceToken `xml:"tr2:VideoSourceModeToken"`
+}
+
+type SetVideoSourceModeResponse struct {
+	Reboot bool
+}
+
+type GetOSDs struct {
+	XMLName            string               `xml:"tr2:GetOSDs"`
+	OSDToken           onvif.ReferenceToken `xml:"tr2:OSDToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+type GetOSDsResponse struct {
+	OSDs []onvif.OSDConfiguration
+}
+
+type GetOSDOptions struct {
+	XMLName            string               `xml:"tr2:GetOSDOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type GetOSDOptionsResponse struct {
+	OSDOptions onvif.OSDConfigurationOptions
+}
+
+type SetOSD struct {
+	XMLName string                 `xml:"tr2:SetOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+type SetOSDResponse struct {
+}
+
+type CreateOSD struct {
+	XMLName string                 `xml:"tr2:CreateOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+type CreateOSDResponse struct {
+	OSDToken onvif.ReferenceToken
+}
+
+type DeleteOSD struct {
+	XMLName  string               `xml:"tr2:DeleteOSD"`
+	OSDToken onvif.ReferenceToken `xml:"tr2:OSDToken"`
+}
+
+type DeleteOSDResponse struct {
+}
+
+type GetMasks struct {
+	XMLName            string               `xml:"tr2:GetMasks"`
+	Token              onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+type GetMasksResponse struct {
+	Masks []Mask
+}
+
+type GetMaskOptions struct {
+	XMLName            string               `xml:"tr2:GetMaskOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+type GetMaskOptionsResponse struct {
+	Options MaskOptions
+}
+
+type SetMask struct {
+	XMLName string `xml:"tr2:SetMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+type SetMaskResponse struct {
+}
+
+type CreateMask struct {
+	XMLName string `xml:"tr2:CreateMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+type CreateMaskResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type DeleteMask struct {
+	XMLName string               `xml:"tr2:DeleteMask"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+type DeleteMaskResponse struct {
+}
@@ -0,0 +1,322 @@
+package networking
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// AuthMode is the authentication of the requests.
+// ONVIF Core 2.x allows both WS-UsernameToken and HTTP Digest, some devices accept only one of them.
+type AuthMode int
+
+const (
+	// AuthAuto sends WS-Security, if the device replies with a Digest challenge
+	// the request is repeated with HTTP Digest and WS-Security, the next requests use both
+	AuthAuto AuthMode = iota
+	// AuthWSSecurity sends WS-UsernameToken only
+	AuthWSSecurity
+	// AuthDigest sends HTTP Digest only
+	AuthDigest
+	// AuthBoth sends WS-UsernameToken and HTTP Digest
+	AuthBoth
+)
+
+func (mode AuthMode) String() string {
+	switch mode {
+	case AuthAuto:
+		return "auto"
+	case AuthWSSecurity:
+		return "ws-security"
+	case AuthDigest:
+		return "digest"
+	case AuthBoth:
+		return "both"
+	}
+	return fmt.Sprintf("AuthMode(%d)", int(mode))
+}
+
+// Auth authenticates the requests of a device.
+// It keeps the last Digest challenge of the device, so the next requests are authorized without the 401 round trip.
+type Auth struct {
+	mode AuthMode
+
+	mu        sync.Mutex
+	challenge *digestChallenge
+	// nc is the count of the requests sent with the nonce of the challenge
+	nc uint32
+}
+
+// NewAuth return the authentication of a device, it is shared by all the requests of the device
+func NewAuth(mode AuthMode) *Auth {
+	return &Auth{mode: mode}
+}
+
+// Mode return the authentication mode
+func (a *Auth) Mode() AuthMode {
+	return a.mode
+}
+
+// wsSecurity reports whether the WS-Security header is added to the envelope
+func (a *Auth) wsSecurity() bool {
+	return a == nil || a.mode != AuthDigest
+}
+
+// digest reports whether HTTP Digest is used
+func (a *Auth) digest() bool {
+	return a != nil && a.mode != AuthWSSecurity
+}
+
+// authorize set the Authorization header if the challenge of the device is known
+func (a *Auth) authorize(req *http.Request, username, password string) {
+	if !a.digest() {
+		return
+	}
+
+	a.mu.Lock()
+	challenge := a.challenge
+	a.nc++
+	nc := a.nc
+	a.mu.Unlock()
+
+	if challenge == nil {
+		return
+	}
+	if authorization, ok := challenge.authorization(req.Method, req.URL.RequestURI(), username, password, nc, newCnonce()); ok {
+		req.Header.Set("Authorization", authorization)
+	}
+}
+
+// update keep the Digest challenge of the 401 response, it reports whether the request can be repeated
+func (a *Auth) update(resp *http.Response) bool {
+	if !a.digest() || resp.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+
+	challenge := parseDigestChallenges(resp.Header.Values("WWW-Authenticate"))
+	if challenge == nil {
+		return false
+	}
+
+	a.mu.Lock()
+	a.challenge = challenge
+	a.nc = 0
+	a.mu.Unlock()
+	return true
+}
+
+// digestChallenge is the WWW-Authenticate: Digest challenge, RFC 7616
+type digestChallenge struct {
+	realm     string
+	nonce     string
+	opaque    string
+	algorithm string
+	qop       bool
+}
+
+// parseDigestChallenges returns the strongest supported Digest challenge: SHA-256 is preferred to MD5
+func parseDigestChallenges(headers []string) *digestChallenge {
+	var chosen *digestChallenge
+	var challenges []authChallenge
+	for _, header := range headers {
+		challenges = append(challenges, splitChallenges(header)...)
+	}
+
+	for _, c := range challenges {
+		if !strings.EqualFold(c.scheme, "Digest") {
+			continue
+		}
+
+		values := parseAuthParams(c.params)
+		challenge := &digestChallenge{
+			realm:     values["realm"],
+			nonce:     values["nonce"],
+			opaque:    values["opaque"],
+			algorithm: values["algorithm"],
+		}
+		if challenge.algorithm == "" {
+			challenge.algorithm = "MD5"
+		}
+		if challenge.nonce == "" || newDigestHash(challenge.algorithm) == nil {
+			continue
+		}
+
+		qop, ok := values["qop"]
+		if ok {
+			for _, option := range strings.Split(qop, ",") {
+				if strings.TrimSpace(option) == "auth" {
+					challenge.qop = true
+				}
+			}
+			// auth-int only is not supported, the body would be hashed
+			if !challenge.qop {
+				continue
+			}
+		}
+
+		if chosen == nil || challenge.sha256() && !chosen.sha256() {
+			chosen = challenge
+		}
+	}
+	return chosen
+}
+
+// authChallenge is a challenge of WWW-Authenticate, params are not parsed
+type authChallenge struct {
+	scheme string
+	params string
+}
+
+// splitChallenges split the WWW-Authenticate value into the challenges, RFC 7235 allows several in one value:
+//
+//	Digest realm="cam", nonce="1", Digest realm="cam", nonce="2", algorithm=SHA-256
+//
+// The items are separated by the commas outside of the quoted strings,
+// an item starting with a token not followed by "=" starts a challenge.
+func splitChallenges(header string) []authChallenge {
+	var items []string
+	quoted, start := false, 0
+	for i := 0; i < len(header); i++ {
+		switch {
+		case header[i] == '\\' && quoted:
+			i++
+		case header[i] == '"':
+			quoted = !quoted
+		case header[i] == ',' && !quoted:
+			items = append(items, header[start:i])
+			start = i + 1
+		}
+	}
+	items = append(items, header[start:])
+
+	var challenges []authChallenge
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		end := strings.IndexAny(item, " \t=")
+		if end < 0 || item[end] != '=' {
+			// a scheme, the rest of the item is the first param or token68
+			scheme, params, _ := strings.Cut(item, " ")
+			challenges = append(challenges, authChallenge{scheme: scheme, params: strings.TrimSpace(params)})
+			continue
+		}
+		if len(challenges) == 0 {
+			continue
+		}
+		c := &challenges[len(challenges)-1]
+		if c.params != "" {
+			c.params += ", "
+		}
+		c.params += item
+	}
+	return challenges
+}
+
+// parseAuthParams parse the comma separated name=value pairs, the values may be quoted
+func parseAuthParams(s string) map[string]string {
+	params := map[string]string{}
+	for {
+		s = strings.TrimLeft(s, " \t,")
+		if s == "" {
+			return params
+		}
+
+		i := strings.IndexByte(s, '=')
+		if i < 0 {
+			return params
+		}
+		name := strings.ToLower(strings.TrimSpace(s[:i]))
+		s = strings.TrimLeft(s[i+1:], " \t")
+
+		var value strings.Builder
+		if strings.HasPrefix(s, `"`) {
+			i = 1
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				value.WriteByte(s[i])
+			}
+			if i < len(s) {
+				i++
+			}
+			s = s[i:]
+		} else {
+			i = strings.IndexByte(s, ',')
+			if i < 0 {
+				i = len(s)
+			}
+			value.WriteString(strings.TrimSpace(s[:i]))
+			s = s[i:]
+		}
+		params[name] = value.String()
+	}
+}
+
+// authorization returns the Authorization header of the request
+func (c *digestChallenge) authorization(method, uri, username, password string, nc uint32, cnonce string) (string, bool) {
+	h := newDigestHash(c.algorithm)
+	if h == nil {
+		return "", false
+	}
+	hash := func(s string) string {
+		h.Reset()
+		h.Write([]byte(s))
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	ha1 := hash(username + ":" + c.realm + ":" + password)
+	if strings.HasSuffix(strings.ToUpper(c.algorithm), "-SESS") {
+		ha1 = hash(ha1 + ":" + c.nonce + ":" + cnonce)
+	}
+	ha2 := hash(method + ":" + uri)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `Digest username="%s", realm="%s", nonce="%s", uri="%s", algorithm=%s`,
+		quote(username), quote(c.realm), quote(c.nonce), quote(uri), c.algorithm)
+	if c.qop {
+		count := fmt.Sprintf("%08x", nc)
+		fmt.Fprintf(&b, `, response="%s", qop=auth, nc=%s, cnonce="%s"`,
+			hash(ha1+":"+c.nonce+":"+count+":"+cnonce+":auth:"+ha2), count, cnonce)
+	} else {
+		fmt.Fprintf(&b, `, response="%s"`, hash(ha1+":"+c.nonce+":"+ha2))
+	}
+	if c.opaque != "" {
+		fmt.Fprintf(&b, `, opaque="%s"`, quote(c.opaque))
+	}
+	return b.String(), true
+}
+
+func (c *digestChallenge) sha256() bool {
+	return strings.HasPrefix(strings.ToUpper(c.algorithm), "SHA-256")
+}
+
+func newDigestHash(algorithm string) hash.Hash {
+	switch strings.ToUpper(algorithm) {
+	case "MD5", "MD5-SESS":
+		return md5.New()
+	case "SHA-256", "SHA-256-SESS":
+		return sha256.New()
+	}
+	return nil
+}
+
+func newCnonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func quote(s string) string {
+	return quoteReplacer.Replace(s)
+}
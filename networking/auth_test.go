@@ -0,0 +1,280 @@
+package networking
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSplitChallenges(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []authChallenge
+	}{
+		{`Digest realm="cam", nonce="abc", qop="auth"`, []authChallenge{
+			{"Digest", `realm="cam", nonce="abc", qop="auth"`},
+		}},
+		{`Digest realm="cam", nonce="1", Digest realm="cam", nonce="2", algorithm=SHA-256`, []authChallenge{
+			{"Digest", `realm="cam", nonce="1"`},
+			{"Digest", `realm="cam", nonce="2", algorithm=SHA-256`},
+		}},
+		{`Basic realm="a, b", Digest realm="cam", qop="auth,auth-int", nonce="1"`, []authChallenge{
+			{"Basic", `realm="a, b"`},
+			{"Digest", `realm="cam", qop="auth,auth-int", nonce="1"`},
+		}},
+		{`Negotiate, Basic dXNlcjpwYXNz==, Digest nonce="a\"b"`, []authChallenge{
+			{"Negotiate", ""},
+			{"Basic", "dXNlcjpwYXNz=="},
+			{"Digest", `nonce="a\"b"`},
+		}},
+	}
+	for _, tt := range tests {
+		if got := splitChallenges(tt.header); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s\ngot  %q\nwant %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestParseDigestChallenges(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		want    *digestChallenge
+	}{
+		{
+			name:    "sha-256 of the same value",
+			headers: []string{`Digest realm="cam", nonce="1", qop="auth", Digest realm="cam", nonce="2", qop="auth", algorithm=SHA-256`},
+			want:    &digestChallenge{realm: "cam", nonce: "2", algorithm: "SHA-256", qop: true},
+		},
+		{
+			name:    "sha-256 of the other header",
+			headers: []string{`Digest realm="cam", nonce="2", algorithm=SHA-256`, `Digest realm="cam", nonce="1"`},
+			want:    &digestChallenge{realm: "cam", nonce: "2", algorithm: "SHA-256"},
+		},
+		{
+			name:    "basic before digest",
+			headers: []string{`Basic realm="cam", Digest realm="cam", nonce="1", opaque="o", qop="auth-int,auth"`},
+			want:    &digestChallenge{realm: "cam", nonce: "1", opaque: "o", algorithm: "MD5", qop: true},
+		},
+		{
+			name:    "unsupported",
+			headers: []string{`Digest realm="cam", nonce="1", algorithm=SHA-512-256, Digest realm="cam", nonce="2", qop="auth-int"`, `Basic realm="cam"`},
+		},
+	}
+	for _, tt := range tests {
+		if got := parseDigestChallenges(tt.headers); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// digestCall is a request received by digestServer
+type digestCall struct {
+	wsSecurity    bool
+	authorization map[string]string
+	authorized    bool
+}
+
+// digestServer requires HTTP Digest with qop=auth, it offers the challenges of algorithms in one WWW-Authenticate value
+type digestServer struct {
+	*httptest.Server
+	algorithms []string
+	// nonces is the count of the requests authorized with a nonce before it becomes stale, 0 is unlimited
+	nonces int
+
+	mu     sync.Mutex
+	nonce  int
+	used   int
+	calls  []digestCall
+	counts []string
+}
+
+func newDigestServer(t *testing.T, algorithms ...string) *digestServer {
+	s := &digestServer{algorithms: algorithms, nonce: 1}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *digestServer) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	call := digestCall{wsSecurity: strings.Contains(string(body), "UsernameToken")}
+	stale := false
+	if scheme, params, _ := strings.Cut(r.Header.Get("Authorization"), " "); scheme == "Digest" {
+		call.authorization = parseAuthParams(params)
+		call.authorized = s.verify(r.Method, call.authorization)
+		if call.authorized && call.authorization["nonce"] != s.currentNonce() {
+			call.authorized, stale = false, true
+		}
+	}
+	s.calls = append(s.calls, call)
+
+	if !call.authorized {
+		var challenges []string
+		for _, algorithm := range s.algorithms {
+			challenge := fmt.Sprintf(`Digest realm="cam", nonce="%s", qop="auth", algorithm=%s`, s.currentNonce(), algorithm)
+			if stale {
+				challenge += ", stale=true"
+			}
+			challenges = append(challenges, challenge)
+		}
+		w.Header().Set("WWW-Authenticate", strings.Join(challenges, ", "))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.counts = append(s.counts, call.authorization["nc"])
+	if s.used++; s.nonces > 0 && s.used >= s.nonces {
+		s.nonce++
+		s.used = 0
+	}
+	io.WriteString(w, `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope"><s:Body><GetProfilesResponse/></s:Body></s:Envelope>`)
+}
+
+func (s *digestServer) currentNonce() string {
+	return fmt.Sprintf("nonce%d", s.nonce)
+}
+
+// verify checks the response of the Authorization params, the password is "secret"
+func (s *digestServer) verify(method string, params map[string]string) bool {
+	var h hash.Hash
+	switch params["algorithm"] {
+	case "MD5", "":
+		h = md5.New()
+	case "SHA-256":
+		h = sha256.New()
+	default:
+		return false
+	}
+	hash := func(s string) string {
+		h.Reset()
+		io.WriteString(h, s)
+		return hex.EncodeToString(h.Sum(nil))
+	}
+	ha1 := hash(params["username"] + ":" + params["realm"] + ":secret")
+	ha2 := hash(method + ":" + params["uri"])
+	want := hash(ha1 + ":" + params["nonce"] + ":" + params["nc"] + ":" + params["cnonce"] + ":" + params["qop"] + ":" + ha2)
+	return params["response"] == want && params["qop"] == "auth"
+}
+
+func (s *digestServer) takeCalls() []digestCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	calls := s.calls
+	s.calls = nil
+	return calls
+}
+
+func (s *digestServer) do(t *testing.T, auth *Auth) error {
+	t.Helper()
+	return NewRequest(testDevice{}, getProfiles{}).
+		WithEndpoint(s.URL + "/onvif/media").
+		WithUsernamePassword("admin", "secret").
+		WithAuth(auth).
+		Do().
+		Unmarshal(&struct{}{})
+}
+
+func TestDigestRetry(t *testing.T) {
+	server := newDigestServer(t, "MD5")
+	auth := NewAuth(AuthDigest)
+
+	if err := server.do(t, auth); err != nil {
+		t.Fatal(err)
+	}
+	calls := server.takeCalls()
+	if len(calls) != 2 || calls[0].authorization != nil || !calls[1].authorized {
+		t.Fatalf("calls %+v, want 401 and the authorized retry", calls)
+	}
+
+	// the challenge is kept, the next requests are authorized at once with the next nonce count
+	for i := 0; i < 2; i++ {
+		if err := server.do(t, auth); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls := server.takeCalls(); len(calls) != 2 {
+		t.Errorf("%d calls, want the request only", len(calls))
+	}
+	if want := []string{"00000001", "00000002", "00000003"}; !reflect.DeepEqual(server.counts, want) {
+		t.Errorf("nc %v, want %v", server.counts, want)
+	}
+}
+
+func TestDigestSHA256(t *testing.T) {
+	server := newDigestServer(t, "MD5", "SHA-256")
+	if err := server.do(t, NewAuth(AuthDigest)); err != nil {
+		t.Fatal(err)
+	}
+	calls := server.takeCalls()
+	if len(calls) != 2 || calls[1].authorization["algorithm"] != "SHA-256" {
+		t.Errorf("calls %+v, want the SHA-256 retry", calls)
+	}
+}
+
+func TestDigestStaleNonce(t *testing.T) {
+	server := newDigestServer(t, "SHA-256")
+	server.nonces = 2
+	auth := NewAuth(AuthDigest)
+
+	for i := 0; i < 3; i++ {
+		if err := server.do(t, auth); err != nil {
+			t.Fatal(err)
+		}
+	}
+	calls := server.takeCalls()
+	// 401, nonce1 twice, the stale nonce1, nonce2
+	if len(calls) != 5 || calls[3].authorized || !calls[4].authorized || calls[4].authorization["nonce"] != "nonce2" {
+		t.Fatalf("calls %+v", calls)
+	}
+	if want := []string{"00000001", "00000002", "00000001"}; !reflect.DeepEqual(server.counts, want) {
+		t.Errorf("nc %v, want %v, the count starts again with the new nonce", server.counts, want)
+	}
+}
+
+func TestAuthModes(t *testing.T) {
+	type sent struct{ wsSecurity, digest bool }
+	tests := []struct {
+		mode AuthMode
+		// first and next are the calls of the first and the next request
+		first, next []sent
+		err         bool
+	}{
+		{mode: AuthWSSecurity, first: []sent{{true, false}}, next: []sent{{true, false}}, err: true},
+		{mode: AuthDigest, first: []sent{{false, false}, {false, true}}, next: []sent{{false, true}}},
+		{mode: AuthAuto, first: []sent{{true, false}, {true, true}}, next: []sent{{true, true}}},
+		{mode: AuthBoth, first: []sent{{true, false}, {true, true}}, next: []sent{{true, true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			server := newDigestServer(t, "MD5")
+			auth := NewAuth(tt.mode)
+			for i, want := range [][]sent{tt.first, tt.next} {
+				err := server.do(t, auth)
+				if (err != nil) != tt.err {
+					t.Fatalf("request %d: error %v", i, err)
+				}
+				var got []sent
+				for _, call := range server.takeCalls() {
+					got = append(got, sent{call.wsSecurity, call.authorization != nil})
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("request %d: sent %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
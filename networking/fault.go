@@ -0,0 +1,166 @@
+package networking
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// ONVIF fault subcodes (ter namespace http://www.onvif.org/ver10/error)
+const (
+	FaultNotAuthorized       = "ter:NotAuthorized"
+	FaultInvalidArgVal       = "ter:InvalidArgVal"
+	FaultInvalidArgs         = "ter:InvalidArgs"
+	FaultActionNotSupported  = "ter:ActionNotSupported"
+	FaultActionFailed        = "ter:Action"
+	FaultOperationProhibited = "ter:OperationProhibited"
+	FaultNoProfile           = "ter:NoProfile"
+	FaultNoConfig            = "ter:NoConfig"
+	FaultNoEntity            = "ter:NoEntity"
+	FaultTooManyPresets      = "ter:TooManyPresets"
+)
+
+// Sentinel errors matched by SOAPFault.Is, usable with errors.Is.
+var (
+	ErrNotAuthorized      = errors.New("not authorized")
+	ErrInvalidArgVal      = errors.New("invalid argument value")
+	ErrActionNotSupported = errors.New("action not supported")
+)
+
+var faultSentinels = map[error]string{
+	ErrNotAuthorized:      FaultNotAuthorized,
+	ErrInvalidArgVal:      FaultInvalidArgVal,
+	ErrActionNotSupported: FaultActionNotSupported,
+}
+
+// FaultCode is a node of the SOAP 1.2 Code/Subcode chain.
+type FaultCode struct {
+	Value   string     `xml:"Value"`
+	Subcode *FaultCode `xml:"Subcode"`
+}
+
+// SOAPFault is the error returned by Response.Unmarshal when the device replies with soap-env:Fault.
+// Use errors.As to get it and HasSubcode (or errors.Is with ErrNotAuthorized etc.) to branch on the ONVIF subcode.
+type SOAPFault struct {
+	// StatusCode is the HTTP status code of the response carrying the fault
+	StatusCode int
+	Code       FaultCode
+	Reason     string
+	// Detail is the raw inner xml of the Detail element
+	Detail string
+}
+
+type faultReason struct {
+	Text []string `xml:"Text"`
+}
+
+type faultDetail struct {
+	InnerXML string `xml:",innerxml"`
+}
+
+type soapFault struct {
+	Code   FaultCode   `xml:"Code"`
+	Reason faultReason `xml:"Reason"`
+	Detail faultDetail `xml:"Detail"`
+
+	// SOAP 1.1 form, still sent by some old firmwares
+	FaultCode   string      `xml:"faultcode"`
+	FaultString string      `xml:"faultstring"`
+	FaultDetail faultDetail `xml:"detail"`
+}
+
+// ParseFault returns SOAPFault if body (the first child of soap-env:Body) is a Fault element, nil otherwise.
+func ParseFault(body string) *SOAPFault {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(body); err != nil {
+		return nil
+	}
+	if root := doc.Root(); root == nil || root.Tag != "Fault" {
+		return nil
+	}
+
+	raw := soapFault{}
+	if err := xml.Unmarshal([]byte(body), &raw); err != nil {
+		return nil
+	}
+
+	fault := &SOAPFault{
+		Code:   raw.Code,
+		Reason: strings.TrimSpace(strings.Join(raw.Reason.Text, " ")),
+		Detail: strings.TrimSpace(raw.Detail.InnerXML),
+	}
+	if len(fault.Code.Value) == 0 && len(raw.FaultCode) > 0 {
+		fault.Code.Value = strings.TrimSpace(raw.FaultCode)
+		fault.Reason = strings.TrimSpace(raw.FaultString)
+		fault.Detail = strings.TrimSpace(raw.FaultDetail.InnerXML)
+	}
+	fault.Code.trim()
+
+	return fault
+}
+
+func (code *FaultCode) trim() {
+	for c := code; c != nil; c = c.Subcode {
+		c.Value = strings.TrimSpace(c.Value)
+	}
+}
+
+// Codes return Code value followed by all the Subcode values.
+func (f *SOAPFault) Codes() []string {
+	codes := make([]string, 0, 3)
+	for c := &f.Code; c != nil; c = c.Subcode {
+		codes = append(codes, c.Value)
+	}
+	return codes
+}
+
+// HasSubcode check whether the Code/Subcode chain contains code.
+// Prefixes are ignored, so "ter:NotAuthorized" matches "env:NotAuthorized" too.
+func (f *SOAPFault) HasSubcode(code string) bool {
+	local := localName(code)
+	for _, c := range f.Codes() {
+		if localName(c) == local {
+			return true
+		}
+	}
+	return false
+}
+
+// NotAuthorized reports whether the fault is ter:NotAuthorized
+func (f *SOAPFault) NotAuthorized() bool {
+	return f.HasSubcode(FaultNotAuthorized)
+}
+
+// ActionNotSupported reports whether the fault is ter:ActionNotSupported
+func (f *SOAPFault) ActionNotSupported() bool {
+	return f.HasSubcode(FaultActionNotSupported)
+}
+
+// InvalidArgVal reports whether the fault is ter:InvalidArgVal
+func (f *SOAPFault) InvalidArgVal() bool {
+	return f.HasSubcode(FaultInvalidArgVal)
+}
+
+// Is allows errors.Is(err, ErrNotAuthorized) and similar checks.
+func (f *SOAPFault) Is(target error) bool {
+	code, ok := faultSentinels[target]
+	return ok && f.HasSubcode(code)
+}
+
+func (f *SOAPFault) Error() string {
+	codes := strings.Join(f.Codes(), "/")
+	if len(f.Reason) == 0 {
+		return fmt.Sprintf("soap fault: %s", codes)
+	}
+	return fmt.Sprintf("soap fault: %s: %s", codes, f.Reason)
+}
+
+func localName(qname string) string {
+	if i := strings.LastIndex(qname, ":"); i >= 0 {
+		return qname[i+1:]
+	}
+	return qname
+}
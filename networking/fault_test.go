@@ -0,0 +1,155 @@
+package networking
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const soap12Fault = `<env:Fault xmlns:env="http://www.w3.org/2003/05/soap-envelope" xmlns:ter="http://www.onvif.org/ver10/error">
+	<env:Code>
+		<env:Value>env:Sender</env:Value>
+		<env:Subcode>
+			<env:Value>ter:NotAuthorized</env:Value>
+			<env:Subcode><env:Value> ter:SenderNotAuthorized </env:Value></env:Subcode>
+		</env:Subcode>
+	</env:Code>
+	<env:Reason><env:Text xml:lang="en">Sender not Authorized</env:Text></env:Reason>
+	<env:Detail><env:Text>the user has no access</env:Text></env:Detail>
+</env:Fault>`
+
+const soap11Fault = `<SOAP-ENV:Fault xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+	<faultcode>SOAP-ENV:Client</faultcode>
+	<faultstring>Method 'tds:GetFoo' not implemented</faultstring>
+	<detail><reason>ActionNotSupported</reason></detail>
+</SOAP-ENV:Fault>`
+
+func TestParseFault(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		codes  []string
+		reason string
+		detail string
+	}{
+		{
+			name:   "soap 1.2 subcode chain",
+			body:   soap12Fault,
+			codes:  []string{"env:Sender", "ter:NotAuthorized", "ter:SenderNotAuthorized"},
+			reason: "Sender not Authorized",
+			detail: "<env:Text>the user has no access</env:Text>",
+		},
+		{
+			name:   "soap 1.1 faultcode",
+			body:   soap11Fault,
+			codes:  []string{"SOAP-ENV:Client"},
+			reason: "Method 'tds:GetFoo' not implemented",
+			detail: "<reason>ActionNotSupported</reason>",
+		},
+		{
+			name: "response with Fault in the text",
+			body: `<tds:GetSystemLogResponse xmlns:tds="http://www.onvif.org/ver10/device/wsdl"><tds:SystemLog><tt:String xmlns:tt="http://www.onvif.org/ver10/schema">Fault: disk</tt:String></tds:SystemLog></tds:GetSystemLogResponse>`,
+		},
+		{
+			name: "element named Fault inside the response",
+			body: `<GetStatusResponse><Fault><Code><Value>x</Value></Code></Fault></GetStatusResponse>`,
+		},
+		{
+			name: "invalid xml",
+			body: `<env:Fault><env:Code>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fault := ParseFault(tt.body)
+			if tt.codes == nil {
+				if fault != nil {
+					t.Fatalf("fault %v, want nil", fault)
+				}
+				return
+			}
+			if fault == nil {
+				t.Fatal("fault is nil")
+			}
+			if !reflect.DeepEqual(fault.Codes(), tt.codes) {
+				t.Errorf("codes %q, want %q", fault.Codes(), tt.codes)
+			}
+			if fault.Reason != tt.reason {
+				t.Errorf("reason %q, want %q", fault.Reason, tt.reason)
+			}
+			if fault.Detail != tt.detail {
+				t.Errorf("detail %q, want %q", fault.Detail, tt.detail)
+			}
+		})
+	}
+}
+
+func TestSOAPFaultIs(t *testing.T) {
+	fault := ParseFault(soap12Fault)
+	if !fault.NotAuthorized() || !fault.HasSubcode("SenderNotAuthorized") || !fault.HasSubcode("env:Sender") {
+		t.Errorf("subcodes of %v", fault)
+	}
+	if fault.ActionNotSupported() || fault.InvalidArgVal() {
+		t.Errorf("unexpected subcode of %v", fault)
+	}
+	if !errors.Is(fault, ErrNotAuthorized) || errors.Is(fault, ErrActionNotSupported) {
+		t.Errorf("errors.Is of %v", fault)
+	}
+	if want := "soap fault: env:Sender/ter:NotAuthorized/ter:SenderNotAuthorized: Sender not Authorized"; fault.Error() != want {
+		t.Errorf("Error() = %q, want %q", fault.Error(), want)
+	}
+}
+
+func TestResponseFault(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		fault  bool
+		// notAuthorized is the result of errors.Is(err, ErrNotAuthorized)
+		notAuthorized bool
+	}{
+		{"soap 1.2", http.StatusBadRequest, soap12Fault, true, true},
+		{"soap 1.1", http.StatusInternalServerError, soap11Fault, true, false},
+		{"text with Fault", http.StatusOK, `<GetSystemLogResponse><SystemLog><String>Fault: disk</String></SystemLog></GetSystemLogResponse>`, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				io.WriteString(w, `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body>`+tt.body+`</env:Body></env:Envelope>`)
+			}))
+			defer server.Close()
+
+			var resp struct {
+				SystemLog struct {
+					String string
+				}
+			}
+			err := NewRequest(testDevice{}, getProfiles{}).WithEndpoint(server.URL).Do().Unmarshal(&resp)
+
+			var fault *SOAPFault
+			if !tt.fault {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp.SystemLog.String != "Fault: disk" {
+					t.Errorf("response %+v", resp)
+				}
+				return
+			}
+			if !errors.As(err, &fault) {
+				t.Fatalf("error %v is not SOAPFault", err)
+			}
+			if fault.StatusCode != tt.status {
+				t.Errorf("status %d, want %d", fault.StatusCode, tt.status)
+			}
+			if errors.Is(err, ErrNotAuthorized) != tt.notAuthorized {
+				t.Errorf("errors.Is(%v, ErrNotAuthorized) is not %v", err, tt.notAuthorized)
+			}
+		})
+	}
+}
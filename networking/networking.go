@@ -1,29 +1,30 @@
 package networking
 
 import (
-	"bytes"
 	"context"
+	"io"
 	"net/http"
+	"strings"
 )
 
+//go:generate go run ../cmd/onvif-gen -xlmns -out xlmns.go ../docs/wsdl ../xsd/onvif
+
 // SendSoap send soap message
 func SendSoap(httpClient *http.Client, endpoint, message string) (*http.Response, error) {
-	resp, err := httpClient.Post(endpoint, "application/soap+xml; charset=utf-8", bytes.NewBufferString(message))
-	if err != nil {
-		return resp, err
-	}
-
-	return resp, nil
+	return sendSoap(context.Background(), httpClient, endpoint, strings.NewReader(message))
 }
 
 // SendSoapWithCtx send soap message with context
 func SendSoapWithCtx(ctx context.Context, httpClient *http.Client, endpoint, message string) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBufferString(message))
+	return sendSoap(ctx, httpClient, endpoint, strings.NewReader(message))
+}
+
+func sendSoap(ctx context.Context, httpClient *http.Client, endpoint string, body io.Reader) (*http.Response, error) {
+	req, err := newSoapRequest(ctx, endpoint, body)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/soap+xml; charset=utf-8")
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return resp, err
@@ -31,3 +32,13 @@ func SendSoapWithCtx(ctx context.Context, httpClient *http.Client, endpoint, mes
 
 	return resp, nil
 }
+
+func newSoapRequest(ctx context.Context, endpoint string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/soap+xml; charset=utf-8")
+	return req, nil
+}
@@ -0,0 +1,97 @@
+package networking
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrRepeatedStartReference is returned by Pages when the device returns the same NextStartReference twice,
+// paging would never end.
+var ErrRepeatedStartReference = errors.New("onvif: device returned repeated NextStartReference")
+
+// PageFunc requests a page of the items, the GetXxxList(Limit, StartReference) operations of the
+// access control services (Profile C/D). It return the items and the NextStartReference, empty for the last page.
+// limit 0 means the device default.
+type PageFunc[T any] func(ctx context.Context, limit int, startReference string) ([]T, string, error)
+
+// Pages iterates the items of all pages of the PageFunc, the typed clients methods are usually used as PageFunc:
+//
+//	pages := networking.NewPages(doorcontrol.NewClient(device).GetDoorInfoList, 0)
+//	for pages.Next(ctx) {
+//		fmt.Println(pages.Value().Token)
+//	}
+//	return pages.Err()
+type Pages[T any] struct {
+	// Limit is the page size requested from the device, 0 means the device default.
+	// The device may return less items than requested.
+	Limit int
+
+	fetch     PageFunc[T]
+	next      string
+	page      []T
+	value     T
+	started   bool
+	completed bool
+	err       error
+}
+
+// NewPages return the iterator of the fetch pages, limit 0 means the device default
+func NewPages[T any](fetch PageFunc[T], limit int) *Pages[T] {
+	return &Pages[T]{Limit: limit, fetch: fetch}
+}
+
+// Next advance to the next item, it requests the next page when the current one is over.
+// False is returned after the last page or on error, see Err.
+func (p *Pages[T]) Next(ctx context.Context) bool {
+	for len(p.page) == 0 {
+		if p.completed || p.err != nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		page, next, err := p.fetch(ctx, p.Limit, p.next)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		if next != "" && p.started && next == p.next {
+			p.err = ErrRepeatedStartReference
+			return false
+		}
+
+		p.started = true
+		p.page = page
+		p.next = next
+		p.completed = next == ""
+	}
+
+	p.value, p.page = p.page[0], p.page[1:]
+	return true
+}
+
+// Value return the current item
+func (p *Pages[T]) Value() T {
+	return p.value
+}
+
+// Err return the error which stopped the iteration
+func (p *Pages[T]) Err() error {
+	return p.err
+}
+
+// All return the rest items of all pages
+func (p *Pages[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.value)
+	}
+	return items, p.err
+}
+
+// GetAll return the items of all pages of the fetch, limit 0 means the device default
+func GetAll[T any](ctx context.Context, fetch PageFunc[T], limit int) ([]T, error) {
+	return NewPages(fetch, limit).All(ctx)
+}
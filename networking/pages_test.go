@@ -0,0 +1,160 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// testPage is a page returned by pagesFunc
+type testPage struct {
+	items []string
+	next  string
+}
+
+// pagesFunc return the PageFunc of the pages, the page is selected by the start reference,
+// the empty reference is the first page and "pN" is the page with index N.
+// The requested start references are appended to requests.
+func pagesFunc(pages []testPage, requests *[]string) PageFunc[string] {
+	return func(ctx context.Context, limit int, startReference string) ([]string, string, error) {
+		*requests = append(*requests, startReference)
+		i := 0
+		if startReference != "" {
+			var err error
+			if i, err = strconv.Atoi(startReference[1:]); err != nil || i >= len(pages) {
+				return nil, "", errors.New("invalid StartReference")
+			}
+		}
+		return pages[i].items, pages[i].next, nil
+	}
+}
+
+func TestPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		pages    []testPage
+		want     []string
+		requests []string
+		err      error
+	}{
+		{
+			name:     "single page",
+			pages:    []testPage{{items: []string{"a", "b"}}},
+			want:     []string{"a", "b"},
+			requests: []string{""},
+		},
+		{
+			name:     "several pages",
+			pages:    []testPage{{[]string{"a", "b"}, "p1"}, {[]string{"c"}, "p2"}, {[]string{"d"}, ""}},
+			want:     []string{"a", "b", "c", "d"},
+			requests: []string{"", "p1", "p2"},
+		},
+		{
+			name:     "empty page in the middle",
+			pages:    []testPage{{[]string{"a"}, "p1"}, {nil, "p2"}, {[]string{"b"}, ""}},
+			want:     []string{"a", "b"},
+			requests: []string{"", "p1", "p2"},
+		},
+		{
+			name:     "empty last page",
+			pages:    []testPage{{[]string{"a"}, "p1"}, {nil, ""}},
+			want:     []string{"a"},
+			requests: []string{"", "p1"},
+		},
+		{
+			name:     "no items",
+			pages:    []testPage{{}},
+			requests: []string{""},
+		},
+		{
+			name:     "repeated start reference",
+			pages:    []testPage{{[]string{"a"}, "p1"}, {[]string{"b"}, "p1"}},
+			want:     []string{"a"},
+			requests: []string{"", "p1"},
+			err:      ErrRepeatedStartReference,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			got, err := GetAll(context.Background(), pagesFunc(tt.pages, &requests), 0)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("items %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(requests, tt.requests) {
+				t.Errorf("start references %q, want %q", requests, tt.requests)
+			}
+		})
+	}
+}
+
+func TestPagesLimit(t *testing.T) {
+	var limits []int
+	pages := NewPages(func(ctx context.Context, limit int, startReference string) ([]int, string, error) {
+		limits = append(limits, limit)
+		if startReference == "" {
+			return []int{1}, "next", nil
+		}
+		return []int{2}, "", nil
+	}, 10)
+	pages.Limit = 20
+
+	var got []int
+	for pages.Next(context.Background()) {
+		got = append(got, pages.Value())
+		if len(got) == 1 {
+			pages.Limit = 30
+		}
+	}
+	if pages.Err() != nil {
+		t.Fatal(pages.Err())
+	}
+	if !reflect.DeepEqual(got, []int{1, 2}) || !reflect.DeepEqual(limits, []int{20, 30}) {
+		t.Errorf("items %v limits %v", got, limits)
+	}
+}
+
+func TestPagesError(t *testing.T) {
+	fail := errors.New("connection reset")
+	calls := 0
+	pages := NewPages(func(ctx context.Context, limit int, startReference string) ([]string, string, error) {
+		if calls++; calls == 1 {
+			return []string{"a"}, "p1", nil
+		}
+		return nil, "", fail
+	}, 0)
+
+	got, err := pages.All(context.Background())
+	if !errors.Is(err, fail) || !reflect.DeepEqual(got, []string{"a"}) {
+		t.Fatalf("items %v error %v, want the first page and %v", got, err, fail)
+	}
+	if pages.Next(context.Background()) || calls != 2 {
+		t.Errorf("%d requests, the error is not kept", calls)
+	}
+}
+
+func TestPagesCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var requests []string
+	pages := NewPages(pagesFunc([]testPage{{[]string{"a"}, "p1"}, {[]string{"b"}, ""}}, &requests), 0)
+	if !pages.Next(ctx) || pages.Value() != "a" {
+		t.Fatalf("first item %q, error %v", pages.Value(), pages.Err())
+	}
+	cancel()
+	if pages.Next(ctx) {
+		t.Errorf("item %q after cancel", pages.Value())
+	}
+	if !errors.Is(pages.Err(), context.Canceled) {
+		t.Errorf("error %v, want context.Canceled", pages.Err())
+	}
+	if !reflect.DeepEqual(requests, []string{""}) {
+		t.Errorf("start references %q, the next page is requested after cancel", requests)
+	}
+}
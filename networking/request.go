@@ -1,15 +1,14 @@
 package networking
 
 import (
+	"bytes"
 	"context"
-	"encoding/xml"
+	"io"
 	"net/http"
 	"reflect"
 	"strings"
 	"time"
 
-	"github.com/beevik/etree"
-
 	"github.com/neirolis/onvif-go/gosoap"
 )
 
@@ -18,6 +17,19 @@ type device interface {
 	DeltaTime() time.Duration
 }
 
+// RequestCreator creates requests bound to a device, it is implemented by onvif.Device.
+// Typed service clients are built on top of it.
+type RequestCreator interface {
+	CreateRequest(method interface{}) *Request
+}
+
+// HostReplacer rewrites the addresses reported by a device behind NAT, it is implemented by onvif.Device.
+// Typed service clients use it when the RequestCreator implements it.
+type HostReplacer interface {
+	ReplaceHostToXAddr(u string) (string, error)
+	ReplaceHostKeepPort(u string) string
+}
+
 type Request struct {
 	ctx        context.Context
 	device     device
@@ -26,6 +38,8 @@ type Request struct {
 	username   string
 	password   string
 	endpoint   string
+	auth       *Auth
+	headers    []interface{}
 }
 
 func NewRequest(device device, method interface{}) *Request {
@@ -51,11 +65,23 @@ func (r *Request) WithUsernamePassword(username, password string) *Request {
 	return r
 }
 
+// WithAuth set the authentication of the device, WS-Security is used without it
+func (r *Request) WithAuth(auth *Auth) *Request {
+	r.auth = auth
+	return r
+}
+
 func (r *Request) WithEndpoint(endpoint string) *Request {
 	r.endpoint = endpoint
 	return r
 }
 
+// WithHeader add the SOAP header block, ex: the reference parameters of the endpoint reference
+func (r *Request) WithHeader(block interface{}) *Request {
+	r.headers = append(r.headers, block)
+	return r
+}
+
 func (r *Request) Do() *Response {
 	resp := &Response{}
 
@@ -65,27 +91,44 @@ func (r *Request) Do() *Response {
 		return resp
 	}
 
-	soap, err := r.buildSOAP(r.method, endpoint)
-	if err != nil {
-		resp.error = err
-		return resp
-	}
-
 	if r.httpClient == nil {
 		r.httpClient = new(http.Client)
 	}
 
-	var response *http.Response
-	if r.ctx != nil {
-		response, resp.error = SendSoapWithCtx(r.ctx, r.httpClient, endpoint, soap.String())
-	} else {
-		response, resp.error = SendSoap(r.httpClient, endpoint, soap.String())
+	response, err := r.send(endpoint)
+	if err == nil && r.username != "" && r.auth.update(response) {
+		// the device asked for Digest, the request is repeated with the new challenge
+		_, _ = io.Copy(io.Discard, response.Body)
+		response.Body.Close()
+		response, err = r.send(endpoint)
 	}
+	resp.error = err
 
 	resp.SetResponse(response)
 	return resp
 }
 
+func (r *Request) send(endpoint string) (*http.Response, error) {
+	soap, err := r.buildSOAP(r.method, endpoint).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := r.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	req, err := newSoapRequest(ctx, endpoint, bytes.NewReader(soap))
+	if err != nil {
+		return nil, err
+	}
+	if r.username != "" {
+		r.auth.authorize(req, r.username, r.password)
+	}
+	return r.httpClient.Do(req)
+}
+
 func (r *Request) getEndpoint(request interface{}) (string, error) {
 	if len(r.endpoint) > 0 {
 		return r.endpoint, nil
@@ -100,32 +143,17 @@ func (r *Request) getEndpoint(request interface{}) (string, error) {
 	return endpoint, nil
 }
 
-func (r *Request) buildSOAP(method interface{}, endpoint string) (gosoap.SoapMessage, error) {
-	output, err := xml.MarshalIndent(method, "  ", "    ")
-	if err != nil {
-		return "", err
-	}
-
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(string(output)); err != nil {
-		return "", err
-	}
+func (r *Request) buildSOAP(method interface{}, endpoint string) *gosoap.Envelope {
+	env := gosoap.NewEnvelope(Xlmns)
+	env.SetBody(method)
 
-	soap := gosoap.NewEmptySOAP()
-	soap.AddRootNamespaces(Xlmns)
-	if err := soap.AddBodyContent(doc.Root()); err != nil {
-		return "", err
+	if r.username != "" && r.password != "" && r.auth.wsSecurity() {
+		env.AddWSSecurity(r.username, r.password, r.device.DeltaTime())
 	}
-
-	if r.username != "" && r.password != "" {
-		if err := soap.AddWSSecurity(r.username, r.password, r.device.DeltaTime()); err != nil {
-			return "", err
-		}
-	}
-
-	if err := soap.AddTo(endpoint); err != nil {
-		return "", err
+	env.AddTo(endpoint)
+	for _, block := range r.headers {
+		env.AddHeader(block)
 	}
 
-	return soap, nil
+	return env
 }
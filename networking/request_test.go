@@ -0,0 +1,277 @@
+package networking
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+
+	"github.com/neirolis/onvif-go/gosoap"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+type testDevice struct{}
+
+func (testDevice) GetEndpoint(string) (string, error) { return "http://192.168.0.10/onvif/media", nil }
+func (testDevice) DeltaTime() time.Duration           { return 0 }
+
+// the requests of the media package, it can not be imported here
+type getProfiles struct {
+	XMLName string `xml:"trt:GetProfiles"`
+}
+
+type setVideoEncoderConfiguration struct {
+	XMLName          string                          `xml:"trt:SetVideoEncoderConfiguration"`
+	Configuration    onvif.VideoEncoderConfiguration `xml:"trt:Configuration"`
+	ForcePersistence xsd.Boolean                     `xml:"trt:ForcePersistence"`
+}
+
+type setOSD struct {
+	XMLName string                 `xml:"trt:SetOSD"`
+	OSD     onvif.OSDConfiguration `xml:"trt:OSD"`
+}
+
+var requests = []struct {
+	name   string
+	method interface{}
+}{
+	{"GetProfiles", getProfiles{}},
+	{"SetVideoEncoderConfiguration", setVideoEncoderConfiguration{
+		Configuration: onvif.VideoEncoderConfiguration{
+			ConfigurationEntity: onvif.ConfigurationEntity{Token: "encoder_1", Name: "MainStream", UseCount: 2},
+			Encoding:            "H264",
+			Resolution:          onvif.VideoResolution{Width: 1920, Height: 1080},
+			Quality:             4,
+			RateControl:         &onvif.VideoRateControl{FrameRateLimit: 25, EncodingInterval: 1, BitrateLimit: 4096},
+			H264:                &onvif.H264Configuration{GovLength: 50, H264Profile: "Main"},
+			Multicast: onvif.MulticastConfiguration{
+				Address: onvif.IPAddress{Type: "IPv4", IPv4Address: "239.0.0.1"},
+				Port:    5004,
+				TTL:     64,
+			},
+			SessionTimeout: "PT60S",
+		},
+		ForcePersistence: true,
+	}},
+	{"SetOSD", setOSD{
+		OSD: onvif.OSDConfiguration{
+			DeviceEntity:                  onvif.DeviceEntity{Token: "osd_1"},
+			VideoSourceConfigurationToken: onvif.OSDReference("source_1"),
+			Type:                          "Text",
+			Position:                      onvif.OSDPosConfiguration{Type: "UpperLeft"},
+			TextString: &onvif.OSDTextConfiguration{
+				Type:      "DateAndTime",
+				Extension: xsd.AnyElement{InnerXML: `<acme:Blink xmlns:acme="http://example.com/acme">true</acme:Blink>`},
+			},
+		},
+	}},
+}
+
+// buildSoapMessage is the request builder replaced by Envelope, kept to compare the output and the speed
+func buildSoapMessage(method interface{}, endpoint, username, password string, deltaTime time.Duration) (gosoap.SoapMessage, error) {
+	output, err := xml.MarshalIndent(method, "  ", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(string(output)); err != nil {
+		return "", err
+	}
+	usePrefixes(doc.Root(), "")
+
+	soap := gosoap.NewEmptySOAP()
+	soap.AddRootNamespaces(Xlmns)
+	if err := soap.AddBodyContent(doc.Root()); err != nil {
+		return "", err
+	}
+
+	if username != "" && password != "" {
+		if err := soap.AddWSSecurity(username, password, deltaTime); err != nil {
+			return "", err
+		}
+	}
+
+	if err := soap.AddTo(endpoint); err != nil {
+		return "", err
+	}
+	return soap, nil
+}
+
+var xlmnsPrefixes = func() map[string]string {
+	prefixes := make(map[string]string, len(Xlmns))
+	for prefix, namespace := range Xlmns {
+		prefixes[namespace] = prefix
+	}
+	return prefixes
+}()
+
+func usePrefixes(el *etree.Element, namespace string) {
+	if attr := el.SelectAttr("xmlns"); attr != nil {
+		namespace = attr.Value
+	}
+	if prefix, ok := xlmnsPrefixes[namespace]; ok && el.Space == "" {
+		el.Space = prefix
+		el.RemoveAttr("xmlns")
+	}
+	for i := range el.Attr {
+		attr := &el.Attr[i]
+		if attr.Space == "" || attr.Space == "xmlns" {
+			continue
+		}
+		decl := el.SelectAttr("xmlns:" + attr.Space)
+		if decl == nil {
+			continue
+		}
+		if prefix, ok := xlmnsPrefixes[decl.Value]; ok {
+			attr.Space = prefix
+		}
+	}
+	for _, child := range el.ChildElements() {
+		usePrefixes(child, namespace)
+	}
+}
+
+func TestBuildSOAP(t *testing.T) {
+	const endpoint = "http://192.168.0.10/onvif/media"
+	for _, tt := range requests {
+		for _, username := range []string{"", "admin"} {
+			name := tt.name
+			if username != "" {
+				name += "/WSSecurity"
+			}
+			t.Run(name, func(t *testing.T) {
+				r := NewRequest(testDevice{}, tt.method).WithUsernamePassword(username, "secret")
+				got, err := r.buildSOAP(tt.method, endpoint).Bytes()
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, err := buildSoapMessage(tt.method, endpoint, username, "secret", 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				gotTokens, err := canonical(got)
+				if err != nil {
+					t.Fatalf("Envelope: %v\n%s", err, got)
+				}
+				wantTokens, err := canonical([]byte(want.String()))
+				if err != nil {
+					t.Fatalf("SoapMessage: %v\n%s", err, want)
+				}
+				if strings.Join(gotTokens, "\n") != strings.Join(wantTokens, "\n") {
+					t.Errorf("Envelope:\n%s\nSoapMessage:\n%s", got, want)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkBuildSOAP(b *testing.B) {
+	const endpoint = "http://192.168.0.10/onvif/media"
+	for _, tt := range requests {
+		b.Run(tt.name+"/SoapMessage", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				soap, err := buildSoapMessage(tt.method, endpoint, "admin", "secret", 0)
+				if err != nil {
+					b.Fatal(err)
+				}
+				_ = soap.String()
+			}
+		})
+		b.Run(tt.name+"/Envelope", func(b *testing.B) {
+			b.ReportAllocs()
+			r := NewRequest(testDevice{}, tt.method).WithUsernamePassword("admin", "secret")
+			for i := 0; i < b.N; i++ {
+				if _, err := r.buildSOAP(tt.method, endpoint).Bytes(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// generated values of the WS-Security header
+var volatile = map[string]bool{"Nonce": true, "Created": true, "Password": true}
+
+// canonical return the elements with the prefixes as written and the resolved namespaces,
+// the attributes sorted, the declarations, the whitespace and the generated values left out
+func canonical(data []byte) ([]string, error) {
+	var res []string
+	var scopes []map[string]string
+	resolve := func(prefix string) string {
+		for i := len(scopes) - 1; i >= 0; i-- {
+			if namespace, ok := scopes[i][prefix]; ok {
+				return namespace
+			}
+		}
+		return ""
+	}
+	name := func(n xml.Name) string {
+		if n.Space == "" {
+			return n.Local
+		}
+		return fmt.Sprintf("%s:%s{%s}", n.Space, n.Local, resolve(n.Space))
+	}
+
+	var local string
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			scope := map[string]string{}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" {
+					scope[attr.Name.Local] = attr.Value
+				} else if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					scope[""] = attr.Value
+				}
+			}
+			scopes = append(scopes, scope)
+			var attrs []string
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					continue
+				}
+				attrs = append(attrs, "@"+name(attr.Name)+"="+attr.Value)
+			}
+			sort.Strings(attrs)
+			el := t.Name.Local
+			if t.Name.Space != "" {
+				el = name(t.Name)
+			} else if namespace := resolve(""); namespace != "" {
+				el = fmt.Sprintf("%s{%s}", t.Name.Local, namespace)
+			}
+			res = append(res, "<"+el+">")
+			res = append(res, attrs...)
+			local = t.Name.Local
+		case xml.EndElement:
+			res = append(res, "</"+t.Name.Local+">")
+			scopes = scopes[:len(scopes)-1]
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			if text == "" {
+				continue
+			}
+			if volatile[local] {
+				text = "*"
+			}
+			res = append(res, text)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package networking
 
 import (
+	"bytes"
 	"encoding/xml"
 	"errors"
 	"io/ioutil"
@@ -48,6 +49,24 @@ func (r *Response) Body() ([]byte, error) {
 	return r.body, nil
 }
 
+// Fault return SOAPFault if the device replied with soap-env:Fault, nil otherwise.
+func (r *Response) Fault() *SOAPFault {
+	if r.error != nil || r.response == nil || !bytes.Contains(r.body, []byte("Fault")) {
+		return nil
+	}
+
+	body, err := gosoap.SoapMessage(r.body).Body()
+	if err != nil {
+		return nil
+	}
+
+	fault := ParseFault(body)
+	if fault != nil {
+		fault.StatusCode = r.response.StatusCode
+	}
+	return fault
+}
+
 func (r *Response) Unmarshal(responses ...interface{}) error {
 	if r.error != nil {
 		return r.error
@@ -55,6 +74,9 @@ func (r *Response) Unmarshal(responses ...interface{}) error {
 	if r.response == nil {
 		return invalidResponse
 	}
+	if fault := r.Fault(); fault != nil {
+		return fault
+	}
 	if !r.StatusOK() {
 		return errors.New("return status code != 200")
 	}
@@ -1,20 +1,42 @@
+// Code generated by onvif-gen from the WSDL and XSD files. DO NOT EDIT.
+
 package networking
 
-//Xlmns XML Scheam
+// Xlmns XML Scheam
 var Xlmns = map[string]string{
 	"onvif":   "http://www.onvif.org/ver10/schema",
+	"pt":      "http://www.onvif.org/ver10/pacs",
+	"tac":     "http://www.onvif.org/ver10/accesscontrol/wsdl",
+	"tad":     "http://www.onvif.org/ver10/analyticsdevice/wsdl",
+	"tae":     "http://www.onvif.org/ver10/actionengine/wsdl",
+	"tan":     "http://www.onvif.org/ver20/analytics/wsdl",
+	"tar":     "http://www.onvif.org/ver10/accessrules/wsdl",
+	"tas":     "http://www.onvif.org/ver10/advancedsecurity/wsdl",
+	"tcr":     "http://www.onvif.org/ver10/credential/wsdl",
+	"tdc":     "http://www.onvif.org/ver10/doorcontrol/wsdl",
 	"tds":     "http://www.onvif.org/ver10/device/wsdl",
-	"trt":     "http://www.onvif.org/ver10/media/wsdl",
 	"tev":     "http://www.onvif.org/ver10/events/wsdl",
-	"tptz":    "http://www.onvif.org/ver20/ptz/wsdl",
 	"timg":    "http://www.onvif.org/ver20/imaging/wsdl",
-	"tan":     "http://www.onvif.org/ver20/analytics/wsdl",
-	"xmime":   "http://www.w3.org/2005/05/xmlmime",
-	"wsnt":    "http://docs.oasis-open.org/wsn/b-2",
-	"xop":     "http://www.w3.org/2004/08/xop/include",
+	"tls":     "http://www.onvif.org/ver10/display/wsdl",
+	"tmd":     "http://www.onvif.org/ver10/deviceIO/wsdl",
+	"tptz":    "http://www.onvif.org/ver20/ptz/wsdl",
+	"tpv":     "http://www.onvif.org/ver10/provisioning/wsdl",
+	"tr2":     "http://www.onvif.org/ver20/media/wsdl",
+	"trc":     "http://www.onvif.org/ver10/recording/wsdl",
+	"trp":     "http://www.onvif.org/ver10/replay/wsdl",
+	"trt":     "http://www.onvif.org/ver10/media/wsdl",
+	"trv":     "http://www.onvif.org/ver10/receiver/wsdl",
+	"tsc":     "http://www.onvif.org/ver10/schedule/wsdl",
+	"tse":     "http://www.onvif.org/ver10/search/wsdl",
+	"tth":     "http://www.onvif.org/ver10/thermal/wsdl",
 	"wsa":     "http://www.w3.org/2005/08/addressing",
-	"wstop":   "http://docs.oasis-open.org/wsn/t-1",
+	"wsaw":    "http://www.w3.org/2006/05/addressing/wsdl",
+	"wsnt":    "http://docs.oasis-open.org/wsn/b-2",
 	"wsntw":   "http://docs.oasis-open.org/wsn/bw-2",
+	"wsrf-bf": "http://docs.oasis-open.org/wsrf/bf-2",
+	"wsrf-r":  "http://docs.oasis-open.org/wsrf/r-2",
 	"wsrf-rw": "http://docs.oasis-open.org/wsrf/rw-2",
-	"wsaw":    "http://www.w3.org/2006/05/addressing/wsdl",
+	"wstop":   "http://docs.oasis-open.org/wsn/t-1",
+	"xmime":   "http://www.w3.org/2005/05/xmlmime",
+	"xop":     "http://www.w3.org/2004/08/xop/include",
 }
@@ -0,0 +1,78 @@
+package provisioning
+
+import (
+	"context"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Provisioning service (tpv): pan, tilt, zoom, roll and focus of the
+// lens and the mount, used to align the camera during the installation.
+// The moves continue until the timeout or Stop, zero timeout means Capabilities.DefaultTimeout.
+// The device must expose the provisioning endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Provisioning service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+func timeoutDuration(timeout time.Duration) xsd.Duration {
+	if timeout <= 0 {
+		return ""
+	}
+	return xsd.NewDuration(timeout)
+}
+
+// GetServiceCapabilities return the default timeout and the capabilities of the video sources
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// PanMove start panning the mount of the video source, zero timeout means the device default
+func (c *Client) PanMove(ctx context.Context, videoSource onvif.ReferenceToken, direction PanDirection, timeout time.Duration) error {
+	return c.call(ctx, PanMove{VideoSource: videoSource, Direction: direction, Timeout: timeoutDuration(timeout)}, &PanMoveResponse{})
+}
+
+// TiltMove start tilting the mount of the video source, zero timeout means the device default
+func (c *Client) TiltMove(ctx context.Context, videoSource onvif.ReferenceToken, direction TiltDirection, timeout time.Duration) error {
+	return c.call(ctx, TiltMove{VideoSource: videoSource, Direction: direction, Timeout: timeoutDuration(timeout)}, &TiltMoveResponse{})
+}
+
+// ZoomMove start zooming the lens of the video source, zero timeout means the device default
+func (c *Client) ZoomMove(ctx context.Context, videoSource onvif.ReferenceToken, direction ZoomDirection, timeout time.Duration) error {
+	return c.call(ctx, ZoomMove{VideoSource: videoSource, Direction: direction, Timeout: timeoutDuration(timeout)}, &ZoomMoveResponse{})
+}
+
+// RollMove start rolling the image sensor of the video source, zero timeout means the device default
+func (c *Client) RollMove(ctx context.Context, videoSource onvif.ReferenceToken, direction RollDirection, timeout time.Duration) error {
+	return c.call(ctx, RollMove{VideoSource: videoSource, Direction: direction, Timeout: timeoutDuration(timeout)}, &RollMoveResponse{})
+}
+
+// FocusMove start focusing the lens of the video source, zero timeout means the device default
+func (c *Client) FocusMove(ctx context.Context, videoSource onvif.ReferenceToken, direction FocusDirection, timeout time.Duration) error {
+	return c.call(ctx, FocusMove{VideoSource: videoSource, Direction: direction, Timeout: timeoutDuration(timeout)}, &FocusMoveResponse{})
+}
+
+// Stop stop all moves of the video source
+func (c *Client) Stop(ctx context.Context, videoSource onvif.ReferenceToken) error {
+	return c.call(ctx, Stop{VideoSource: videoSource}, &StopResponse{})
+}
+
+// GetUsage return the number of the moves done by the mechanics of the video source
+func (c *Client) GetUsage(ctx context.Context, videoSource onvif.ReferenceToken) (Usage, error) {
+	resp := GetUsageResponse{}
+	err := c.call(ctx, GetUsage{VideoSource: videoSource}, &resp)
+	return resp.Usage, err
+}
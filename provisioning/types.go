@@ -0,0 +1,149 @@
+package provisioning
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+type PanDirection xsd.String
+
+const (
+	PanLeft  PanDirection = "Left"
+	PanRight PanDirection = "Right"
+)
+
+type TiltDirection xsd.String
+
+const (
+	TiltUp   TiltDirection = "Up"
+	TiltDown TiltDirection = "Down"
+)
+
+type ZoomDirection xsd.String
+
+const (
+	ZoomWide      ZoomDirection = "Wide"
+	ZoomTelephoto ZoomDirection = "Telephoto"
+)
+
+// RollDirection RollAuto levels the image with the horizon, see SourceCapabilities.AutoLevel
+type RollDirection xsd.String
+
+const (
+	RollClockwise        RollDirection = "Clockwise"
+	RollCounterclockwise RollDirection = "Counterclockwise"
+	RollAuto             RollDirection = "Auto"
+)
+
+// FocusDirection FocusAuto runs the autofocus once, see SourceCapabilities.AutoFocus
+type FocusDirection xsd.String
+
+const (
+	FocusNear FocusDirection = "Near"
+	FocusFar  FocusDirection = "Far"
+	FocusAuto FocusDirection = "Auto"
+)
+
+// SourceCapabilities the maximums are the lifetime moves of the mechanics, see GetUsage
+type SourceCapabilities struct {
+	VideoSourceToken  onvif.ReferenceToken `xml:"VideoSourceToken,attr"`
+	MaximumPanMoves   int                  `xml:"MaximumPanMoves,attr"`
+	MaximumTiltMoves  int                  `xml:"MaximumTiltMoves,attr"`
+	MaximumZoomMoves  int                  `xml:"MaximumZoomMoves,attr"`
+	MaximumRollMoves  int                  `xml:"MaximumRollMoves,attr"`
+	AutoLevel         bool                 `xml:"AutoLevel,attr"`
+	MaximumFocusMoves int                  `xml:"MaximumFocusMoves,attr"`
+	AutoFocus         bool                 `xml:"AutoFocus,attr"`
+}
+
+// Capabilities DefaultTimeout is the duration of the moves without timeout
+type Capabilities struct {
+	DefaultTimeout xsd.Duration
+	Source         []SourceCapabilities
+}
+
+// Usage is the number of the moves done by the mechanics
+type Usage struct {
+	Pan   int
+	Tilt  int
+	Zoom  int
+	Roll  int
+	Focus int
+}
+
+//Provisioning main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tpv:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type PanMove struct {
+	XMLName     string               `xml:"tpv:PanMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   PanDirection         `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type PanMoveResponse struct {
+}
+
+type TiltMove struct {
+	XMLName     string               `xml:"tpv:TiltMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   TiltDirection        `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type TiltMoveResponse struct {
+}
+
+type ZoomMove struct {
+	XMLName     string               `xml:"tpv:ZoomMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   ZoomDirection        `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type ZoomMoveResponse struct {
+}
+
+type RollMove struct {
+	XMLName     string               `xml:"tpv:RollMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   RollDirection        `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type RollMoveResponse struct {
+}
+
+type FocusMove struct {
+	XMLName     string               `xml:"tpv:FocusMove"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+	Direction   FocusDirection       `xml:"tpv:Direction"`
+	Timeout     xsd.Duration         `xml:"tpv:Timeout,omitempty"`
+}
+
+type FocusMoveResponse struct {
+}
+
+type Stop struct {
+	XMLName     string               `xml:"tpv:Stop"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+}
+
+type StopResponse struct {
+}
+
+type GetUsage struct {
+	XMLName     string               `xml:"tpv:GetUsage"`
+	VideoSource onvif.ReferenceToken `xml:"tpv:VideoSource"`
+}
+
+type GetUsageResponse struct {
+	Usage Usage
+}
@@ -0,0 +1,112 @@
+package ptz
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Generic spaces every PTZ node supports.
+// The Clamp functions select the space of the node by the space URI of the vector.
+// A vector without space is in the default space of the PTZ configuration, it is limited to the generic space,
+// set the space of the vector when the configuration defaults to another one.
+// The vector of a space the node does not describe is left as is.
+const (
+	PanTiltPositionGenericSpace    = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace"
+	ZoomPositionGenericSpace       = "http://www.onvif.org/ver10/tptz/ZoomSpaces/PositionGenericSpace"
+	PanTiltTranslationGenericSpace = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/TranslationGenericSpace"
+	ZoomTranslationGenericSpace    = "http://www.onvif.org/ver10/tptz/ZoomSpaces/TranslationGenericSpace"
+	PanTiltVelocityGenericSpace    = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocityGenericSpace"
+	ZoomVelocityGenericSpace       = "http://www.onvif.org/ver10/tptz/ZoomSpaces/VelocityGenericSpace"
+	PanTiltSpeedGenericSpace       = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/GenericSpeedSpace"
+	ZoomSpeedGenericSpace          = "http://www.onvif.org/ver10/tptz/ZoomSpaces/ZoomGenericSpeedSpace"
+)
+
+// ClampVelocity limits velocity to the continuous velocity spaces of the node (PTZNode.SupportedPTZSpaces)
+func ClampVelocity(velocity onvif.PTZSpeed, spaces onvif.PTZSpaces) onvif.PTZSpeed {
+	return onvif.PTZSpeed{
+		PanTilt: clamp2D(velocity.PanTilt, spaces.ContinuousPanTiltVelocitySpace, PanTiltVelocityGenericSpace),
+		Zoom:    clamp1D(velocity.Zoom, spaces.ContinuousZoomVelocitySpace, ZoomVelocityGenericSpace),
+	}
+}
+
+// ClampSpeed limits speed of GotoPreset, AbsoluteMove and RelativeMove to the speed spaces of the node
+func ClampSpeed(speed onvif.PTZSpeed, spaces onvif.PTZSpaces) onvif.PTZSpeed {
+	// PanTiltSpeedSpace is a tt:Space1DDescription, the node has one speed range for pan and tilt,
+	// so X and Y of the speed vector are limited to the same XRange
+	panTiltSpaces := make([]onvif.Space2DDescription, 0, len(spaces.PanTiltSpeedSpace))
+	for _, space := range spaces.PanTiltSpeedSpace {
+		panTiltSpaces = append(panTiltSpaces, onvif.Space2DDescription{URI: space.URI, XRange: space.XRange, YRange: space.XRange})
+	}
+	return onvif.PTZSpeed{
+		PanTilt: clamp2D(speed.PanTilt, panTiltSpaces, PanTiltSpeedGenericSpace),
+		Zoom:    clamp1D(speed.Zoom, spaces.ZoomSpeedSpace, ZoomSpeedGenericSpace),
+	}
+}
+
+// ClampPosition limits position of AbsoluteMove to the absolute position spaces of the node
+func ClampPosition(position onvif.PTZVector, spaces onvif.PTZSpaces) onvif.PTZVector {
+	return onvif.PTZVector{
+		PanTilt: clamp2D(position.PanTilt, spaces.AbsolutePanTiltPositionSpace, PanTiltPositionGenericSpace),
+		Zoom:    clamp1D(position.Zoom, spaces.AbsoluteZoomPositionSpace, ZoomPositionGenericSpace),
+	}
+}
+
+// ClampTranslation limits translation of RelativeMove to the relative translation spaces of the node
+func ClampTranslation(translation onvif.PTZVector, spaces onvif.PTZSpaces) onvif.PTZVector {
+	return onvif.PTZVector{
+		PanTilt: clamp2D(translation.PanTilt, spaces.RelativePanTiltTranslationSpace, PanTiltTranslationGenericSpace),
+		Zoom:    clamp1D(translation.Zoom, spaces.RelativeZoomTranslationSpace, ZoomTranslationGenericSpace),
+	}
+}
+
+// clamp2D returns a copy of v limited to the space of its URI, generic is the space of the vector without URI
+func clamp2D(v *onvif.Vector2D, spaces []onvif.Space2DDescription, generic xsd.AnyURI) *onvif.Vector2D {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	uri := spaceURI(res.Space, generic)
+	for _, space := range spaces {
+		if space.URI == uri {
+			res.X = clampRange(res.X, space.XRange)
+			res.Y = clampRange(res.Y, space.YRange)
+			break
+		}
+	}
+	return &res
+}
+
+func clamp1D(v *onvif.Vector1D, spaces []onvif.Space1DDescription, generic xsd.AnyURI) *onvif.Vector1D {
+	if v == nil {
+		return nil
+	}
+	res := *v
+	uri := spaceURI(res.Space, generic)
+	for _, space := range spaces {
+		if space.URI == uri {
+			res.X = clampRange(res.X, space.XRange)
+			break
+		}
+	}
+	return &res
+}
+
+func spaceURI(vector, generic xsd.AnyURI) xsd.AnyURI {
+	if vector == "" {
+		return generic
+	}
+	return vector
+}
+
+func clampRange(x float64, r onvif.FloatRange) float64 {
+	if r.Min == 0 && r.Max == 0 || r.Min > r.Max {
+		return x
+	}
+	if x < r.Min {
+		return r.Min
+	}
+	if x > r.Max {
+		return r.Max
+	}
+	return x
+}
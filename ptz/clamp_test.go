@@ -0,0 +1,152 @@
+package ptz
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// supportedSpaces is the SupportedPTZSpaces of a node with the generic spaces and the degree spaces
+const supportedSpaces = `<tt:SupportedPTZSpaces xmlns:tt="http://www.onvif.org/ver10/schema">
+	<tt:AbsolutePanTiltPositionSpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:URI>
+		<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+		<tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange>
+	</tt:AbsolutePanTiltPositionSpace>
+	<tt:AbsolutePanTiltPositionSpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/SphericalPositionSpaceDegrees</tt:URI>
+		<tt:XRange><tt:Min>0</tt:Min><tt:Max>360</tt:Max></tt:XRange>
+		<tt:YRange><tt:Min>-90</tt:Min><tt:Max>0</tt:Max></tt:YRange>
+	</tt:AbsolutePanTiltPositionSpace>
+	<tt:AbsoluteZoomPositionSpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/ZoomSpaces/PositionGenericSpace</tt:URI>
+		<tt:XRange><tt:Min>0</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+	</tt:AbsoluteZoomPositionSpace>
+	<tt:RelativePanTiltTranslationSpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/TranslationGenericSpace</tt:URI>
+		<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+		<tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange>
+	</tt:RelativePanTiltTranslationSpace>
+	<tt:RelativeZoomTranslationSpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/ZoomSpaces/TranslationGenericSpace</tt:URI>
+		<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+	</tt:RelativeZoomTranslationSpace>
+	<tt:ContinuousPanTiltVelocitySpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocityGenericSpace</tt:URI>
+		<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+		<tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange>
+	</tt:ContinuousPanTiltVelocitySpace>
+	<tt:ContinuousPanTiltVelocitySpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocitySpaceDegrees</tt:URI>
+		<tt:XRange><tt:Min>-120</tt:Min><tt:Max>120</tt:Max></tt:XRange>
+		<tt:YRange><tt:Min>-60</tt:Min><tt:Max>60</tt:Max></tt:YRange>
+	</tt:ContinuousPanTiltVelocitySpace>
+	<tt:ContinuousZoomVelocitySpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/ZoomSpaces/VelocityGenericSpace</tt:URI>
+		<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+	</tt:ContinuousZoomVelocitySpace>
+	<tt:PanTiltSpeedSpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/GenericSpeedSpace</tt:URI>
+		<tt:XRange><tt:Min>0</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+	</tt:PanTiltSpeedSpace>
+	<tt:ZoomSpeedSpace>
+		<tt:URI>http://www.onvif.org/ver10/tptz/ZoomSpaces/ZoomGenericSpeedSpace</tt:URI>
+		<tt:XRange><tt:Min>0</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+	</tt:ZoomSpeedSpace>
+</tt:SupportedPTZSpaces>`
+
+const (
+	sphericalDegrees = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/SphericalPositionSpaceDegrees"
+	velocityDegrees  = "http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocitySpaceDegrees"
+)
+
+func testSpaces(t *testing.T) onvif.PTZSpaces {
+	t.Helper()
+	var spaces onvif.PTZSpaces
+	if err := xml.Unmarshal([]byte(supportedSpaces), &spaces); err != nil {
+		t.Fatal(err)
+	}
+	if len(spaces.AbsolutePanTiltPositionSpace) != 2 || len(spaces.ContinuousPanTiltVelocitySpace) != 2 {
+		t.Fatalf("spaces are not decoded: %+v", spaces)
+	}
+	return spaces
+}
+
+func pt(x, y float64, space string) *onvif.Vector2D {
+	return &onvif.Vector2D{X: x, Y: y, Space: xsd.AnyURI(space)}
+}
+
+func zoom(x float64, space string) *onvif.Vector1D {
+	return &onvif.Vector1D{X: x, Space: xsd.AnyURI(space)}
+}
+
+func TestClampPosition(t *testing.T) {
+	spaces := testSpaces(t)
+	tests := []struct {
+		name     string
+		position onvif.PTZVector
+		want     onvif.PTZVector
+	}{
+		{"default space", onvif.PTZVector{PanTilt: pt(1.5, -2, ""), Zoom: zoom(2, "")}, onvif.PTZVector{PanTilt: pt(1, -1, ""), Zoom: zoom(1, "")}},
+		{"generic space", onvif.PTZVector{PanTilt: pt(-3, 0.5, PanTiltPositionGenericSpace)}, onvif.PTZVector{PanTilt: pt(-1, 0.5, PanTiltPositionGenericSpace)}},
+		{"degrees", onvif.PTZVector{PanTilt: pt(400, -30, sphericalDegrees)}, onvif.PTZVector{PanTilt: pt(360, -30, sphericalDegrees)}},
+		{"degrees below", onvif.PTZVector{PanTilt: pt(-10, -100, sphericalDegrees)}, onvif.PTZVector{PanTilt: pt(0, -90, sphericalDegrees)}},
+		{"unknown space", onvif.PTZVector{PanTilt: pt(400, 400, "urn:vendor:space")}, onvif.PTZVector{PanTilt: pt(400, 400, "urn:vendor:space")}},
+		{"nil", onvif.PTZVector{}, onvif.PTZVector{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClampPosition(tt.position, spaces); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v %+v, want %+v %+v", got.PanTilt, got.Zoom, tt.want.PanTilt, tt.want.Zoom)
+			}
+		})
+	}
+}
+
+func TestClampVelocity(t *testing.T) {
+	spaces := testSpaces(t)
+	tests := []struct {
+		name     string
+		velocity onvif.PTZSpeed
+		want     onvif.PTZSpeed
+	}{
+		{"default space", onvif.PTZSpeed{PanTilt: pt(2, -2, ""), Zoom: zoom(-1.5, "")}, onvif.PTZSpeed{PanTilt: pt(1, -1, ""), Zoom: zoom(-1, "")}},
+		{"degrees", onvif.PTZSpeed{PanTilt: pt(90, -90, velocityDegrees)}, onvif.PTZSpeed{PanTilt: pt(90, -60, velocityDegrees)}},
+		{"in range", onvif.PTZSpeed{PanTilt: pt(0.5, -0.5, PanTiltVelocityGenericSpace)}, onvif.PTZSpeed{PanTilt: pt(0.5, -0.5, PanTiltVelocityGenericSpace)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClampVelocity(tt.velocity, spaces); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v %+v, want %+v %+v", got.PanTilt, got.Zoom, tt.want.PanTilt, tt.want.Zoom)
+			}
+		})
+	}
+}
+
+func TestClampSpeed(t *testing.T) {
+	spaces := testSpaces(t)
+	// the pan/tilt speed space is one dimensional, Y is limited to XRange too
+	got := ClampSpeed(onvif.PTZSpeed{PanTilt: pt(2, -1, ""), Zoom: zoom(3, ZoomSpeedGenericSpace)}, spaces)
+	want := onvif.PTZSpeed{PanTilt: pt(1, 0, ""), Zoom: zoom(1, ZoomSpeedGenericSpace)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v %+v, want %+v %+v", got.PanTilt, got.Zoom, want.PanTilt, want.Zoom)
+	}
+}
+
+func TestClampTranslation(t *testing.T) {
+	spaces := testSpaces(t)
+	got := ClampTranslation(onvif.PTZVector{PanTilt: pt(0.2, 5, ""), Zoom: zoom(-2, "")}, spaces)
+	want := onvif.PTZVector{PanTilt: pt(0.2, 1, ""), Zoom: zoom(-1, "")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v %+v, want %+v %+v", got.PanTilt, got.Zoom, want.PanTilt, want.Zoom)
+	}
+
+	// the node without spaces
+	vector := onvif.PTZVector{PanTilt: pt(5, 5, "")}
+	if got := ClampTranslation(vector, onvif.PTZSpaces{}); !reflect.DeepEqual(got, vector) {
+		t.Errorf("got %+v, want the vector as is", got.PanTilt)
+	}
+}
@@ -0,0 +1,368 @@
+package ptz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Move statuses reported by GetStatus
+const (
+	MoveStatusIdle    = "IDLE"
+	MoveStatusMoving  = "MOVING"
+	MoveStatusUnknown = "UNKNOWN"
+)
+
+// WaitOptions defaults
+const (
+	DefaultPollInterval = 500 * time.Millisecond
+	DefaultStartGrace   = 2 * time.Second
+)
+
+// stopTimeout bounds the Stop request sent by MoveFor after ctx is done
+const stopTimeout = 5 * time.Second
+
+// Client is a typed client of the PTZ service.
+// Every method resolves the ptz endpoint, authenticates with the device credentials
+// and returns *networking.SOAPFault when the device replies with a fault.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return PTZ service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the ptz service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetNodes return all the PTZ nodes of the device
+func (c *Client) GetNodes(ctx context.Context) ([]onvif.PTZNode, error) {
+	resp := GetNodesResponse{}
+	if err := c.call(ctx, GetNodes{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PTZNode, nil
+}
+
+// GetNode return the PTZ node by token
+func (c *Client) GetNode(ctx context.Context, nodeToken onvif.ReferenceToken) (onvif.PTZNode, error) {
+	resp := GetNodeResponse{}
+	err := c.call(ctx, GetNode{NodeToken: nodeToken}, &resp)
+	return resp.PTZNode, err
+}
+
+// GetConfiguration return the PTZ configuration by token
+func (c *Client) GetConfiguration(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.PTZConfiguration, error) {
+	resp := GetConfigurationResponse{}
+	err := c.call(ctx, GetConfiguration{PTZConfigurationToken: configurationToken}, &resp)
+	return resp.PTZConfiguration, err
+}
+
+// GetConfigurations return all the PTZ configurations of the device
+func (c *Client) GetConfigurations(ctx context.Context) ([]onvif.PTZConfiguration, error) {
+	resp := GetConfigurationsResponse{}
+	if err := c.call(ctx, GetConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PTZConfiguration, nil
+}
+
+// SetConfiguration modify the PTZ configuration
+func (c *Client) SetConfiguration(ctx context.Context, configuration onvif.PTZConfiguration, forcePersistence bool) error {
+	return c.call(ctx, SetConfiguration{PTZConfiguration: configuration, ForcePersistence: xsd.Boolean(forcePersistence)}, &SetConfigurationResponse{})
+}
+
+// GetConfigurationOptions return the options of the PTZ configuration
+func (c *Client) GetConfigurationOptions(ctx context.Context, configurationToken onvif.ReferenceToken) (onvif.PTZConfigurationOptions, error) {
+	resp := GetConfigurationOptionsResponse{}
+	err := c.call(ctx, GetConfigurationOptions{ConfigurationToken: configurationToken}, &resp)
+	return resp.PTZConfigurationOptions, err
+}
+
+// GetCompatibleConfigurations return the PTZ configurations compatible with the profile
+func (c *Client) GetCompatibleConfigurations(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.PTZConfiguration, error) {
+	resp := GetCompatibleConfigurationsResponse{}
+	if err := c.call(ctx, GetCompatibleConfigurations{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PTZConfiguration, nil
+}
+
+// SendAuxiliaryCommand send the auxiliary command (ex: tt:Wiper|On) and return the device answer
+func (c *Client) SendAuxiliaryCommand(ctx context.Context, profileToken onvif.ReferenceToken, data onvif.AuxiliaryData) (onvif.AuxiliaryData, error) {
+	resp := SendAuxiliaryCommandResponse{}
+	err := c.call(ctx, SendAuxiliaryCommand{ProfileToken: profileToken, AuxiliaryData: data}, &resp)
+	return resp.AuxiliaryResponse, err
+}
+
+// GetPresets return the presets of the profile
+func (c *Client) GetPresets(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.PTZPreset, error) {
+	resp := GetPresetsResponse{}
+	if err := c.call(ctx, GetPresets{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Preset, nil
+}
+
+// SetPreset save the current position as preset.
+// Empty presetToken creates a new preset, otherwise the preset is overwritten.
+func (c *Client) SetPreset(ctx context.Context, profileToken onvif.ReferenceToken, name string, presetToken onvif.ReferenceToken) (onvif.ReferenceToken, error) {
+	resp := SetPresetResponse{}
+	err := c.call(ctx, SetPreset{ProfileToken: profileToken, PresetName: xsd.String(name), PresetToken: presetToken}, &resp)
+	return resp.PresetToken, err
+}
+
+// RemovePreset remove the preset
+func (c *Client) RemovePreset(ctx context.Context, profileToken, presetToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemovePreset{ProfileToken: profileToken, PresetToken: presetToken}, &RemovePresetResponse{})
+}
+
+// GotoPreset move to the preset, speed is optional
+func (c *Client) GotoPreset(ctx context.Context, profileToken, presetToken onvif.ReferenceToken, speed *onvif.PTZSpeed) error {
+	return c.call(ctx, GotoPreset{ProfileToken: profileToken, PresetToken: presetToken, Speed: speed}, &GotoPresetResponse{})
+}
+
+// GotoHomePosition move to the home position, speed is optional
+func (c *Client) GotoHomePosition(ctx context.Context, profileToken onvif.ReferenceToken, speed *onvif.PTZSpeed) error {
+	return c.call(ctx, GotoHomePosition{ProfileToken: profileToken, Speed: speed}, &GotoHomePositionResponse{})
+}
+
+// SetHomePosition save the current position as home position
+func (c *Client) SetHomePosition(ctx context.Context, profileToken onvif.ReferenceToken) error {
+	return c.call(ctx, SetHomePosition{ProfileToken: profileToken}, &SetHomePositionResponse{})
+}
+
+// ContinuousMove start moving with velocity, zero timeout means the device default
+func (c *Client) ContinuousMove(ctx context.Context, profileToken onvif.ReferenceToken, velocity onvif.PTZSpeed, timeout time.Duration) error {
+	move := ContinuousMove{ProfileToken: profileToken, Velocity: velocity}
+	if timeout > 0 {
+		move.Timeout = xsd.NewDuration(timeout)
+	}
+	return c.call(ctx, move, &ContinuousMoveResponse{})
+}
+
+// RelativeMove move by translation, speed is optional
+func (c *Client) RelativeMove(ctx context.Context, profileToken onvif.ReferenceToken, translation onvif.PTZVector, speed *onvif.PTZSpeed) error {
+	return c.call(ctx, RelativeMove{ProfileToken: profileToken, Translation: translation, Speed: speed}, &RelativeMoveResponse{})
+}
+
+// AbsoluteMove move to the position, speed is optional
+func (c *Client) AbsoluteMove(ctx context.Context, profileToken onvif.ReferenceToken, position onvif.PTZVector, speed *onvif.PTZSpeed) error {
+	return c.call(ctx, AbsoluteMove{ProfileToken: profileToken, Position: position, Speed: speed}, &AbsoluteMoveResponse{})
+}
+
+// GeoMove point to the geo location, speed is optional, zero area is omitted
+func (c *Client) GeoMove(ctx context.Context, profileToken onvif.ReferenceToken, target onvif.GeoLocation, speed *onvif.PTZSpeed, areaHeight, areaWidth float32) error {
+	move := GeoMove{
+		ProfileToken: profileToken,
+		Target:       target,
+		Speed:        speed,
+		AreaHeight:   xsd.Float(areaHeight),
+		AreaWidth:    xsd.Float(areaWidth),
+	}
+	return c.call(ctx, move, &GeoMoveResponse{})
+}
+
+// Stop stop pan/tilt and/or zoom movement
+func (c *Client) Stop(ctx context.Context, profileToken onvif.ReferenceToken, panTilt, zoom bool) error {
+	return c.call(ctx, Stop{ProfileToken: profileToken, PanTilt: xsd.Boolean(panTilt), Zoom: xsd.Boolean(zoom)}, &StopResponse{})
+}
+
+// GetStatus return the position and the move status of the profile
+func (c *Client) GetStatus(ctx context.Context, profileToken onvif.ReferenceToken) (onvif.PTZStatus, error) {
+	resp := GetStatusResponse{}
+	err := c.call(ctx, GetStatus{ProfileToken: profileToken}, &resp)
+	return resp.PTZStatus, err
+}
+
+// GetPresetTours return the preset tours of the profile
+func (c *Client) GetPresetTours(ctx context.Context, profileToken onvif.ReferenceToken) ([]onvif.PresetTour, error) {
+	resp := GetPresetToursResponse{}
+	if err := c.call(ctx, GetPresetTours{ProfileToken: profileToken}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.PresetTour, nil
+}
+
+// GetPresetTour return the preset tour by token
+func (c *Client) GetPresetTour(ctx context.Context, profileToken, presetTourToken onvif.ReferenceToken) (onvif.PresetTour, error) {
+	resp := GetPresetTourResponse{}
+	err := c.call(ctx, GetPresetTour{ProfileToken: profileToken, PresetTourToken: presetTourToken}, &resp)
+	return resp.PresetTour, err
+}
+
+// GetPresetTourOptions return the options of the preset tour
+func (c *Client) GetPresetTourOptions(ctx context.Context, profileToken, presetTourToken onvif.ReferenceToken) (onvif.PTZPresetTourOptions, error) {
+	resp := GetPresetTourOptionsResponse{}
+	err := c.call(ctx, GetPresetTourOptions{ProfileToken: profileToken, PresetTourToken: presetTourToken}, &resp)
+	return resp.Options, err
+}
+
+// CreatePresetTour create an empty preset tour and return its token
+func (c *Client) CreatePresetTour(ctx context.Context, profileToken onvif.ReferenceToken) (onvif.ReferenceToken, error) {
+	resp := CreatePresetTourResponse{}
+	err := c.call(ctx, CreatePresetTour{ProfileToken: profileToken}, &resp)
+	return resp.PresetTourToken, err
+}
+
+// ModifyPresetTour modify the preset tour
+func (c *Client) ModifyPresetTour(ctx context.Context, profileToken onvif.ReferenceToken, presetTour onvif.PresetTour) error {
+	return c.call(ctx, ModifyPresetTour{ProfileToken: profileToken, PresetTour: presetTour}, &ModifyPresetTourResponse{})
+}
+
+// OperatePresetTour start, stop, pause or extend the preset tour
+func (c *Client) OperatePresetTour(ctx context.Context, profileToken, presetTourToken onvif.ReferenceToken, operation onvif.PTZPresetTourOperation) error {
+	return c.call(ctx, OperatePresetTour{ProfileToken: profileToken, PresetTourToken: presetTourToken, Operation: operation}, &OperatePresetTourResponse{})
+}
+
+// RemovePresetTour remove the preset tour
+func (c *Client) RemovePresetTour(ctx context.Context, profileToken, presetTourToken onvif.ReferenceToken) error {
+	return c.call(ctx, RemovePresetTour{ProfileToken: profileToken, PresetTourToken: presetTourToken}, &RemovePresetTourResponse{})
+}
+
+// MoveFor move with velocity for duration d and then Stop.
+// Stop is sent even if ctx is done before d elapsed.
+func (c *Client) MoveFor(ctx context.Context, profileToken onvif.ReferenceToken, velocity onvif.PTZSpeed, d time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := c.ContinuousMove(ctx, profileToken, velocity, 0); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	stopCtx := ctx
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		var cancel context.CancelFunc
+		stopCtx, cancel = context.WithTimeout(context.Background(), stopTimeout)
+		defer cancel()
+	}
+
+	err := c.Stop(stopCtx, profileToken, velocity.PanTilt != nil, velocity.Zoom != nil)
+	if err == nil {
+		err = ctx.Err()
+	}
+	return err
+}
+
+// WaitOptions of WaitIdle and GotoPresetAndWait, zero values mean the defaults
+type WaitOptions struct {
+	// PollInterval of GetStatus, zero means DefaultPollInterval
+	PollInterval time.Duration
+	// StartGrace is how long GotoPresetAndWait accepts IDLE only after MOVING has been seen,
+	// the devices may report IDLE until they start the movement. Zero means DefaultStartGrace.
+	StartGrace time.Duration
+	// AcceptUnknown treats UNKNOWN and the missing move status as IDLE, for the devices which do not report
+	// the end of the movement. Otherwise they are waited for like MOVING until ctx is done.
+	AcceptUnknown bool
+}
+
+// GotoPresetAndWait move to the preset and poll GetStatus until pan/tilt and zoom are IDLE.
+// IDLE is accepted once MOVING has been seen or StartGrace has passed since GotoPreset.
+func (c *Client) GotoPresetAndWait(ctx context.Context, profileToken, presetToken onvif.ReferenceToken, speed *onvif.PTZSpeed, opts WaitOptions) error {
+	if err := c.GotoPreset(ctx, profileToken, presetToken, speed); err != nil {
+		return err
+	}
+	if opts.StartGrace <= 0 {
+		opts.StartGrace = DefaultStartGrace
+	}
+	return c.wait(ctx, profileToken, opts, time.Now().Add(opts.StartGrace))
+}
+
+// WaitIdle poll GetStatus until pan/tilt and zoom are IDLE.
+// The first poll is done after PollInterval so the device has time to start the movement.
+// A fault of GetStatus is returned at once, the connection errors are retried until ctx is done.
+func (c *Client) WaitIdle(ctx context.Context, profileToken onvif.ReferenceToken, opts WaitOptions) error {
+	return c.wait(ctx, profileToken, opts, time.Time{})
+}
+
+// wait poll GetStatus until the device is idle, IDLE reported before MOVING is ignored until graceEnd.
+// A fault is returned at once, the other GetStatus errors are retried until ctx is done.
+func (c *Client) wait(ctx context.Context, profileToken onvif.ReferenceToken, opts WaitOptions, graceEnd time.Time) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(opts.PollInterval)
+	defer ticker.Stop()
+
+	moved := false
+	var lastErr error
+	for {
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return fmt.Errorf("%w, last GetStatus error: %v", ctx.Err(), lastErr)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		status, err := c.GetStatus(ctx, profileToken)
+		var fault *networking.SOAPFault
+		if errors.As(err, &fault) {
+			return err
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		lastErr = nil
+		if IsMoving(status) {
+			moved = true
+			continue
+		}
+		if IsIdle(status, opts.AcceptUnknown) && (moved || !time.Now().Before(graceEnd)) {
+			return nil
+		}
+	}
+}
+
+// IsIdle reports whether pan/tilt and zoom statuses are IDLE, the axis without status is ignored.
+// acceptUnknown treats UNKNOWN and the status without any axis as IDLE.
+func IsIdle(status onvif.PTZStatus, acceptUnknown bool) bool {
+	reported := false
+	for _, axis := range []string{status.MoveStatus.PanTilt.Status, status.MoveStatus.Zoom.Status} {
+		switch axis {
+		case "":
+		case MoveStatusIdle:
+			reported = true
+		case MoveStatusUnknown:
+			if !acceptUnknown {
+				return false
+			}
+			reported = true
+		default:
+			return false
+		}
+	}
+	return reported || acceptUnknown
+}
+
+// IsMoving reports whether pan/tilt or zoom status is MOVING
+func IsMoving(status onvif.PTZStatus) bool {
+	return status.MoveStatus.PanTilt.Status == MoveStatusMoving || status.MoveStatus.Zoom.Status == MoveStatusMoving
+}
@@ -0,0 +1,271 @@
+package ptz
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// testDevice serves the ptz requests, handle returns the status and the content of the response body
+// for the n-th request of the name
+type testDevice struct {
+	*httptest.Server
+	handle func(name string, n int) (int, string)
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func newTestDevice(t *testing.T, handle func(name string, n int) (int, string)) *testDevice {
+	d := &testDevice{handle: handle}
+	d.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		name := bodyElement(body)
+
+		d.mu.Lock()
+		d.calls = append(d.calls, name)
+		n := 0
+		for _, call := range d.calls {
+			if call == name {
+				n++
+			}
+		}
+		d.mu.Unlock()
+
+		status, content := d.handle(name, n)
+		w.WriteHeader(status)
+		io.WriteString(w, `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema" `+
+			`xmlns:tptz="http://www.onvif.org/ver20/ptz/wsdl"><s:Body>`+content+`</s:Body></s:Envelope>`)
+	}))
+	t.Cleanup(d.Close)
+	return d
+}
+
+func (d *testDevice) GetEndpoint(string) (string, error) { return d.URL + "/onvif/ptz", nil }
+func (d *testDevice) DeltaTime() time.Duration           { return 0 }
+
+func (d *testDevice) CreateRequest(method interface{}) *networking.Request {
+	return networking.NewRequest(d, method)
+}
+
+func (d *testDevice) received() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.calls...)
+}
+
+func bodyElement(envelope []byte) string {
+	d := xml.NewDecoder(bytes.NewReader(envelope))
+	inBody := false
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if inBody {
+				return start.Name.Local
+			}
+			inBody = start.Name.Local == "Body"
+		}
+	}
+}
+
+// status return the content of GetStatusResponse, the empty status omits the axis
+func status(panTilt, zoom string) string {
+	var moveStatus string
+	if panTilt != "" {
+		moveStatus += `<tt:PanTilt>` + panTilt + `</tt:PanTilt>`
+	}
+	if zoom != "" {
+		moveStatus += `<tt:Zoom>` + zoom + `</tt:Zoom>`
+	}
+	return `<tptz:GetStatusResponse><tptz:PTZStatus><tt:Position><tt:PanTilt x="0" y="0"/></tt:Position>` +
+		`<tt:MoveStatus>` + moveStatus + `</tt:MoveStatus><tt:UtcTime>2024-05-01T10:00:00Z</tt:UtcTime></tptz:PTZStatus></tptz:GetStatusResponse>`
+}
+
+const noProfile = `<s:Fault><s:Code><s:Value>s:Sender</s:Value><s:Subcode><s:Value>ter:InvalidArgVal</s:Value>` +
+	`<s:Subcode><s:Value>ter:NoProfile</s:Value></s:Subcode></s:Subcode></s:Code><s:Reason><s:Text xml:lang="en">no profile</s:Text></s:Reason></s:Fault>`
+
+func moveStatus(panTilt, zoom string) onvif.PTZStatus {
+	var s onvif.PTZStatus
+	s.MoveStatus.PanTilt.Status = panTilt
+	s.MoveStatus.Zoom.Status = zoom
+	return s
+}
+
+func TestIsIdle(t *testing.T) {
+	tests := []struct {
+		panTilt, zoom string
+		idle          bool
+		idleUnknown   bool
+		moving        bool
+	}{
+		{MoveStatusIdle, MoveStatusIdle, true, true, false},
+		{MoveStatusIdle, "", true, true, false},
+		{"", MoveStatusIdle, true, true, false},
+		{MoveStatusMoving, MoveStatusIdle, false, false, true},
+		{MoveStatusIdle, MoveStatusMoving, false, false, true},
+		{MoveStatusUnknown, MoveStatusIdle, false, true, false},
+		{MoveStatusUnknown, MoveStatusUnknown, false, true, false},
+		{"", "", false, true, false},
+	}
+	for _, tt := range tests {
+		s := moveStatus(tt.panTilt, tt.zoom)
+		if got := IsIdle(s, false); got != tt.idle {
+			t.Errorf("IsIdle(%q, %q, false) = %v", tt.panTilt, tt.zoom, got)
+		}
+		if got := IsIdle(s, true); got != tt.idleUnknown {
+			t.Errorf("IsIdle(%q, %q, true) = %v", tt.panTilt, tt.zoom, got)
+		}
+		if got := IsMoving(s); got != tt.moving {
+			t.Errorf("IsMoving(%q, %q) = %v", tt.panTilt, tt.zoom, got)
+		}
+	}
+}
+
+var fastPoll = WaitOptions{PollInterval: 10 * time.Millisecond, StartGrace: 200 * time.Millisecond}
+
+func TestWaitIdle(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []string
+		// polls is the count of GetStatus requests before WaitIdle returns
+		polls int
+	}{
+		{"moving", []string{status(MoveStatusMoving, MoveStatusIdle), status(MoveStatusMoving, MoveStatusMoving), status(MoveStatusIdle, MoveStatusIdle)}, 3},
+		{"idle", []string{status(MoveStatusIdle, MoveStatusIdle)}, 1},
+		{"pan/tilt only", []string{status(MoveStatusMoving, ""), status(MoveStatusIdle, "")}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev := newTestDevice(t, func(name string, n int) (int, string) {
+				if n > len(tt.statuses) {
+					n = len(tt.statuses)
+				}
+				return http.StatusOK, tt.statuses[n-1]
+			})
+			if err := NewClient(dev).WaitIdle(context.Background(), "profile_1", fastPoll); err != nil {
+				t.Fatal(err)
+			}
+			if got := len(dev.received()); got != tt.polls {
+				t.Errorf("%d GetStatus requests, want %d", got, tt.polls)
+			}
+		})
+	}
+}
+
+func TestWaitIdleTransientErrors(t *testing.T) {
+	dev := newTestDevice(t, func(name string, n int) (int, string) {
+		if n <= 2 {
+			return http.StatusServiceUnavailable, ""
+		}
+		return http.StatusOK, status(MoveStatusIdle, MoveStatusIdle)
+	})
+	if err := NewClient(dev).WaitIdle(context.Background(), "profile_1", fastPoll); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(dev.received()); got != 3 {
+		t.Errorf("%d GetStatus requests, want 3", got)
+	}
+}
+
+func TestWaitIdleDeadline(t *testing.T) {
+	dev := newTestDevice(t, func(name string, n int) (int, string) {
+		return http.StatusServiceUnavailable, ""
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	err := NewClient(dev).WaitIdle(ctx, "profile_1", fastPoll)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error %v, want the deadline", err)
+	}
+	if !strings.Contains(err.Error(), "GetStatus") {
+		t.Errorf("error %q does not tell the GetStatus error", err)
+	}
+	if len(dev.received()) < 2 {
+		t.Errorf("GetStatus is not retried: %v", dev.received())
+	}
+}
+
+func TestWaitIdleFault(t *testing.T) {
+	dev := newTestDevice(t, func(name string, n int) (int, string) {
+		return http.StatusBadRequest, noProfile
+	})
+	err := NewClient(dev).WaitIdle(context.Background(), "profile_1", fastPoll)
+	var fault *networking.SOAPFault
+	if !errors.As(err, &fault) || !fault.HasSubcode("ter:NoProfile") {
+		t.Fatalf("error %v, want the fault", err)
+	}
+	if got := len(dev.received()); got != 1 {
+		t.Errorf("%d GetStatus requests, the fault is not retried", got)
+	}
+}
+
+func TestGotoPresetAndWait(t *testing.T) {
+	// the device reports IDLE until it starts the movement
+	dev := newTestDevice(t, func(name string, n int) (int, string) {
+		switch {
+		case name == "GotoPreset":
+			return http.StatusOK, `<tptz:GotoPresetResponse/>`
+		case n <= 3:
+			return http.StatusOK, status(MoveStatusIdle, MoveStatusIdle)
+		case n == 4:
+			return http.StatusOK, status(MoveStatusMoving, MoveStatusIdle)
+		}
+		return http.StatusOK, status(MoveStatusIdle, MoveStatusIdle)
+	})
+	if err := NewClient(dev).GotoPresetAndWait(context.Background(), "profile_1", "preset_1", nil, fastPoll); err != nil {
+		t.Fatal(err)
+	}
+	calls := dev.received()
+	if len(calls) != 6 || calls[0] != "GotoPreset" {
+		t.Errorf("requests %v, want GotoPreset and 5 GetStatus", calls)
+	}
+}
+
+func TestMoveFor(t *testing.T) {
+	handle := func(name string, n int) (int, string) {
+		return http.StatusOK, `<tptz:` + name + `Response/>`
+	}
+	velocity := onvif.PTZSpeed{PanTilt: &onvif.Vector2D{X: 0.5}}
+
+	t.Run("duration", func(t *testing.T) {
+		dev := newTestDevice(t, handle)
+		start := time.Now()
+		if err := NewClient(dev).MoveFor(context.Background(), "profile_1", velocity, 50*time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+			t.Errorf("stopped after %v", elapsed)
+		}
+		if calls := dev.received(); strings.Join(calls, ",") != "ContinuousMove,Stop" {
+			t.Errorf("requests %v", calls)
+		}
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		dev := newTestDevice(t, handle)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		err := NewClient(dev).MoveFor(ctx, "profile_1", velocity, time.Minute)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("error %v, want the deadline", err)
+		}
+		if calls := dev.received(); strings.Join(calls, ",") != "ContinuousMove,Stop" {
+			t.Errorf("requests %v, Stop is sent after ctx is done", calls)
+		}
+	})
+}
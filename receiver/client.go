@@ -0,0 +1,75 @@
+package receiver
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Receiver service (trv): RTSP streams received by the display and recording devices.
+// The device must expose the receiver endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Receiver service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the receiver service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetReceivers return all receivers of the device
+func (c *Client) GetReceivers(ctx context.Context) ([]onvif.Receiver, error) {
+	resp := GetReceiversResponse{}
+	if err := c.call(ctx, GetReceivers{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Receivers, nil
+}
+
+// GetReceiver return the receiver by token
+func (c *Client) GetReceiver(ctx context.Context, receiverToken onvif.ReferenceToken) (onvif.Receiver, error) {
+	resp := GetReceiverResponse{}
+	err := c.call(ctx, GetReceiver{ReceiverToken: receiverToken}, &resp)
+	return resp.Receiver, err
+}
+
+// CreateReceiver create the receiver of the stream, ex: the stream uri of the camera
+func (c *Client) CreateReceiver(ctx context.Context, configuration onvif.ReceiverConfiguration) (onvif.Receiver, error) {
+	resp := CreateReceiverResponse{}
+	err := c.call(ctx, CreateReceiver{Configuration: configuration}, &resp)
+	return resp.Receiver, err
+}
+
+// DeleteReceiver delete the receiver, the auto created receivers can't be deleted
+func (c *Client) DeleteReceiver(ctx context.Context, receiverToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteReceiver{ReceiverToken: receiverToken}, &DeleteReceiverResponse{})
+}
+
+// ConfigureReceiver replace the configuration of the receiver
+func (c *Client) ConfigureReceiver(ctx context.Context, receiverToken onvif.ReferenceToken, configuration onvif.ReceiverConfiguration) error {
+	return c.call(ctx, ConfigureReceiver{ReceiverToken: receiverToken, Configuration: configuration}, &ConfigureReceiverResponse{})
+}
+
+// SetReceiverMode set the connection mode of the receiver, see ModeAlwaysConnect
+func (c *Client) SetReceiverMode(ctx context.Context, receiverToken onvif.ReferenceToken, mode onvif.ReceiverMode) error {
+	return c.call(ctx, SetReceiverMode{ReceiverToken: receiverToken, Mode: mode}, &SetReceiverModeResponse{})
+}
+
+// GetReceiverState return the connection state of the receiver
+func (c *Client) GetReceiverState(ctx context.Context, receiverToken onvif.ReferenceToken) (onvif.ReceiverStateInformation, error) {
+	resp := GetReceiverStateResponse{}
+	err := c.call(ctx, GetReceiverState{ReceiverToken: receiverToken}, &resp)
+	return resp.ReceiverState, err
+}
@@ -0,0 +1,98 @@
+package receiver
+
+import "github.com/neirolis/onvif-go/xsd/onvif"
+
+// Receiver modes (tt:ReceiverMode)
+const (
+	ModeAutoConnect   onvif.ReceiverMode = "AutoConnect"
+	ModeAlwaysConnect onvif.ReceiverMode = "AlwaysConnect"
+	ModeNeverConnect  onvif.ReceiverMode = "NeverConnect"
+	ModeUnknown       onvif.ReceiverMode = "Unknown"
+)
+
+// Receiver states (tt:ReceiverState)
+const (
+	StateNotConnected onvif.ReceiverState = "NotConnected"
+	StateConnecting   onvif.ReceiverState = "Connecting"
+	StateConnected    onvif.ReceiverState = "Connected"
+	StateUnknown      onvif.ReceiverState = "Unknown"
+)
+
+type Capabilities struct {
+	RTPMulticast         bool `xml:"RTP_Multicast,attr"`
+	RTPTCP               bool `xml:"RTP_TCP,attr"`
+	RTPRTSPTCP           bool `xml:"RTP_RTSP_TCP,attr"`
+	SupportedReceivers   int  `xml:"SupportedReceivers,attr"`
+	MaximumRTSPURILength int  `xml:"MaximumRTSPURILength,attr"`
+}
+
+//Receiver main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trv:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type GetReceivers struct {
+	XMLName string `xml:"trv:GetReceivers"`
+}
+
+type GetReceiversResponse struct {
+	Receivers []onvif.Receiver
+}
+
+type GetReceiver struct {
+	XMLName       string               `xml:"trv:GetReceiver"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+}
+
+type GetReceiverResponse struct {
+	Receiver onvif.Receiver
+}
+
+type CreateReceiver struct {
+	XMLName       string                      `xml:"trv:CreateReceiver"`
+	Configuration onvif.ReceiverConfiguration `xml:"trv:Configuration"`
+}
+
+type CreateReceiverResponse struct {
+	Receiver onvif.Receiver
+}
+
+type DeleteReceiver struct {
+	XMLName       string               `xml:"trv:DeleteReceiver"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+}
+
+type DeleteReceiverResponse struct {
+}
+
+type ConfigureReceiver struct {
+	XMLName       string                      `xml:"trv:ConfigureReceiver"`
+	ReceiverToken onvif.ReferenceToken        `xml:"trv:ReceiverToken"`
+	Configuration onvif.ReceiverConfiguration `xml:"trv:Configuration"`
+}
+
+type ConfigureReceiverResponse struct {
+}
+
+type SetReceiverMode struct {
+	XMLName       string               `xml:"trv:SetReceiverMode"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+	Mode          onvif.ReceiverMode   `xml:"trv:Mode"`
+}
+
+type SetReceiverModeResponse struct {
+}
+
+type GetReceiverState struct {
+	XMLName       string               `xml:"trv:GetReceiverState"`
+	ReceiverToken onvif.ReferenceToken `xml:"trv:ReceiverToken"`
+}
+
+type GetReceiverStateResponse struct {
+	ReceiverState onvif.ReceiverStateInformation
+}
@@ -0,0 +1,115 @@
+package recording
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Recording service (trc) of NVRs and edge storage devices.
+// The device must expose the recording endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Recording service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the recording service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetRecordings return all the recordings with their tracks
+func (c *Client) GetRecordings(ctx context.Context) ([]onvif.GetRecordingsResponseItem, error) {
+	resp := GetRecordingsResponse{}
+	if err := c.call(ctx, GetRecordings{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.RecordingItem, nil
+}
+
+// CreateRecording create a recording and return its token, the device creates the default tracks
+func (c *Client) CreateRecording(ctx context.Context, configuration onvif.RecordingConfiguration) (onvif.ReferenceToken, error) {
+	resp := CreateRecordingResponse{}
+	err := c.call(ctx, CreateRecording{RecordingConfiguration: configuration}, &resp)
+	return resp.RecordingToken, err
+}
+
+// DeleteRecording delete the recording with all its tracks and data
+func (c *Client) DeleteRecording(ctx context.Context, recordingToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteRecording{RecordingToken: recordingToken}, &DeleteRecordingResponse{})
+}
+
+// GetRecordingConfiguration return configuration of the recording
+func (c *Client) GetRecordingConfiguration(ctx context.Context, recordingToken onvif.ReferenceToken) (onvif.RecordingConfiguration, error) {
+	resp := GetRecordingConfigurationResponse{}
+	err := c.call(ctx, GetRecordingConfiguration{RecordingToken: recordingToken}, &resp)
+	return resp.RecordingConfiguration, err
+}
+
+// SetRecordingConfiguration change configuration of the recording
+func (c *Client) SetRecordingConfiguration(ctx context.Context, recordingToken onvif.ReferenceToken, configuration onvif.RecordingConfiguration) error {
+	return c.call(ctx, SetRecordingConfiguration{RecordingToken: recordingToken, RecordingConfiguration: configuration}, &SetRecordingConfigurationResponse{})
+}
+
+// CreateTrack add a track to the recording and return its token
+func (c *Client) CreateTrack(ctx context.Context, recordingToken onvif.ReferenceToken, configuration onvif.TrackConfiguration) (onvif.ReferenceToken, error) {
+	resp := CreateTrackResponse{}
+	err := c.call(ctx, CreateTrack{RecordingToken: recordingToken, TrackConfiguration: configuration}, &resp)
+	return resp.TrackToken, err
+}
+
+// DeleteTrack delete the track with its data from the recording
+func (c *Client) DeleteTrack(ctx context.Context, recordingToken, trackToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteTrack{RecordingToken: recordingToken, TrackToken: trackToken}, &DeleteTrackResponse{})
+}
+
+// GetRecordingJobs return all the recording jobs
+func (c *Client) GetRecordingJobs(ctx context.Context) ([]onvif.GetRecordingJobsResponseItem, error) {
+	resp := GetRecordingJobsResponse{}
+	if err := c.call(ctx, GetRecordingJobs{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.JobItem, nil
+}
+
+// CreateRecordingJob create a recording job, the device may adjust the configuration, the actual one is returned
+func (c *Client) CreateRecordingJob(ctx context.Context, configuration onvif.RecordingJobConfiguration) (CreateRecordingJobResponse, error) {
+	resp := CreateRecordingJobResponse{}
+	err := c.call(ctx, CreateRecordingJob{JobConfiguration: configuration}, &resp)
+	return resp, err
+}
+
+// DeleteRecordingJob delete the recording job, the recorded data is kept
+func (c *Client) DeleteRecordingJob(ctx context.Context, jobToken onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteRecordingJob{JobToken: jobToken}, &DeleteRecordingJobResponse{})
+}
+
+// SetRecordingJobMode start (JobModeActive) or stop (JobModeIdle) the recording job
+func (c *Client) SetRecordingJobMode(ctx context.Context, jobToken onvif.ReferenceToken, mode onvif.RecordingJobMode) error {
+	return c.call(ctx, SetRecordingJobMode{JobToken: jobToken, Mode: mode}, &SetRecordingJobModeResponse{})
+}
+
+// GetRecordingJobState return state of the recording job and its sources
+func (c *Client) GetRecordingJobState(ctx context.Context, jobToken onvif.ReferenceToken) (onvif.RecordingJobStateInformation, error) {
+	resp := GetRecordingJobStateResponse{}
+	err := c.call(ctx, GetRecordingJobState{JobToken: jobToken}, &resp)
+	return resp.State, err
+}
+
+// GetRecordingOptions return spare jobs and tracks of the recording
+func (c *Client) GetRecordingOptions(ctx context.Context, recordingToken onvif.ReferenceToken) (RecordingOptions, error) {
+	resp := GetRecordingOptionsResponse{}
+	err := c.call(ctx, GetRecordingOptions{RecordingToken: recordingToken}, &resp)
+	return resp.Options, err
+}
@@ -0,0 +1,175 @@
+package recording
+
+import "github.com/neirolis/onvif-go/xsd/onvif"
+
+// Recording job modes (tt:RecordingJobMode)
+const (
+	JobModeIdle   onvif.RecordingJobMode = "Idle"
+	JobModeActive onvif.RecordingJobMode = "Active"
+)
+
+// Track types (tt:TrackType)
+const (
+	TrackVideo    onvif.TrackType = "Video"
+	TrackAudio    onvif.TrackType = "Audio"
+	TrackMetadata onvif.TrackType = "Metadata"
+	TrackExtended onvif.TrackType = "Extended"
+)
+
+type Capabilities struct {
+	DynamicRecordings bool `xml:"DynamicRecordings,attr"`
+	DynamicTracks     bool `xml:"DynamicTracks,attr"`
+	//Encoding space separated list of the supported encodings
+	Encoding          string  `xml:"Encoding,attr"`
+	MaxRate           float64 `xml:"MaxRate,attr"`
+	MaxTotalRate      float64 `xml:"MaxTotalRate,attr"`
+	MaxRecordings     float64 `xml:"MaxRecordings,attr"`
+	MaxRecordingJobs  int     `xml:"MaxRecordingJobs,attr"`
+	Options           bool    `xml:"Options,attr"`
+	MetadataRecording bool    `xml:"MetadataRecording,attr"`
+	//SupportedExportFileFormats space separated list of the file formats
+	SupportedExportFileFormats string `xml:"SupportedExportFileFormats,attr"`
+}
+
+type JobOptions struct {
+	Spare int `xml:"Spare,attr"`
+	//CompatibleSources space separated list of the source tokens
+	CompatibleSources string `xml:"CompatibleSources,attr"`
+}
+
+type TrackOptions struct {
+	SpareTotal    int `xml:"SpareTotal,attr"`
+	SpareVideo    int `xml:"SpareVideo,attr"`
+	SpareAudio    int `xml:"SpareAudio,attr"`
+	SpareMetadata int `xml:"SpareMetadata,attr"`
+}
+
+type RecordingOptions struct {
+	Job   JobOptions
+	Track TrackOptions
+}
+
+//Recording main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trc:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type CreateRecording struct {
+	XMLName                string                       `xml:"trc:CreateRecording"`
+	RecordingConfiguration onvif.RecordingConfiguration `xml:"trc:RecordingConfiguration"`
+}
+
+type CreateRecordingResponse struct {
+	RecordingToken onvif.ReferenceToken
+}
+
+type DeleteRecording struct {
+	XMLName        string               `xml:"trc:DeleteRecording"`
+	RecordingToken onvif.ReferenceToken `xml:"trc:RecordingToken"`
+}
+
+type DeleteRecordingResponse struct {
+}
+
+type GetRecordings struct {
+	XMLName string `xml:"trc:GetRecordings"`
+}
+
+type GetRecordingsResponse struct {
+	RecordingItem []onvif.GetRecordingsResponseItem
+}
+
+type SetRecordingConfiguration struct {
+	XMLName                string                       `xml:"trc:SetRecordingConfiguration"`
+	RecordingToken         onvif.ReferenceToken         `xml:"trc:RecordingToken"`
+	RecordingConfiguration onvif.RecordingConfiguration `xml:"trc:RecordingConfiguration"`
+}
+
+type SetRecordingConfigurationResponse struct {
+}
+
+type GetRecordingConfiguration struct {
+	XMLName        string               `xml:"trc:GetRecordingConfiguration"`
+	RecordingToken onvif.ReferenceToken `xml:"trc:RecordingToken"`
+}
+
+type GetRecordingConfigurationResponse struct {
+	RecordingConfiguration onvif.RecordingConfiguration
+}
+
+type CreateTrack struct {
+	XMLName            string                   `xml:"trc:CreateTrack"`
+	RecordingToken     onvif.ReferenceToken     `xml:"trc:RecordingToken"`
+	TrackConfiguration onvif.TrackConfiguration `xml:"trc:TrackConfiguration"`
+}
+
+type CreateTrackResponse struct {
+	TrackToken onvif.ReferenceToken
+}
+
+type DeleteTrack struct {
+	XMLName        string               `xml:"trc:DeleteTrack"`
+	RecordingToken onvif.ReferenceToken `xml:"trc:RecordingToken"`
+	TrackToken     onvif.ReferenceToken `xml:"trc:TrackToken"`
+}
+
+type DeleteTrackResponse struct {
+}
+
+type CreateRecordingJob struct {
+	XMLName          string                          `xml:"trc:CreateRecordingJob"`
+	JobConfiguration onvif.RecordingJobConfiguration `xml:"trc:JobConfiguration"`
+}
+
+type CreateRecordingJobResponse struct {
+	JobToken         onvif.ReferenceToken
+	JobConfiguration onvif.RecordingJobConfiguration
+}
+
+type DeleteRecordingJob struct {
+	XMLName  string               `xml:"trc:DeleteRecordingJob"`
+	JobToken onvif.ReferenceToken `xml:"trc:JobToken"`
+}
+
+type DeleteRecordingJobResponse struct {
+}
+
+type GetRecordingJobs struct {
+	XMLName string `xml:"trc:GetRecordingJobs"`
+}
+
+type GetRecordingJobsResponse struct {
+	JobItem []onvif.GetRecordingJobsResponseItem
+}
+
+type SetRecordingJobMode struct {
+	XMLName  string                 `xml:"trc:SetRecordingJobMode"`
+	JobToken onvif.ReferenceToken   `xml:"trc:JobToken"`
+	Mode     onvif.RecordingJobMode `xml:"trc:Mode"`
+}
+
+type SetRecordingJobModeResponse struct {
+}
+
+type GetRecordingJobState struct {
+	XMLName  string               `xml:"trc:GetRecordingJobState"`
+	JobToken onvif.ReferenceToken `xml:"trc:JobToken"`
+}
+
+type GetRecordingJobStateResponse struct {
+	State onvif.RecordingJobStateInformation
+}
+
+type GetRecordingOptions struct {
+	XMLName        string               `xml:"trc:GetRecordingOptions"`
+	RecordingToken onvif.ReferenceToken `xml:"trc:RecordingToken"`
+}
+
+type GetRecordingOptionsResponse struct {
+	Options RecordingOptions
+}
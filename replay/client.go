@@ -0,0 +1,74 @@
+package replay
+
+import (
+	"context"
+	"strings"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Replay service (trp) of the recorded data.
+// The device must expose the replay endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Replay service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the replay service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetReplayUri return the RTSP uri of the recording as the device reports it
+func (c *Client) GetReplayUri(ctx context.Context, recordingToken onvif.ReferenceToken, setup onvif.StreamSetup) (xsd.AnyURI, error) {
+	resp := GetReplayUriResponse{}
+	err := c.call(ctx, GetReplayUri{StreamSetup: setup, RecordingToken: recordingToken}, &resp)
+	return resp.Uri, err
+}
+
+// GetReplayConfiguration return the replay configuration of the device
+func (c *Client) GetReplayConfiguration(ctx context.Context) (onvif.ReplayConfiguration, error) {
+	resp := GetReplayConfigurationResponse{}
+	err := c.call(ctx, GetReplayConfiguration{}, &resp)
+	return resp.Configuration, err
+}
+
+// SetReplayConfiguration change the replay configuration of the device
+func (c *Client) SetReplayConfiguration(ctx context.Context, configuration onvif.ReplayConfiguration) error {
+	return c.call(ctx, SetReplayConfiguration{Configuration: configuration}, &SetReplayConfigurationResponse{})
+}
+
+// PlaybackURI return the RTSP playback uri of the recording, the token is from the recording or search services.
+// Empty Stream and Transport.Protocol of setup default to RTP-Unicast over RTSP.
+// The host is rewritten by ReplaceHostKeepPort of the device (NAT), the RTSP port of the uri is kept.
+func (c *Client) PlaybackURI(ctx context.Context, recordingToken onvif.ReferenceToken, setup onvif.StreamSetup) (string, error) {
+	if len(setup.Stream) == 0 {
+		setup.Stream = "RTP-Unicast"
+	}
+	if len(setup.Transport.Protocol) == 0 {
+		setup.Transport.Protocol = "RTSP"
+	}
+
+	uri, err := c.GetReplayUri(ctx, recordingToken, setup)
+	if err != nil {
+		return "", err
+	}
+
+	playback := strings.TrimSpace(string(uri))
+	if replacer, ok := c.dev.(networking.HostReplacer); ok {
+		playback = replacer.ReplaceHostKeepPort(playback)
+	}
+	return playback, nil
+}
@@ -0,0 +1,50 @@
+package replay
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+type Capabilities struct {
+	ReversePlayback bool `xml:"ReversePlayback,attr"`
+	//SessionTimeoutRange min and max session timeout in seconds, space separated
+	SessionTimeoutRange string     `xml:"SessionTimeoutRange,attr"`
+	RTP_RTSP_TCP        bool       `xml:"RTP_RTSP_TCP,attr"`
+	RTSPWebSocketUri    xsd.AnyURI `xml:"RTSPWebSocketUri,attr"`
+}
+
+//Replay main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trp:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type GetReplayUri struct {
+	XMLName        string               `xml:"trp:GetReplayUri"`
+	StreamSetup    onvif.StreamSetup    `xml:"trp:StreamSetup"`
+	RecordingToken onvif.ReferenceToken `xml:"trp:RecordingToken"`
+}
+
+type GetReplayUriResponse struct {
+	Uri xsd.AnyURI
+}
+
+type GetReplayConfiguration struct {
+	XMLName string `xml:"trp:GetReplayConfiguration"`
+}
+
+type GetReplayConfigurationResponse struct {
+	Configuration onvif.ReplayConfiguration
+}
+
+type SetReplayConfiguration struct {
+	XMLName       string                    `xml:"trp:SetReplayConfiguration"`
+	Configuration onvif.ReplayConfiguration `xml:"trp:Configuration"`
+}
+
+type SetReplayConfigurationResponse struct {
+}
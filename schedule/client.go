@@ -0,0 +1,170 @@
+package schedule
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Schedule service (tsc): schedules and special day groups.
+// The device must expose the schedule endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	// PageSize is the Limit of the list requests of the GetAll methods, 0 means the device default
+	PageSize int
+
+	dev networking.RequestCreator
+}
+
+// NewClient return Schedule service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the schedule service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (ServiceCapabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetScheduleState return whether the schedule is active now
+func (c *Client) GetScheduleState(ctx context.Context, token onvif.ReferenceToken) (ScheduleState, error) {
+	resp := GetScheduleStateResponse{}
+	err := c.call(ctx, GetScheduleState{Token: token}, &resp)
+	return resp.ScheduleState, err
+}
+
+// GetScheduleInfo return the schedules info by tokens
+func (c *Client) GetScheduleInfo(ctx context.Context, tokens ...onvif.ReferenceToken) ([]ScheduleInfo, error) {
+	resp := GetScheduleInfoResponse{}
+	if err := c.call(ctx, GetScheduleInfo{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ScheduleInfo, nil
+}
+
+// GetScheduleInfoList return a page of the schedules info and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetScheduleInfoList(ctx context.Context, limit int, startReference string) ([]ScheduleInfo, string, error) {
+	resp := GetScheduleInfoListResponse{}
+	if err := c.call(ctx, GetScheduleInfoList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.ScheduleInfo, resp.NextStartReference, nil
+}
+
+// GetAllScheduleInfo return the schedules info of all pages, see PageSize
+func (c *Client) GetAllScheduleInfo(ctx context.Context) ([]ScheduleInfo, error) {
+	return networking.GetAll(ctx, c.GetScheduleInfoList, c.PageSize)
+}
+
+// GetSchedules return the schedules by tokens
+func (c *Client) GetSchedules(ctx context.Context, tokens ...onvif.ReferenceToken) ([]Schedule, error) {
+	resp := GetSchedulesResponse{}
+	if err := c.call(ctx, GetSchedules{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Schedule, nil
+}
+
+// GetScheduleList return a page of the schedules and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetScheduleList(ctx context.Context, limit int, startReference string) ([]Schedule, string, error) {
+	resp := GetScheduleListResponse{}
+	if err := c.call(ctx, GetScheduleList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.Schedule, resp.NextStartReference, nil
+}
+
+// GetAllSchedules return the schedules of all pages, see PageSize
+func (c *Client) GetAllSchedules(ctx context.Context) ([]Schedule, error) {
+	return networking.GetAll(ctx, c.GetScheduleList, c.PageSize)
+}
+
+// CreateSchedule create the schedule and return its token
+func (c *Client) CreateSchedule(ctx context.Context, schedule Schedule) (onvif.ReferenceToken, error) {
+	resp := CreateScheduleResponse{}
+	err := c.call(ctx, CreateSchedule{Schedule: schedule}, &resp)
+	return resp.Token, err
+}
+
+// ModifySchedule replace the schedule by token
+func (c *Client) ModifySchedule(ctx context.Context, schedule Schedule) error {
+	return c.call(ctx, ModifySchedule{Schedule: schedule}, &ModifyScheduleResponse{})
+}
+
+// DeleteSchedule delete the schedule
+func (c *Client) DeleteSchedule(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteSchedule{Token: token}, &DeleteScheduleResponse{})
+}
+
+// GetSpecialDayGroupInfo return the special day groups info by tokens
+func (c *Client) GetSpecialDayGroupInfo(ctx context.Context, tokens ...onvif.ReferenceToken) ([]SpecialDayGroupInfo, error) {
+	resp := GetSpecialDayGroupInfoResponse{}
+	if err := c.call(ctx, GetSpecialDayGroupInfo{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.SpecialDayGroupInfo, nil
+}
+
+// GetSpecialDayGroupInfoList return a page of the special day groups info and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetSpecialDayGroupInfoList(ctx context.Context, limit int, startReference string) ([]SpecialDayGroupInfo, string, error) {
+	resp := GetSpecialDayGroupInfoListResponse{}
+	if err := c.call(ctx, GetSpecialDayGroupInfoList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.SpecialDayGroupInfo, resp.NextStartReference, nil
+}
+
+// GetAllSpecialDayGroupInfo return the special day groups info of all pages, see PageSize
+func (c *Client) GetAllSpecialDayGroupInfo(ctx context.Context) ([]SpecialDayGroupInfo, error) {
+	return networking.GetAll(ctx, c.GetSpecialDayGroupInfoList, c.PageSize)
+}
+
+// GetSpecialDayGroups return the special day groups by tokens
+func (c *Client) GetSpecialDayGroups(ctx context.Context, tokens ...onvif.ReferenceToken) ([]SpecialDayGroup, error) {
+	resp := GetSpecialDayGroupsResponse{}
+	if err := c.call(ctx, GetSpecialDayGroups{Token: tokens}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.SpecialDayGroup, nil
+}
+
+// GetSpecialDayGroupList return a page of the special day groups and the reference of the next one, empty for the last page.
+// limit 0 means the device default.
+func (c *Client) GetSpecialDayGroupList(ctx context.Context, limit int, startReference string) ([]SpecialDayGroup, string, error) {
+	resp := GetSpecialDayGroupListResponse{}
+	if err := c.call(ctx, GetSpecialDayGroupList{Limit: limit, StartReference: startReference}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.SpecialDayGroup, resp.NextStartReference, nil
+}
+
+// GetAllSpecialDayGroups return the special day groups of all pages, see PageSize
+func (c *Client) GetAllSpecialDayGroups(ctx context.Context) ([]SpecialDayGroup, error) {
+	return networking.GetAll(ctx, c.GetSpecialDayGroupList, c.PageSize)
+}
+
+// CreateSpecialDayGroup create the special day group and return its token
+func (c *Client) CreateSpecialDayGroup(ctx context.Context, group SpecialDayGroup) (onvif.ReferenceToken, error) {
+	resp := CreateSpecialDayGroupResponse{}
+	err := c.call(ctx, CreateSpecialDayGroup{SpecialDayGroup: group}, &resp)
+	return resp.Token, err
+}
+
+// ModifySpecialDayGroup replace the special day group by token
+func (c *Client) ModifySpecialDayGroup(ctx context.Context, group SpecialDayGroup) error {
+	return c.call(ctx, ModifySpecialDayGroup{SpecialDayGroup: group}, &ModifySpecialDayGroupResponse{})
+}
+
+// DeleteSpecialDayGroup delete the special day group
+func (c *Client) DeleteSpecialDayGroup(ctx context.Context, token onvif.ReferenceToken) error {
+	return c.call(ctx, DeleteSpecialDayGroup{Token: token}, &DeleteSpecialDayGroupResponse{})
+}
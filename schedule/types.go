@@ -0,0 +1,228 @@
+package schedule
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+type ServiceCapabilities struct {
+	//MaxLimit is the max number of items the device returns in one GetXxxList response
+	MaxLimit                    int  `xml:"MaxLimit,attr"`
+	MaxSchedules                int  `xml:"MaxSchedules,attr"`
+	MaxTimePeriodsPerDay        int  `xml:"MaxTimePeriodsPerDay,attr"`
+	MaxSpecialDayGroups         int  `xml:"MaxSpecialDayGroups,attr"`
+	MaxDaysInSpecialDayGroup    int  `xml:"MaxDaysInSpecialDayGroup,attr"`
+	MaxSpecialDaysSchedules     int  `xml:"MaxSpecialDaysSchedules,attr"`
+	ExtendedRecurrenceSupported bool `xml:"ExtendedRecurrenceSupported,attr"`
+	SpecialDaysSupported        bool `xml:"SpecialDaysSupported,attr"`
+	StateReportingSupported     bool `xml:"StateReportingSupported,attr"`
+}
+
+type ScheduleInfo struct {
+	Token       onvif.ReferenceToken `xml:"token,attr"`
+	Name        onvif.Name
+	Description xsd.String
+}
+
+// TimePeriod of the day, From and Until are xs:time, ex: 08:00:00
+type TimePeriod struct {
+	From  string `xml:"http://www.onvif.org/ver10/schedule/wsdl From"`
+	Until string `xml:"http://www.onvif.org/ver10/schedule/wsdl Until,omitempty"`
+}
+
+// Deprecated: use TimePeriod, it decodes the responses too.
+type TimePeriodResponse = TimePeriod
+
+// SpecialDaysSchedule replaces the standard schedule on the days of the special day group
+type SpecialDaysSchedule struct {
+	GroupToken onvif.ReferenceToken `xml:"http://www.onvif.org/ver10/schedule/wsdl GroupToken"`
+	TimeRange  []TimePeriod         `xml:"http://www.onvif.org/ver10/schedule/wsdl TimeRange,omitempty"`
+}
+
+// Deprecated: use SpecialDaysSchedule, it decodes the responses too.
+type SpecialDaysScheduleResponse = SpecialDaysSchedule
+
+// Schedule the token is empty in CreateSchedule, the device assigns it.
+// Standard is iCalendar VEVENT list of the schedule.
+type Schedule struct {
+	Token       onvif.ReferenceToken  `xml:"token,attr"`
+	Name        onvif.Name            `xml:"http://www.onvif.org/ver10/schedule/wsdl Name"`
+	Description xsd.String            `xml:"http://www.onvif.org/ver10/schedule/wsdl Description,omitempty"`
+	Standard    string                `xml:"http://www.onvif.org/ver10/schedule/wsdl Standard"`
+	SpecialDays []SpecialDaysSchedule `xml:"http://www.onvif.org/ver10/schedule/wsdl SpecialDays,omitempty"`
+}
+
+// Deprecated: use Schedule, it decodes the responses too.
+type ScheduleResponse = Schedule
+
+type ScheduleState struct {
+	Active     bool
+	SpecialDay bool
+}
+
+type SpecialDayGroupInfo struct {
+	Token       onvif.ReferenceToken `xml:"token,attr"`
+	Name        onvif.Name
+	Description xsd.String
+}
+
+// SpecialDayGroup the token is empty in CreateSpecialDayGroup, the device assigns it.
+// Days is iCalendar VEVENT list of the days.
+type SpecialDayGroup struct {
+	Token       onvif.ReferenceToken `xml:"token,attr"`
+	Name        onvif.Name           `xml:"http://www.onvif.org/ver10/schedule/wsdl Name"`
+	Description xsd.String           `xml:"http://www.onvif.org/ver10/schedule/wsdl Description,omitempty"`
+	Days        string               `xml:"http://www.onvif.org/ver10/schedule/wsdl Days,omitempty"`
+}
+
+// Deprecated: use SpecialDayGroup, it decodes the responses too.
+type SpecialDayGroupResponse = SpecialDayGroup
+
+//Schedule main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tsc:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities ServiceCapabilities
+}
+
+type GetScheduleState struct {
+	XMLName string               `xml:"tsc:GetScheduleState"`
+	Token   onvif.ReferenceToken `xml:"tsc:Token"`
+}
+
+type GetScheduleStateResponse struct {
+	ScheduleState ScheduleState
+}
+
+type GetScheduleInfo struct {
+	XMLName string                 `xml:"tsc:GetScheduleInfo"`
+	Token   []onvif.ReferenceToken `xml:"tsc:Token"`
+}
+
+type GetScheduleInfoResponse struct {
+	ScheduleInfo []ScheduleInfo
+}
+
+type GetScheduleInfoList struct {
+	XMLName        string `xml:"tsc:GetScheduleInfoList"`
+	Limit          int    `xml:"tsc:Limit,omitempty"`
+	StartReference string `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetScheduleInfoListResponse struct {
+	NextStartReference string
+	ScheduleInfo       []ScheduleInfo
+}
+
+type GetSchedules struct {
+	XMLName string                 `xml:"tsc:GetSchedules"`
+	Token   []onvif.ReferenceToken `xml:"tsc:Token"`
+}
+
+type GetSchedulesResponse struct {
+	Schedule []Schedule
+}
+
+type GetScheduleList struct {
+	XMLName        string `xml:"tsc:GetScheduleList"`
+	Limit          int    `xml:"tsc:Limit,omitempty"`
+	StartReference string `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetScheduleListResponse struct {
+	NextStartReference string
+	Schedule           []Schedule
+}
+
+type CreateSchedule struct {
+	XMLName  string   `xml:"tsc:CreateSchedule"`
+	Schedule Schedule `xml:"tsc:Schedule"`
+}
+
+type CreateScheduleResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type ModifySchedule struct {
+	XMLName  string   `xml:"tsc:ModifySchedule"`
+	Schedule Schedule `xml:"tsc:Schedule"`
+}
+
+type ModifyScheduleResponse struct {
+}
+
+type DeleteSchedule struct {
+	XMLName string               `xml:"tsc:DeleteSchedule"`
+	Token   onvif.ReferenceToken `xml:"tsc:Token"`
+}
+
+type DeleteScheduleResponse struct {
+}
+
+type GetSpecialDayGroupInfo struct {
+	XMLName string                 `xml:"tsc:GetSpecialDayGroupInfo"`
+	Token   []onvif.ReferenceToken `xml:"tsc:Token"`
+}
+
+type GetSpecialDayGroupInfoResponse struct {
+	SpecialDayGroupInfo []SpecialDayGroupInfo
+}
+
+type GetSpecialDayGroupInfoList struct {
+	XMLName        string `xml:"tsc:GetSpecialDayGroupInfoList"`
+	Limit          int    `xml:"tsc:Limit,omitempty"`
+	StartReference string `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetSpecialDayGroupInfoListResponse struct {
+	NextStartReference  string
+	SpecialDayGroupInfo []SpecialDayGroupInfo
+}
+
+type GetSpecialDayGroups struct {
+	XMLName string                 `xml:"tsc:GetSpecialDayGroups"`
+	Token   []onvif.ReferenceToken `xml:"tsc:Token"`
+}
+
+type GetSpecialDayGroupsResponse struct {
+	SpecialDayGroup []SpecialDayGroup
+}
+
+type GetSpecialDayGroupList struct {
+	XMLName        string `xml:"tsc:GetSpecialDayGroupList"`
+	Limit          int    `xml:"tsc:Limit,omitempty"`
+	StartReference string `xml:"tsc:StartReference,omitempty"`
+}
+
+type GetSpecialDayGroupListResponse struct {
+	NextStartReference string
+	SpecialDayGroup    []SpecialDayGroup
+}
+
+type CreateSpecialDayGroup struct {
+	XMLName         string          `xml:"tsc:CreateSpecialDayGroup"`
+	SpecialDayGroup SpecialDayGroup `xml:"tsc:SpecialDayGroup"`
+}
+
+type CreateSpecialDayGroupResponse struct {
+	Token onvif.ReferenceToken
+}
+
+type ModifySpecialDayGroup struct {
+	XMLName         string          `xml:"tsc:ModifySpecialDayGroup"`
+	SpecialDayGroup SpecialDayGroup `xml:"tsc:SpecialDayGroup"`
+}
+
+type ModifySpecialDayGroupResponse struct {
+}
+
+type DeleteSpecialDayGroup struct {
+	XMLName string               `xml:"tsc:DeleteSpecialDayGroup"`
+	Token   onvif.ReferenceToken `xml:"tsc:Token"`
+}
+
+type DeleteSpecialDayGroupResponse struct {
+}
@@ -0,0 +1,125 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// DefaultKeepAliveTime of the search sessions, used when the request has no KeepAliveTime
+const DefaultKeepAliveTime = time.Minute
+
+// Client is a typed client of the Search service (tse) of the recorded data.
+// The device must expose the search endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Search service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the search service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetRecordingSummary return the time range and the number of all the recordings
+func (c *Client) GetRecordingSummary(ctx context.Context) (onvif.RecordingSummary, error) {
+	resp := GetRecordingSummaryResponse{}
+	err := c.call(ctx, GetRecordingSummary{}, &resp)
+	return resp.Summary, err
+}
+
+// GetRecordingInformation return the recording with its tracks and the recorded time ranges
+func (c *Client) GetRecordingInformation(ctx context.Context, recordingToken onvif.ReferenceToken) (onvif.RecordingInformation, error) {
+	resp := GetRecordingInformationResponse{}
+	err := c.call(ctx, GetRecordingInformation{RecordingToken: recordingToken}, &resp)
+	return resp.RecordingInformation, err
+}
+
+// FindRecordings start the recordings search and return its token
+func (c *Client) FindRecordings(ctx context.Context, request FindRecordings) (onvif.ReferenceToken, error) {
+	request.KeepAliveTime = keepAlive(request.KeepAliveTime)
+	resp := FindRecordingsResponse{}
+	err := c.call(ctx, request, &resp)
+	return resp.SearchToken, err
+}
+
+// GetRecordingSearchResults return the next results of the recordings search,
+// the device waits up to waitTime for maxResults, 0 values are omitted
+func (c *Client) GetRecordingSearchResults(ctx context.Context, searchToken onvif.ReferenceToken, maxResults int, waitTime time.Duration) (onvif.FindRecordingResultList, error) {
+	resp := GetRecordingSearchResultsResponse{}
+	err := c.call(ctx, GetRecordingSearchResults{SearchToken: searchToken, MaxResults: maxResults, WaitTime: waitDuration(waitTime)}, &resp)
+	return resp.ResultList, err
+}
+
+// FindEvents start the events search and return its token
+func (c *Client) FindEvents(ctx context.Context, request FindEvents) (onvif.ReferenceToken, error) {
+	request.KeepAliveTime = keepAlive(request.KeepAliveTime)
+	resp := FindEventsResponse{}
+	err := c.call(ctx, request, &resp)
+	return resp.SearchToken, err
+}
+
+// GetEventSearchResults return the next results of the events search,
+// the device waits up to waitTime for maxResults, 0 values are omitted
+func (c *Client) GetEventSearchResults(ctx context.Context, searchToken onvif.ReferenceToken, maxResults int, waitTime time.Duration) (FindEventResultList, error) {
+	resp := GetEventSearchResultsResponse{}
+	err := c.call(ctx, GetEventSearchResults{SearchToken: searchToken, MaxResults: maxResults, WaitTime: waitDuration(waitTime)}, &resp)
+	return resp.ResultList, err
+}
+
+// FindPTZPosition start the search of the PTZ positions and return its token
+func (c *Client) FindPTZPosition(ctx context.Context, request FindPTZPosition) (onvif.ReferenceToken, error) {
+	request.KeepAliveTime = keepAlive(request.KeepAliveTime)
+	resp := FindPTZPositionResponse{}
+	err := c.call(ctx, request, &resp)
+	return resp.SearchToken, err
+}
+
+// GetPTZPositionSearchResults return the next results of the PTZ positions search,
+// the device waits up to waitTime for maxResults, 0 values are omitted
+func (c *Client) GetPTZPositionSearchResults(ctx context.Context, searchToken onvif.ReferenceToken, maxResults int, waitTime time.Duration) (onvif.FindPTZPositionResultList, error) {
+	resp := GetPTZPositionSearchResultsResponse{}
+	err := c.call(ctx, GetPTZPositionSearchResults{SearchToken: searchToken, MaxResults: maxResults, WaitTime: waitDuration(waitTime)}, &resp)
+	return resp.ResultList, err
+}
+
+// GetSearchState return state of the search
+func (c *Client) GetSearchState(ctx context.Context, searchToken onvif.ReferenceToken) (onvif.SearchState, error) {
+	resp := GetSearchStateResponse{}
+	err := c.call(ctx, GetSearchState{SearchToken: searchToken}, &resp)
+	return resp.State, err
+}
+
+// EndSearch stop the search and free its resources, the point the search reached is returned
+func (c *Client) EndSearch(ctx context.Context, searchToken onvif.ReferenceToken) (xsd.DateTime, error) {
+	resp := EndSearchResponse{}
+	err := c.call(ctx, EndSearch{SearchToken: searchToken}, &resp)
+	return resp.Endpoint, err
+}
+
+func keepAlive(d xsd.Duration) xsd.Duration {
+	if len(d) == 0 {
+		return xsd.NewDuration(DefaultKeepAliveTime)
+	}
+	return d
+}
+
+func waitDuration(d time.Duration) xsd.Duration {
+	if d <= 0 {
+		return ""
+	}
+	return xsd.NewDuration(d)
+}
@@ -0,0 +1,158 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Results defaults
+const (
+	DefaultMaxResults   = 100
+	DefaultWaitTime     = 5 * time.Second
+	DefaultPollInterval = time.Second
+)
+
+// Results pages through the results of the search until the device reports it completed.
+//
+//	results, err := client.SearchEvents(ctx, search.FindEvents{StartPoint: xsd.NewDateTime(from), EndPoint: xsd.NewDateTime(to)})
+//	if err != nil {
+//		return err
+//	}
+//	defer results.Close(ctx)
+//	for results.Next(ctx) {
+//		fmt.Println(results.Value().Time)
+//	}
+//	return results.Err()
+type Results[T any] struct {
+	// MaxResults requested per page
+	MaxResults int
+	// WaitTime the device may wait for MaxResults, the http client timeout of the device must be greater
+	WaitTime time.Duration
+	// PollInterval delay before the next request when the device returned no results and the search is running
+	PollInterval time.Duration
+
+	client    *Client
+	token     onvif.ReferenceToken
+	fetch     func(ctx context.Context, maxResults int, waitTime time.Duration) ([]T, onvif.SearchState, error)
+	page      []T
+	value     T
+	completed bool
+	err       error
+}
+
+func newResults[T any](client *Client, token onvif.ReferenceToken, fetch func(context.Context, int, time.Duration) ([]T, onvif.SearchState, error)) *Results[T] {
+	return &Results[T]{
+		MaxResults:   DefaultMaxResults,
+		WaitTime:     DefaultWaitTime,
+		PollInterval: DefaultPollInterval,
+		client:       client,
+		token:        token,
+		fetch:        fetch,
+	}
+}
+
+// Token return the search token
+func (r *Results[T]) Token() onvif.ReferenceToken {
+	return r.token
+}
+
+// Next advance to the next result, it requests the next page when the current one is over.
+// False is returned when the search is completed or on error, see Err.
+func (r *Results[T]) Next(ctx context.Context) bool {
+	for len(r.page) == 0 {
+		if r.completed || r.err != nil {
+			return false
+		}
+
+		page, state, err := r.fetch(ctx, r.MaxResults, r.WaitTime)
+		if err != nil {
+			r.err = err
+			return false
+		}
+		r.page = page
+		r.completed = state == StateCompleted || state == StateUnknown ||
+			// some devices omit the required SearchState, the empty page ends their search
+			(state == "" && len(page) == 0)
+
+		if len(page) == 0 && !r.completed {
+			if err := wait(ctx, r.PollInterval); err != nil {
+				r.err = err
+				return false
+			}
+		}
+	}
+
+	r.value, r.page = r.page[0], r.page[1:]
+	return true
+}
+
+// Value return the current result
+func (r *Results[T]) Value() T {
+	return r.value
+}
+
+// Err return the error which stopped Next
+func (r *Results[T]) Err() error {
+	return r.err
+}
+
+// Close end the search if it is not completed yet, so the device frees its resources
+func (r *Results[T]) Close(ctx context.Context) error {
+	if r.completed {
+		return nil
+	}
+	r.completed = true
+	r.page = nil
+	_, err := r.client.EndSearch(ctx, r.token)
+	return err
+}
+
+// SearchRecordings start FindRecordings and return its results
+func (c *Client) SearchRecordings(ctx context.Context, request FindRecordings) (*Results[onvif.RecordingInformation], error) {
+	token, err := c.FindRecordings(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return newResults(c, token, func(ctx context.Context, maxResults int, waitTime time.Duration) ([]onvif.RecordingInformation, onvif.SearchState, error) {
+		list, err := c.GetRecordingSearchResults(ctx, token, maxResults, waitTime)
+		return list.RecordingInformation, list.SearchState, err
+	}), nil
+}
+
+// SearchEvents start FindEvents and return its results
+func (c *Client) SearchEvents(ctx context.Context, request FindEvents) (*Results[FindEventResult], error) {
+	token, err := c.FindEvents(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return newResults(c, token, func(ctx context.Context, maxResults int, waitTime time.Duration) ([]FindEventResult, onvif.SearchState, error) {
+		list, err := c.GetEventSearchResults(ctx, token, maxResults, waitTime)
+		return list.Result, list.SearchState, err
+	}), nil
+}
+
+// SearchPTZPositions start FindPTZPosition and return its results
+func (c *Client) SearchPTZPositions(ctx context.Context, request FindPTZPosition) (*Results[onvif.FindPTZPositionResult], error) {
+	token, err := c.FindPTZPosition(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return newResults(c, token, func(ctx context.Context, maxResults int, waitTime time.Duration) ([]onvif.FindPTZPositionResult, onvif.SearchState, error) {
+		list, err := c.GetPTZPositionSearchResults(ctx, token, maxResults, waitTime)
+		return list.Result, list.SearchState, err
+	}), nil
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
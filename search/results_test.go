@@ -0,0 +1,221 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// testDevice serves the search requests, pages are the content of the result lists
+// returned by GetRecordingSearchResults one after another, the last one is repeated
+type testDevice struct {
+	*httptest.Server
+	pages []string
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func newTestDevice(t *testing.T, pages ...string) *testDevice {
+	d := &testDevice{pages: pages}
+	d.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		name := bodyElement(body)
+
+		d.mu.Lock()
+		d.calls = append(d.calls, name)
+		n := 0
+		for _, call := range d.calls {
+			if call == name {
+				n++
+			}
+		}
+		d.mu.Unlock()
+
+		var content string
+		switch name {
+		case "FindRecordings":
+			content = `<tse:FindRecordingsResponse><tse:SearchToken>search_1</tse:SearchToken></tse:FindRecordingsResponse>`
+		case "GetRecordingSearchResults":
+			if n > len(d.pages) {
+				n = len(d.pages)
+			}
+			content = `<tse:GetRecordingSearchResultsResponse><tse:ResultList>` + d.pages[n-1] + `</tse:ResultList></tse:GetRecordingSearchResultsResponse>`
+		case "EndSearch":
+			content = `<tse:EndSearchResponse><tse:Endpoint>2024-05-01T10:00:00Z</tse:Endpoint></tse:EndSearchResponse>`
+		}
+		io.WriteString(w, `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:tt="http://www.onvif.org/ver10/schema" `+
+			`xmlns:tse="http://www.onvif.org/ver10/search/wsdl"><s:Body>`+content+`</s:Body></s:Envelope>`)
+	}))
+	t.Cleanup(d.Close)
+	return d
+}
+
+func (d *testDevice) GetEndpoint(string) (string, error) { return d.URL + "/onvif/search", nil }
+func (d *testDevice) DeltaTime() time.Duration           { return 0 }
+
+func (d *testDevice) CreateRequest(method interface{}) *networking.Request {
+	return networking.NewRequest(d, method)
+}
+
+func (d *testDevice) received() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.calls...)
+}
+
+func bodyElement(envelope []byte) string {
+	d := xml.NewDecoder(bytes.NewReader(envelope))
+	inBody := false
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if inBody {
+				return start.Name.Local
+			}
+			inBody = start.Name.Local == "Body"
+		}
+	}
+}
+
+// page return the result list content with the state and the recordings
+func page(state string, recordings ...string) string {
+	s := ""
+	if state != "" {
+		s = `<tt:SearchState>` + state + `</tt:SearchState>`
+	}
+	for _, token := range recordings {
+		s += `<tt:RecordingInformation><tt:RecordingToken>` + token + `</tt:RecordingToken></tt:RecordingInformation>`
+	}
+	return s
+}
+
+func TestResults(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages []string
+		want  []string
+		// calls is the count of GetRecordingSearchResults requests
+		calls int
+	}{
+		{"pages", []string{page("Searching", "rec_1", "rec_2"), page("Searching", "rec_3"), page("Completed", "rec_4")}, []string{"rec_1", "rec_2", "rec_3", "rec_4"}, 3},
+		{"empty pages while searching", []string{page("Queued"), page("Searching"), page("Completed", "rec_1")}, []string{"rec_1"}, 3},
+		{"completed without results", []string{page("Completed")}, nil, 1},
+		{"unknown", []string{page("Searching", "rec_1"), page("Unknown")}, []string{"rec_1"}, 2},
+		{"no state", []string{page("", "rec_1"), page("", "rec_2"), page("")}, []string{"rec_1", "rec_2"}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev := newTestDevice(t, tt.pages...)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			results, err := NewClient(dev).SearchRecordings(ctx, FindRecordings{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			results.PollInterval = 10 * time.Millisecond
+
+			var got []string
+			for results.Next(ctx) {
+				got = append(got, string(results.Value().RecordingToken))
+			}
+			if err := results.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("results %v, want %v", got, tt.want)
+			}
+			if results.Next(ctx) {
+				t.Error("Next after the end")
+			}
+
+			if err := results.Close(ctx); err != nil {
+				t.Fatal(err)
+			}
+			calls := dev.received()
+			if n := strings.Count(strings.Join(calls, ","), "GetRecordingSearchResults"); n != tt.calls {
+				t.Errorf("%d GetRecordingSearchResults requests, want %d", n, tt.calls)
+			}
+			if calls[len(calls)-1] == "EndSearch" {
+				t.Error("EndSearch of the completed search")
+			}
+		})
+	}
+}
+
+func TestResultsClose(t *testing.T) {
+	dev := newTestDevice(t, page("Searching", "rec_1", "rec_2"))
+	ctx := context.Background()
+	results, err := NewClient(dev).SearchRecordings(ctx, FindRecordings{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !results.Next(ctx) || results.Value().RecordingToken != "rec_1" {
+		t.Fatalf("first result %+v, error %v", results.Value(), results.Err())
+	}
+	if err := results.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if results.Next(ctx) {
+		t.Error("Next after Close")
+	}
+	if want := []string{"FindRecordings", "GetRecordingSearchResults", "EndSearch"}; !reflect.DeepEqual(dev.received(), want) {
+		t.Errorf("requests %v, want %v", dev.received(), want)
+	}
+}
+
+func TestResultsCancel(t *testing.T) {
+	// the search never completes
+	dev := newTestDevice(t, page("Searching"))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	results, err := NewClient(dev).SearchRecordings(ctx, FindRecordings{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results.PollInterval = 10 * time.Millisecond
+	if results.Next(ctx) {
+		t.Fatalf("result %+v", results.Value())
+	}
+	if !errors.Is(results.Err(), context.DeadlineExceeded) {
+		t.Errorf("error %v, want the deadline", results.Err())
+	}
+}
+
+func TestResultsFetchError(t *testing.T) {
+	fail := errors.New("connection reset")
+	pages := 0
+	results := newResults(nil, "search_1", func(context.Context, int, time.Duration) ([]onvif.RecordingInformation, onvif.SearchState, error) {
+		if pages++; pages == 1 {
+			return []onvif.RecordingInformation{{RecordingToken: "rec_1"}}, StateSearching, nil
+		}
+		return nil, "", fail
+	})
+	ctx := context.Background()
+	if !results.Next(ctx) {
+		t.Fatal(results.Err())
+	}
+	if results.Next(ctx) || !errors.Is(results.Err(), fail) {
+		t.Errorf("error %v, want %v", results.Err(), fail)
+	}
+	if results.Next(ctx) || pages != 2 {
+		t.Errorf("%d pages requested, the error is not kept", pages)
+	}
+}
@@ -0,0 +1,158 @@
+package search
+
+import (
+	"github.com/neirolis/onvif-go/event"
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Search states (tt:SearchState)
+const (
+	StateQueued    onvif.SearchState = "Queued"
+	StateSearching onvif.SearchState = "Searching"
+	StateCompleted onvif.SearchState = "Completed"
+	StateUnknown   onvif.SearchState = "Unknown"
+)
+
+type Capabilities struct {
+	MetadataSearch     bool `xml:"MetadataSearch,attr"`
+	GeneralStartEvents bool `xml:"GeneralStartEvents,attr"`
+}
+
+type FindEventResult struct {
+	RecordingToken onvif.ReferenceToken
+	TrackToken     onvif.ReferenceToken
+	Time           xsd.DateTime
+	//Event use Event.Messages() and event.Decode to get the typed events
+	Event event.NotificationMessage
+	//StartStateEvent is true for the state of the property at StartPoint (IncludeStartState)
+	StartStateEvent bool
+}
+
+type FindEventResultList struct {
+	SearchState onvif.SearchState
+	Result      []FindEventResult
+}
+
+//Search main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tse:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type GetRecordingSummary struct {
+	XMLName string `xml:"tse:GetRecordingSummary"`
+}
+
+type GetRecordingSummaryResponse struct {
+	Summary onvif.RecordingSummary
+}
+
+type GetRecordingInformation struct {
+	XMLName        string               `xml:"tse:GetRecordingInformation"`
+	RecordingToken onvif.ReferenceToken `xml:"tse:RecordingToken"`
+}
+
+type GetRecordingInformationResponse struct {
+	RecordingInformation onvif.RecordingInformation
+}
+
+type FindRecordings struct {
+	XMLName       string            `xml:"tse:FindRecordings"`
+	Scope         onvif.SearchScope `xml:"tse:Scope"`
+	MaxMatches    int               `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime xsd.Duration      `xml:"tse:KeepAliveTime"`
+}
+
+type FindRecordingsResponse struct {
+	SearchToken onvif.ReferenceToken
+}
+
+type GetRecordingSearchResults struct {
+	XMLName     string               `xml:"tse:GetRecordingSearchResults"`
+	SearchToken onvif.ReferenceToken `xml:"tse:SearchToken"`
+	MinResults  int                  `xml:"tse:MinResults,omitempty"`
+	MaxResults  int                  `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration         `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetRecordingSearchResultsResponse struct {
+	ResultList onvif.FindRecordingResultList
+}
+
+// FindEvents SearchFilter is built with event.FilterBuilder, the empty filter matches all the events.
+// EndPoint before StartPoint searches backwards.
+type FindEvents struct {
+	XMLName           string            `xml:"tse:FindEvents"`
+	StartPoint        xsd.DateTime      `xml:"tse:StartPoint"`
+	EndPoint          xsd.DateTime      `xml:"tse:EndPoint,omitempty"`
+	Scope             onvif.SearchScope `xml:"tse:Scope"`
+	SearchFilter      event.FilterType  `xml:"tse:SearchFilter"`
+	IncludeStartState bool              `xml:"tse:IncludeStartState"`
+	MaxMatches        int               `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime     xsd.Duration      `xml:"tse:KeepAliveTime"`
+}
+
+type FindEventsResponse struct {
+	SearchToken onvif.ReferenceToken
+}
+
+type GetEventSearchResults struct {
+	XMLName     string               `xml:"tse:GetEventSearchResults"`
+	SearchToken onvif.ReferenceToken `xml:"tse:SearchToken"`
+	MinResults  int                  `xml:"tse:MinResults,omitempty"`
+	MaxResults  int                  `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration         `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetEventSearchResultsResponse struct {
+	ResultList FindEventResultList
+}
+
+type FindPTZPosition struct {
+	XMLName       string                  `xml:"tse:FindPTZPosition"`
+	StartPoint    xsd.DateTime            `xml:"tse:StartPoint"`
+	EndPoint      xsd.DateTime            `xml:"tse:EndPoint,omitempty"`
+	Scope         onvif.SearchScope       `xml:"tse:Scope"`
+	SearchFilter  onvif.PTZPositionFilter `xml:"tse:SearchFilter"`
+	MaxMatches    int                     `xml:"tse:MaxMatches,omitempty"`
+	KeepAliveTime xsd.Duration            `xml:"tse:KeepAliveTime"`
+}
+
+type FindPTZPositionResponse struct {
+	SearchToken onvif.ReferenceToken
+}
+
+type GetPTZPositionSearchResults struct {
+	XMLName     string               `xml:"tse:GetPTZPositionSearchResults"`
+	SearchToken onvif.ReferenceToken `xml:"tse:SearchToken"`
+	MinResults  int                  `xml:"tse:MinResults,omitempty"`
+	MaxResults  int                  `xml:"tse:MaxResults,omitempty"`
+	WaitTime    xsd.Duration         `xml:"tse:WaitTime,omitempty"`
+}
+
+type GetPTZPositionSearchResultsResponse struct {
+	ResultList onvif.FindPTZPositionResultList
+}
+
+type GetSearchState struct {
+	XMLName     string               `xml:"tse:GetSearchState"`
+	SearchToken onvif.ReferenceToken `xml:"tse:SearchToken"`
+}
+
+type GetSearchStateResponse struct {
+	State onvif.SearchState
+}
+
+type EndSearch struct {
+	XMLName     string               `xml:"tse:EndSearch"`
+	SearchToken onvif.ReferenceToken `xml:"tse:SearchToken"`
+}
+
+type EndSearchResponse struct {
+	Endpoint xsd.DateTime
+}
@@ -0,0 +1,199 @@
+package onvif
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/neirolis/onvif-go/media"
+	"github.com/neirolis/onvif-go/media2"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// StreamURI normalized stream of the media profile, received from Media2 or Media service
+type StreamURI struct {
+	ProfileToken string
+	ProfileName  string
+	// Encoding of the video encoder: H264, H265, JPEG, MPEG4
+	Encoding    string
+	Width       int
+	Height      int
+	URI         string
+	SnapshotURI string
+	// Media2 the stream was received from Media2 service
+	Media2 bool
+}
+
+// StreamURIsOptions options of Device.StreamURIs
+type StreamURIsOptions struct {
+	// Multicast request RTP multicast uris instead of unicast
+	Multicast bool
+	// Media force the Media (ver10) service even if the device supports Media2
+	Media bool
+	// SkipSnapshot do not request snapshot uris
+	SkipSnapshot bool
+	// KeepHost disable the NAT rewriting of the uris by ReplaceHostToXAddr
+	KeepHost bool
+}
+
+// StreamURIs return the stream uri of each media profile with a video encoder.
+// Media2 is preferred when the device exposes it (see Inspect), Media is used otherwise
+// or when any Media2 request fails and the device exposes Media too, the firmwares often
+// advertise Media2 and answer it with an error page. Snapshot uri errors are ignored, as it is an optional feature.
+func (dev *Device) StreamURIs(ctx context.Context, opts StreamURIsOptions) ([]StreamURI, error) {
+	if _, ok := dev.endpoints["media2"]; ok && !opts.Media {
+		streams, err := dev.media2StreamURIs(ctx, opts)
+		if _, media := dev.endpoints["media"]; err == nil || !media || ctx.Err() != nil {
+			return streams, err
+		}
+	}
+
+	return dev.mediaStreamURIs(ctx, opts)
+}
+
+func (dev *Device) media2StreamURIs(ctx context.Context, opts StreamURIsOptions) ([]StreamURI, error) {
+	client := media2.NewClient(dev)
+
+	profiles, err := client.GetProfiles(ctx, media2.ConfigurationVideoEncoder)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := media2.ProtocolRtspUnicast
+	if opts.Multicast {
+		protocol = media2.ProtocolRtspMulticast
+	}
+
+	streams := make([]StreamURI, 0, len(profiles))
+	for _, profile := range profiles {
+		encoder := profile.Configurations.VideoEncoder
+		if encoder == nil {
+			continue
+		}
+
+		uri, err := client.GetStreamUri(ctx, profile.Token, protocol)
+		if err != nil {
+			return nil, err
+		}
+
+		stream := StreamURI{
+			ProfileToken: string(profile.Token),
+			ProfileName:  string(profile.Name),
+			Encoding:     encoder.Encoding,
+			Width:        int(encoder.Resolution.Width),
+			Height:       int(encoder.Resolution.Height),
+			URI:          string(uri),
+			Media2:       true,
+		}
+
+		if !opts.SkipSnapshot {
+			if snapshot, err := client.GetSnapshotUri(ctx, profile.Token); err == nil {
+				stream.SnapshotURI = string(snapshot)
+			}
+		}
+
+		streams = append(streams, dev.rewriteStreamURI(stream, opts))
+	}
+
+	return streams, nil
+}
+
+func (dev *Device) mediaStreamURIs(ctx context.Context, opts StreamURIsOptions) ([]StreamURI, error) {
+	client := media.NewClient(dev)
+
+	profiles, err := client.GetProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	setup := onvif.StreamSetup{
+		Stream:    "RTP-Unicast",
+		Transport: onvif.Transport{Protocol: "RTSP"},
+	}
+	if opts.Multicast {
+		setup = onvif.StreamSetup{
+			Stream:    "RTP-Multicast",
+			Transport: onvif.Transport{Protocol: "UDP"},
+		}
+	}
+
+	streams := make([]StreamURI, 0, len(profiles))
+	for _, profile := range profiles {
+		encoder := profile.VideoEncoderConfiguration
+		if len(encoder.Token) == 0 {
+			continue
+		}
+
+		uri, err := client.GetStreamUri(ctx, profile.Token, setup)
+		if err != nil {
+			return nil, err
+		}
+
+		stream := StreamURI{
+			ProfileToken: string(profile.Token),
+			ProfileName:  string(profile.Name),
+			Encoding:     string(encoder.Encoding),
+			Width:        int(encoder.Resolution.Width),
+			Height:       int(encoder.Resolution.Height),
+			URI:          string(uri.Uri),
+		}
+
+		if !opts.SkipSnapshot {
+			if snapshot, err := client.GetSnapshotUri(ctx, profile.Token); err == nil {
+				stream.SnapshotURI = string(snapshot.Uri)
+			}
+		}
+
+		streams = append(streams, dev.rewriteStreamURI(stream, opts))
+	}
+
+	return streams, nil
+}
+
+// rewriteStreamURI replace hosts of the uris by ReplaceHostToXAddr.
+// The port of the stream uri is kept, the device xaddr port is the http one and RTSP is served on its own port.
+func (dev *Device) rewriteStreamURI(stream StreamURI, opts StreamURIsOptions) StreamURI {
+	if opts.KeepHost {
+		return stream
+	}
+
+	if len(stream.URI) > 0 {
+		stream.URI = dev.ReplaceHostKeepPort(stream.URI)
+	}
+	if len(stream.SnapshotURI) > 0 {
+		stream.SnapshotURI, _ = dev.ReplaceHostToXAddr(stream.SnapshotURI)
+	}
+
+	return stream
+}
+
+// ReplaceHostKeepPort replace the host of u by the host of dev.params.Xaddr and keep the port of u.
+// The xaddr port is the http one, the media uris (RTSP) are served on their own port.
+func (dev *Device) ReplaceHostKeepPort(u string) string {
+	original, err := url.Parse(u)
+	if err != nil {
+		return u
+	}
+
+	replaced, err := dev.ReplaceHostToXAddr(u)
+	if err != nil {
+		return replaced
+	}
+
+	res, err := url.Parse(replaced)
+	if err != nil {
+		return replaced
+	}
+
+	switch host := res.Hostname(); {
+	case len(original.Port()) > 0:
+		res.Host = net.JoinHostPort(host, original.Port())
+	case strings.Contains(host, ":"):
+		res.Host = "[" + host + "]"
+	default:
+		res.Host = host
+	}
+
+	return res.String()
+}
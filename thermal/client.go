@@ -0,0 +1,78 @@
+package thermal
+
+import (
+	"context"
+
+	"github.com/neirolis/onvif-go/networking"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Client is a typed client of the Thermal service (tth): color palettes, NUC tables, cooler and radiometry.
+// The device must expose the thermal endpoint, see onvif.Device.GetEndpoint.
+type Client struct {
+	dev networking.RequestCreator
+}
+
+// NewClient return Thermal service client, dev is usually *onvif.Device
+func NewClient(dev networking.RequestCreator) *Client {
+	return &Client{dev: dev}
+}
+
+func (c *Client) call(ctx context.Context, method, response interface{}) error {
+	return c.dev.CreateRequest(method).WithContext(ctx).Do().Unmarshal(response)
+}
+
+// GetServiceCapabilities return capabilities of the thermal service
+func (c *Client) GetServiceCapabilities(ctx context.Context) (Capabilities, error) {
+	resp := GetServiceCapabilitiesResponse{}
+	err := c.call(ctx, GetServiceCapabilities{}, &resp)
+	return resp.Capabilities, err
+}
+
+// GetConfigurationOptions return the color palettes, NUC tables and cooler options of the video source
+func (c *Client) GetConfigurationOptions(ctx context.Context, videoSourceToken onvif.ReferenceToken) (ConfigurationOptions, error) {
+	resp := GetConfigurationOptionsResponse{}
+	err := c.call(ctx, GetConfigurationOptions{VideoSourceToken: videoSourceToken}, &resp)
+	return resp.ConfigurationOptions, err
+}
+
+// GetConfiguration return the thermal configuration of the video source
+func (c *Client) GetConfiguration(ctx context.Context, videoSourceToken onvif.ReferenceToken) (Configuration, error) {
+	resp := GetConfigurationResponse{}
+	err := c.call(ctx, GetConfiguration{VideoSourceToken: videoSourceToken}, &resp)
+	return resp.Configuration, err
+}
+
+// GetConfigurations return the thermal configurations of all thermal video sources
+func (c *Client) GetConfigurations(ctx context.Context) ([]Configurations, error) {
+	resp := GetConfigurationsResponse{}
+	if err := c.call(ctx, GetConfigurations{}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Configurations, nil
+}
+
+// SetConfiguration set the thermal configuration of the video source,
+// the color palette and the NUC table must be one of GetConfigurationOptions
+func (c *Client) SetConfiguration(ctx context.Context, videoSourceToken onvif.ReferenceToken, configuration Configuration) error {
+	return c.call(ctx, SetConfiguration{VideoSourceToken: videoSourceToken, Configuration: configuration}, &SetConfigurationResponse{})
+}
+
+// GetRadiometryConfigurationOptions return the ranges of the radiometry global parameters of the video source
+func (c *Client) GetRadiometryConfigurationOptions(ctx context.Context, videoSourceToken onvif.ReferenceToken) (RadiometryConfigurationOptions, error) {
+	resp := GetRadiometryConfigurationOptionsResponse{}
+	err := c.call(ctx, GetRadiometryConfigurationOptions{VideoSourceToken: videoSourceToken}, &resp)
+	return resp.ConfigurationOptions, err
+}
+
+// GetRadiometryConfiguration return the radiometry configuration of the video source
+func (c *Client) GetRadiometryConfiguration(ctx context.Context, videoSourceToken onvif.ReferenceToken) (RadiometryConfiguration, error) {
+	resp := GetRadiometryConfigurationResponse{}
+	err := c.call(ctx, GetRadiometryConfiguration{VideoSourceToken: videoSourceToken}, &resp)
+	return resp.Configuration, err
+}
+
+// SetRadiometryConfiguration set the radiometry configuration of the video source
+func (c *Client) SetRadiometryConfiguration(ctx context.Context, videoSourceToken onvif.ReferenceToken, configuration RadiometryConfiguration) error {
+	return c.call(ctx, SetRadiometryConfiguration{VideoSourceToken: videoSourceToken, Configuration: configuration}, &SetRadiometryConfigurationResponse{})
+}
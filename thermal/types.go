@@ -0,0 +1,212 @@
+package thermal
+
+import (
+	"github.com/neirolis/onvif-go/xsd"
+	"github.com/neirolis/onvif-go/xsd/onvif"
+)
+
+// Polarity of the thermal image
+type Polarity xsd.String
+
+const (
+	PolarityWhiteHot Polarity = "WhiteHot"
+	PolarityBlackHot Polarity = "BlackHot"
+)
+
+// Color palette types, ColorPalette.Type is a string so the vendor types are allowed too
+const (
+	ColorPaletteCustom    = "Custom"
+	ColorPaletteGrayscale = "Grayscale"
+	ColorPaletteBlackHot  = "BlackHot"
+	ColorPaletteWhiteHot  = "WhiteHot"
+	ColorPaletteSepia     = "Sepia"
+	ColorPaletteRed       = "Red"
+	ColorPaletteIron      = "Iron"
+	ColorPaletteRain      = "Rain"
+	ColorPaletteRainbow   = "Rainbow"
+	ColorPaletteIsotherm  = "Isotherm"
+)
+
+type Capabilities struct {
+	Radiometry bool `xml:"Radiometry,attr"`
+}
+
+type ColorPalette struct {
+	Token onvif.ReferenceToken `xml:"token,attr"`
+	Type  string               `xml:"Type,attr"`
+	Name  onvif.Name           `xml:"http://www.onvif.org/ver10/thermal/wsdl Name"`
+}
+
+// Deprecated: use ColorPalette, it decodes the responses too.
+type ColorPaletteResponse = ColorPalette
+
+// NUCTable is the Non-Uniformity Correction table, the temperatures are in Kelvin
+type NUCTable struct {
+	Token           onvif.ReferenceToken `xml:"token,attr"`
+	LowTemperature  *float64             `xml:"LowTemperature,attr,omitempty"`
+	HighTemperature *float64             `xml:"HighTemperature,attr,omitempty"`
+	Name            onvif.Name           `xml:"http://www.onvif.org/ver10/thermal/wsdl Name"`
+}
+
+// Deprecated: use NUCTable, it decodes the responses too.
+type NUCTableResponse = NUCTable
+
+// Cooler RunTime is in hours
+type Cooler struct {
+	Enabled bool     `xml:"http://www.onvif.org/ver10/thermal/wsdl Enabled"`
+	RunTime *float64 `xml:"http://www.onvif.org/ver10/thermal/wsdl RunTime,omitempty"`
+}
+
+// Deprecated: use Cooler, it decodes the responses too.
+type CoolerResponse = Cooler
+
+type CoolerOptions struct {
+	Enabled bool
+}
+
+// Configuration of the thermal video source
+type Configuration struct {
+	ColorPalette ColorPalette `xml:"http://www.onvif.org/ver10/thermal/wsdl ColorPalette"`
+	Polarity     Polarity     `xml:"http://www.onvif.org/ver10/thermal/wsdl Polarity"`
+	NUCTable     *NUCTable    `xml:"http://www.onvif.org/ver10/thermal/wsdl NUCTable,omitempty"`
+	Cooler       *Cooler      `xml:"http://www.onvif.org/ver10/thermal/wsdl Cooler,omitempty"`
+}
+
+// Deprecated: use Configuration, it decodes the responses too.
+type ConfigurationResponse = Configuration
+
+// Configurations is the configuration of the video source by its token
+type Configurations struct {
+	Token         onvif.ReferenceToken `xml:"token,attr"`
+	Configuration Configuration
+}
+
+type ConfigurationOptions struct {
+	ColorPalette  []ColorPalette
+	NUCTable      []NUCTable
+	CoolerOptions *CoolerOptions
+}
+
+// RadiometryGlobalParameters the temperatures are in Kelvin, the distance in meters,
+// emissivity, humidity and transmittances are in [0, 1]
+type RadiometryGlobalParameters struct {
+	ReflectedAmbientTemperature float64  `xml:"http://www.onvif.org/ver10/thermal/wsdl ReflectedAmbientTemperature"`
+	Emissivity                  float64  `xml:"http://www.onvif.org/ver10/thermal/wsdl Emissivity"`
+	DistanceToObject            float64  `xml:"http://www.onvif.org/ver10/thermal/wsdl DistanceToObject"`
+	RelativeHumidity            *float64 `xml:"http://www.onvif.org/ver10/thermal/wsdl RelativeHumidity,omitempty"`
+	AtmosphericTemperature      *float64 `xml:"http://www.onvif.org/ver10/thermal/wsdl AtmosphericTemperature,omitempty"`
+	AtmosphericTransmittance    *float64 `xml:"http://www.onvif.org/ver10/thermal/wsdl AtmosphericTransmittance,omitempty"`
+	ExtOpticsTemperature        *float64 `xml:"http://www.onvif.org/ver10/thermal/wsdl ExtOpticsTemperature,omitempty"`
+	ExtOpticsTransmittance      *float64 `xml:"http://www.onvif.org/ver10/thermal/wsdl ExtOpticsTransmittance,omitempty"`
+}
+
+// Deprecated: use RadiometryGlobalParameters, it decodes the responses too.
+type RadiometryGlobalParametersResponse = RadiometryGlobalParameters
+
+type RadiometryGlobalParameterOptions struct {
+	ReflectedAmbientTemperature onvif.FloatRange
+	Emissivity                  onvif.FloatRange
+	DistanceToObject            onvif.FloatRange
+	RelativeHumidity            *onvif.FloatRange
+	AtmosphericTemperature      *onvif.FloatRange
+	AtmosphericTransmittance    *onvif.FloatRange
+	ExtOpticsTemperature        *onvif.FloatRange
+	ExtOpticsTransmittance      *onvif.FloatRange
+}
+
+type RadiometryConfiguration struct {
+	RadiometryGlobalParameters *RadiometryGlobalParameters `xml:"http://www.onvif.org/ver10/thermal/wsdl RadiometryGlobalParameters,omitempty"`
+}
+
+// Deprecated: use RadiometryConfiguration, it decodes the responses too.
+type RadiometryConfigurationResponse = RadiometryConfiguration
+
+type RadiometryConfigurationOptions struct {
+	RadiometryGlobalParameterOptions *RadiometryGlobalParameterOptions
+}
+
+//Thermal main types
+
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tth:GetServiceCapabilities"`
+}
+
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities
+}
+
+type GetConfigurationOptions struct {
+	XMLName          string               `xml:"tth:GetConfigurationOptions"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetConfigurationOptionsResponse struct {
+	ConfigurationOptions ConfigurationOptions
+}
+
+type GetConfiguration struct {
+	XMLName          string               `xml:"tth:GetConfiguration"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetConfigurationResponse struct {
+	Configuration Configuration
+}
+
+type GetConfigurations struct {
+	XMLName string `xml:"tth:GetConfigurations"`
+}
+
+type GetConfigurationsResponse struct {
+	Configurations []Configurations
+}
+
+type SetConfiguration struct {
+	XMLName          string               `xml:"tth:SetConfiguration"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+	Configuration    Configuration        `xml:"tth:Configuration"`
+}
+
+type SetConfigurationResponse struct {
+}
+
+type GetRadiometryConfigurationOptions struct {
+	XMLName          string               `xml:"tth:GetRadiometryConfigurationOptions"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetRadiometryConfigurationOptionsResponse struct {
+	ConfigurationOptions RadiometryConfigurationOptions
+}
+
+type GetRadiometryConfiguration struct {
+	XMLName          string               `xml:"tth:GetRadiometryConfiguration"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tth:VideoSourceToken"`
+}
+
+type GetRadiometryConfigurationResponse struct {
+	Configuration RadiometryConfiguration
+}
+
+type SetRadiometryConfiguration struct {
+	XMLName          string                  `xml:"tth:SetRadiometryConfiguration"`
+	VideoSourceToken onvif.ReferenceToken    `xml:"tth:VideoSourceToken"`
+	Configuration    RadiometryConfiguration `xml:"tth:Configuration"`
+}
+
+type SetRadiometryConfigurationResponse struct {
+}
+
+// Configuration return c, the received configuration is set as is.
+//
+// Deprecated: pass the received configuration to SetConfiguration.
+func (c Configuration) Configuration() Configuration {
+	return c
+}
+
+// Configuration return c, the received configuration is set as is.
+//
+// Deprecated: pass the received configuration to SetRadiometryConfiguration.
+func (c RadiometryConfiguration) Configuration() RadiometryConfiguration {
+	return c
+}
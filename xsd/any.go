@@ -0,0 +1,194 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// AnyElement keeps an element with an unknown content (xs:any), ex: the Extension elements.
+// The content is captured on unmarshal and encoded back verbatim, so the vendor extensions
+// and the elements of newer versions of the specification survive a Get and Set round trip.
+//
+// The zero value is not encoded at all.
+type AnyElement struct {
+	XMLName xml.Name
+	// Attr attributes of the element without the namespace declarations
+	Attr []xml.Attr
+	// Namespaces declarations in scope of the element used by Attr and InnerXML, namespace by prefix.
+	// The empty prefix is the default namespace the unprefixed elements of InnerXML rely on.
+	Namespaces map[string]string
+	// InnerXML raw content of the element, the prefixes and the whitespace are kept as received
+	InnerXML string
+}
+
+// IsZero reports whether the element is absent
+func (a AnyElement) IsZero() bool {
+	return a.XMLName.Local == "" && len(a.Attr) == 0 && a.InnerXML == ""
+}
+
+// anyNode is the element of the content with the names resolved by the decoder
+type anyNode struct {
+	XMLName xml.Name
+	Attr    []xml.Attr `xml:",any,attr"`
+	Nodes   []anyNode  `xml:",any"`
+}
+
+// UnmarshalXML captures the raw content of the element. The decoder does not tell the declarations
+// of the ancestors, so the prefixes of the content are resolved by the names the decoder has translated,
+// the declarations are kept in Namespaces and written on the element by MarshalXML.
+func (a *AnyElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var content struct {
+		Nodes    []anyNode `xml:",any"`
+		InnerXML string    `xml:",innerxml"`
+	}
+	if err := d.DecodeElement(&content, &start); err != nil {
+		return err
+	}
+
+	a.XMLName = start.Name
+	a.InnerXML = content.InnerXML
+	a.Attr = nil
+	a.Namespaces = map[string]string{}
+	for _, attr := range start.Attr {
+		switch {
+		case attr.Name.Space == "xmlns":
+			a.Namespaces[attr.Name.Local] = attr.Value
+		case attr.Name.Space == "" && attr.Name.Local == "xmlns":
+		default:
+			a.Attr = append(a.Attr, attr)
+		}
+	}
+
+	var nodes []*anyNode
+	var walk func([]anyNode)
+	walk = func(children []anyNode) {
+		for i := range children {
+			nodes = append(nodes, &children[i])
+			walk(children[i].Nodes)
+		}
+	}
+	walk(content.Nodes)
+
+	// the raw names are matched with the translated ones in the document order,
+	// the prefixes declared inside the content are left as they are
+	raw := xml.NewDecoder(strings.NewReader(content.InnerXML))
+	var scopes []map[string]bool
+	declared := func(prefix string) bool {
+		for i := len(scopes) - 1; i >= 0; i-- {
+			if scopes[i][prefix] {
+				return true
+			}
+		}
+		return false
+	}
+	resolve := func(raw, translated xml.Name, isElement bool) {
+		prefix := raw.Space
+		switch {
+		case prefix == "xmlns" || prefix == "xml" || prefix == "" && !isElement || declared(prefix):
+		case prefix != "" && prefix == translated.Space:
+			// the prefix is not bound
+		default:
+			if _, ok := a.Namespaces[prefix]; !ok {
+				a.Namespaces[prefix] = translated.Space
+			}
+		}
+	}
+	for i := 0; ; {
+		tok, err := raw.RawToken()
+		if err != nil {
+			break
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			scope := map[string]bool{}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" {
+					scope[attr.Name.Local] = true
+				} else if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					scope[""] = true
+				}
+			}
+			scopes = append(scopes, scope)
+			if i >= len(nodes) {
+				continue
+			}
+			node := nodes[i]
+			i++
+			resolve(t.Name, node.XMLName, true)
+			for j, attr := range t.Attr {
+				if j < len(node.Attr) {
+					resolve(attr.Name, node.Attr[j].Name, false)
+				}
+			}
+		case xml.EndElement:
+			scopes = scopes[:len(scopes)-1]
+		}
+	}
+	return nil
+}
+
+// MarshalXML encodes the captured element. The name of the field is used if it has a namespace,
+// otherwise XMLName is used, so the elements of the ",any" fields keep their names.
+func (a AnyElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if a.IsZero() {
+		return nil
+	}
+	if start.Name.Space == "" && a.XMLName.Local != "" {
+		start.Name = a.XMLName
+	}
+
+	namespaces := make(map[string]string, len(a.Namespaces)+1)
+	for prefix, namespace := range a.Namespaces {
+		namespaces[prefix] = namespace
+	}
+	qualify := func(name xml.Name) string {
+		if name.Space == "" {
+			return name.Local
+		}
+		if name.Space == xmlNamespace {
+			return "xml:" + name.Local
+		}
+		prefix := ""
+		for p, namespace := range namespaces {
+			if p != "" && namespace == name.Space && (prefix == "" || p < prefix) {
+				prefix = p
+			}
+		}
+		for i := 1; prefix == ""; i++ {
+			if _, taken := namespaces["ns"+strconv.Itoa(i)]; !taken {
+				prefix = "ns" + strconv.Itoa(i)
+				namespaces[prefix] = name.Space
+			}
+		}
+		return prefix + ":" + name.Local
+	}
+
+	var attrs []xml.Attr
+	if space, ok := namespaces[""]; ok && space != start.Name.Space {
+		// the content relies on another default namespace, the element is written with a prefix
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: "xmlns"}, Value: space})
+		start.Name = xml.Name{Local: qualify(start.Name)}
+	}
+	delete(namespaces, "")
+	for _, attr := range a.Attr {
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: qualify(attr.Name)}, Value: attr.Value})
+	}
+
+	prefixes := make([]string, 0, len(namespaces))
+	for prefix := range namespaces {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		attrs = append(attrs, xml.Attr{Name: xml.Name{Local: "xmlns:" + prefix}, Value: namespaces[prefix]})
+	}
+
+	return e.EncodeElement(struct {
+		Attr     []xml.Attr `xml:",any,attr"`
+		InnerXML string     `xml:",innerxml"`
+	}{attrs, a.InnerXML}, start)
+}
@@ -0,0 +1,229 @@
+package xsd_test
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+// node is an element with the names resolved by the decoder
+type node struct {
+	XMLName xml.Name
+	Attr    []xml.Attr `xml:",any,attr"`
+	Nodes   []node     `xml:",any"`
+	Text    string     `xml:",chardata"`
+}
+
+// resolved returns the element with the names resolved and without the declarations
+func resolved(t *testing.T, data string) node {
+	t.Helper()
+	var n node
+	if err := xml.Unmarshal([]byte(data), &n); err != nil {
+		t.Fatalf("%v\n%s", err, data)
+	}
+	clean(&n)
+	return n
+}
+
+func clean(n *node) {
+	attrs := n.Attr[:0]
+	for _, attr := range n.Attr {
+		if attr.Name.Space != "xmlns" && !(attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			attrs = append(attrs, attr)
+		}
+	}
+	n.Attr = attrs
+	if len(n.Attr) == 0 {
+		n.Attr = nil
+	}
+	n.Text = strings.TrimSpace(n.Text)
+	for i := range n.Nodes {
+		clean(&n.Nodes[i])
+	}
+}
+
+type extension struct {
+	XMLName   xml.Name       `xml:"http://www.onvif.org/ver10/schema Configuration"`
+	Extension xsd.AnyElement `xml:"http://www.onvif.org/ver10/schema Extension"`
+}
+
+type envelope struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2003/05/soap-envelope Envelope"`
+	Body    struct {
+		Configuration extension
+	} `xml:"http://www.w3.org/2003/05/soap-envelope Body"`
+}
+
+type vendor struct {
+	XMLName xml.Name         `xml:"http://www.onvif.org/ver10/schema Configuration"`
+	Name    string           `xml:"http://www.onvif.org/ver10/schema Name"`
+	Any     []xsd.AnyElement `xml:",any"`
+}
+
+func TestAnyElement(t *testing.T) {
+	tests := []struct {
+		name string
+		// xml is the document as received
+		xml string
+		// extension is the element the AnyElement keeps
+		extension string
+		// value decodes xml
+		value interface{}
+		// element returns the AnyElement of the decoded value
+		element func(v interface{}) xsd.AnyElement
+	}{
+		{
+			name: "prefixes declared on the ancestors",
+			xml: `<tt:Configuration xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:acme="http://example.com/acme">
+				<tt:Extension><acme:Blink><acme:Rate>2</acme:Rate></acme:Blink></tt:Extension>
+			</tt:Configuration>`,
+			extension: `<tt:Extension xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:acme="http://example.com/acme">
+				<acme:Blink><acme:Rate>2</acme:Rate></acme:Blink>
+			</tt:Extension>`,
+			value:   &extension{},
+			element: func(v interface{}) xsd.AnyElement { return v.(*extension).Extension },
+		},
+		{
+			name: "prefixes declared on several ancestors",
+			xml: `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope" xmlns:acme="http://example.com/acme">
+				<env:Body xmlns:hw="http://example.com/hw">
+					<tt:Configuration xmlns:tt="http://www.onvif.org/ver10/schema">
+						<tt:Extension><acme:Blink><hw:Led>on</hw:Led></acme:Blink></tt:Extension>
+					</tt:Configuration>
+				</env:Body>
+			</env:Envelope>`,
+			extension: `<tt:Extension xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:acme="http://example.com/acme" xmlns:hw="http://example.com/hw">
+				<acme:Blink><hw:Led>on</hw:Led></acme:Blink>
+			</tt:Extension>`,
+			value:   &envelope{},
+			element: func(v interface{}) xsd.AnyElement { return v.(*envelope).Body.Configuration.Extension },
+		},
+		{
+			name: "prefix declared in the content",
+			xml: `<tt:Configuration xmlns:tt="http://www.onvif.org/ver10/schema">
+				<tt:Extension><acme:Blink xmlns:acme="http://example.com/acme"><acme:Rate>2</acme:Rate></acme:Blink></tt:Extension>
+			</tt:Configuration>`,
+			extension: `<tt:Extension xmlns:tt="http://www.onvif.org/ver10/schema">
+				<acme:Blink xmlns:acme="http://example.com/acme"><acme:Rate>2</acme:Rate></acme:Blink>
+			</tt:Extension>`,
+			value:   &extension{},
+			element: func(v interface{}) xsd.AnyElement { return v.(*extension).Extension },
+		},
+		{
+			name: "attributes",
+			xml: `<tt:Configuration xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:acme="http://example.com/acme">
+				<tt:Extension acme:mode="fast" plain="1" xml:lang="en"><acme:Blink acme:rate="2" enabled="true"/></tt:Extension>
+			</tt:Configuration>`,
+			extension: `<tt:Extension xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:acme="http://example.com/acme" acme:mode="fast" plain="1" xml:lang="en">
+				<acme:Blink acme:rate="2" enabled="true"/>
+			</tt:Extension>`,
+			value:   &extension{},
+			element: func(v interface{}) xsd.AnyElement { return v.(*extension).Extension },
+		},
+		{
+			name: "default namespace of the ancestors",
+			xml: `<Configuration xmlns="http://www.onvif.org/ver10/schema">
+				<Extension><Blink>true</Blink></Extension>
+			</Configuration>`,
+			extension: `<Extension xmlns="http://www.onvif.org/ver10/schema"><Blink>true</Blink></Extension>`,
+			value:     &extension{},
+			element:   func(v interface{}) xsd.AnyElement { return v.(*extension).Extension },
+		},
+		{
+			name: "default namespace of the content",
+			xml: `<tt:Configuration xmlns:tt="http://www.onvif.org/ver10/schema" xmlns="http://example.com/acme">
+				<tt:Extension><Blink><Rate>2</Rate></Blink></tt:Extension>
+			</tt:Configuration>`,
+			extension: `<tt:Extension xmlns:tt="http://www.onvif.org/ver10/schema">
+				<Blink xmlns="http://example.com/acme"><Rate>2</Rate></Blink>
+			</tt:Extension>`,
+			value:   &extension{},
+			element: func(v interface{}) xsd.AnyElement { return v.(*extension).Extension },
+		},
+		{
+			name: "vendor elements of the any field",
+			xml: `<tt:Configuration xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:acme="http://example.com/acme">
+				<tt:Name>Main</tt:Name>
+				<acme:SmartCodec acme:level="3"><acme:Mode>on</acme:Mode></acme:SmartCodec>
+			</tt:Configuration>`,
+			extension: `<acme:SmartCodec xmlns:acme="http://example.com/acme" acme:level="3"><acme:Mode>on</acme:Mode></acme:SmartCodec>`,
+			value:     &vendor{},
+			element:   func(v interface{}) xsd.AnyElement { return v.(*vendor).Any[0] },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := reflect.New(reflect.TypeOf(tt.value).Elem()).Interface()
+			if err := xml.Unmarshal([]byte(tt.xml), v); err != nil {
+				t.Fatal(err)
+			}
+			data, err := xml.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the element is decoded again without the ancestors of the received document
+			ext, err := xml.Marshal(tt.element(v))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := string(ext)
+			if want := resolved(t, tt.extension); !reflect.DeepEqual(resolved(t, got), want) {
+				t.Errorf("extension:\n%s\nwant:\n%s", got, tt.extension)
+			}
+
+			again := reflect.New(reflect.TypeOf(tt.value).Elem()).Interface()
+			if err := xml.Unmarshal(data, again); err != nil {
+				t.Fatalf("%v\n%s", err, data)
+			}
+			dataAgain, err := xml.Marshal(again)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(resolved(t, string(dataAgain)), resolved(t, tt.xml)) {
+				t.Errorf("decoded again:\n%s\nwant:\n%s", dataAgain, tt.xml)
+			}
+		})
+	}
+}
+
+func TestAnyElementZero(t *testing.T) {
+	var v extension
+	data, err := xml.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `<Configuration xmlns="http://www.onvif.org/ver10/schema"></Configuration>`; string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	if err := xml.Unmarshal([]byte(`<Configuration xmlns="http://www.onvif.org/ver10/schema"><Extension/></Configuration>`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Extension.IsZero() {
+		t.Error("an empty element is decoded as absent")
+	}
+}
+
+func TestAnyElementUnboundPrefix(t *testing.T) {
+	// the devices which forget the declaration get back the prefix as it was
+	var v extension
+	doc := `<Configuration xmlns="http://www.onvif.org/ver10/schema"><Extension><acme:Blink>true</acme:Blink></Extension></Configuration>`
+	if err := xml.Unmarshal([]byte(doc), &v); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := v.Extension.Namespaces["acme"]; ok {
+		t.Errorf("unbound prefix declared: %v", v.Extension.Namespaces)
+	}
+	data, err := xml.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "<acme:Blink>true</acme:Blink>") {
+		t.Errorf("content changed: %s", data)
+	}
+}
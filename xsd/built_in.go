@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -205,6 +206,66 @@ func (tp Duration) NewDateTime(years, months, days, hours, minutes, seconds stri
 	return Duration(i.ISO8601Duration())
 }
 
+var durationRegexp = regexp.MustCompile(`^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+/*
+	Construct an instance of xsd duration type from time.Duration, ex: PT1M30.5S
+*/
+func NewDuration(d time.Duration) Duration {
+	var res strings.Builder
+	if d < 0 {
+		res.WriteString("-")
+		d = -d
+	}
+	res.WriteString("PT")
+
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+	d -= minutes * time.Minute
+
+	if hours > 0 {
+		res.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+	}
+	if minutes > 0 {
+		res.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+	}
+	if d > 0 || hours+minutes == 0 {
+		res.WriteString(strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+	}
+
+	return Duration(res.String())
+}
+
+/*
+	Duration convert xsd duration to time.Duration.
+	Years and months are approximated as 365 and 30 days.
+*/
+func (tp Duration) Duration() (time.Duration, error) {
+	match := durationRegexp.FindStringSubmatch(strings.TrimSpace(string(tp)))
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration %q", string(tp))
+	}
+
+	units := []time.Duration{365 * 24 * time.Hour, 30 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(match[i+2], 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(v * float64(unit))
+	}
+
+	if match[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
 /*
 	DateTime values may be viewed as objects with integer-valued year, month, day, hour
 	and minute properties, a decimal-valued second property, and a boolean timezoned property.
@@ -235,7 +296,29 @@ type DateTime AnySimpleType
 	Construct an instance of xsd dateTime type
 */
 func (tp DateTime) NewDateTime(time time.Time) DateTime {
-	return DateTime(time.Format("2002-10-10T12:00:00-05:00"))
+	return NewDateTime(time)
+}
+
+/*
+	NewDateTime construct an instance of xsd dateTime type in UTC, ex: 2021-03-01T12:00:00Z
+*/
+func NewDateTime(t time.Time) DateTime {
+	return DateTime(t.UTC().Format(time.RFC3339Nano))
+}
+
+/*
+	Time convert xsd dateTime to time.Time, dateTime without timezone is considered UTC
+*/
+func (tp DateTime) Time() (time.Time, error) {
+	value := strings.TrimSpace(string(tp))
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		t, err = time.ParseInLocation("2006-01-02T15:04:05.999999999", value, time.UTC)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid dateTime %q", value)
+	}
+	return t, nil
 }
 
 /*
@@ -1,6 +1,10 @@
 package onvif
 
 import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
 	"github.com/neirolis/onvif-go/xsd"
 )
 
@@ -10,7 +14,6 @@ import (
 //TODO: type <typeName> struct {Any string} convert to type <typeName> AnyType
 //TODO: process restrictions
 
-//todo посмотреть все Extensions (Any string)
 //todo что делать с xs:any = Any
 //todo IntList и ему подобные. Проверить нужен ли слайс. Изменить на slice
 //todo посмотреть можно ли заменить StreamType и ему подобные типы на вмтроенные типы
@@ -22,6 +25,10 @@ import (
 
 //todo Add in buit in
 
+// Namespace of the schema types (tt), the fields are tagged with it so the types decode the responses
+// and are encoded with the onvif prefix in the requests, see networking.Xlmns
+const Namespace = "http://www.onvif.org/ver10/schema"
+
 type ContentType string // minLength value="3"
 type DNSName xsd.Token
 
@@ -41,38 +48,38 @@ type IntRectangle struct {
 }
 
 type IntRectangleRange struct {
-	XRange      IntRange
-	YRange      IntRange
-	WidthRange  IntRange
-	HeightRange IntRange
+	XRange      IntRange `xml:"http://www.onvif.org/ver10/schema XRange"`
+	YRange      IntRange `xml:"http://www.onvif.org/ver10/schema YRange"`
+	WidthRange  IntRange `xml:"http://www.onvif.org/ver10/schema WidthRange"`
+	HeightRange IntRange `xml:"http://www.onvif.org/ver10/schema HeightRange"`
 }
 
 type IntRange struct {
-	Min int
-	Max int
+	Min int `xml:"http://www.onvif.org/ver10/schema Min"`
+	Max int `xml:"http://www.onvif.org/ver10/schema Max"`
 }
 
 type FloatRange struct {
-	Min float64 `xml:"Min"`
-	Max float64 `xml:"Max"`
+	Min float64 `xml:"http://www.onvif.org/ver10/schema Min"`
+	Max float64 `xml:"http://www.onvif.org/ver10/schema Max"`
 }
 
 type OSDConfiguration struct {
 	DeviceEntity                  `xml:"token,attr"`
-	VideoSourceConfigurationToken OSDReference              `xml:"onvif:VideoSourceConfigurationToken"`
-	Type                          OSDType                   `xml:"onvif:Type"`
-	Position                      OSDPosConfiguration       `xml:"onvif:Position"`
-	TextString                    OSDTextConfiguration      `xml:"onvif:TextString"`
-	Image                         OSDImgConfiguration       `xml:"onvif:Image"`
-	Extension                     OSDConfigurationExtension `xml:"onvif:Extension"`
+	VideoSourceConfigurationToken OSDReference              `xml:"http://www.onvif.org/ver10/schema VideoSourceConfigurationToken"`
+	Type                          OSDType                   `xml:"http://www.onvif.org/ver10/schema Type"`
+	Position                      OSDPosConfiguration       `xml:"http://www.onvif.org/ver10/schema Position"`
+	TextString                    *OSDTextConfiguration     `xml:"http://www.onvif.org/ver10/schema TextString,omitempty"`
+	Image                         *OSDImgConfiguration      `xml:"http://www.onvif.org/ver10/schema Image,omitempty"`
+	Extension                     OSDConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type OSDType xsd.String
 
 type OSDPosConfiguration struct {
-	Type      string                       `xml:"onvif:Type"`
-	Pos       Vector                       `xml:"onvif:Pos"`
-	Extension OSDPosConfigurationExtension `xml:"onvif:Extension"`
+	Type      string                       `xml:"http://www.onvif.org/ver10/schema Type"`
+	Pos       *Vector                      `xml:"http://www.onvif.org/ver10/schema Pos,omitempty"`
+	Extension OSDPosConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type Vector struct {
@@ -80,99 +87,104 @@ type Vector struct {
 	Y float64 `xml:"y,attr"`
 }
 
-type OSDPosConfigurationExtension xsd.AnyType
+type Polygon struct {
+	Point []Vector `xml:"http://www.onvif.org/ver10/schema Point"`
+}
+
+// Deprecated: use Polygon, it decodes the responses too.
+type PolygonResponse = Polygon
+
+type OSDPosConfigurationExtension = xsd.AnyElement
 
 type OSDReference ReferenceToken
 
 type OSDTextConfiguration struct {
-	IsPersistentText xsd.Boolean `xml:"IsPersistentText,attr"`
+	IsPersistentText *xsd.Boolean `xml:"IsPersistentText,attr,omitempty"`
 
-	Type            xsd.String                    `xml:"onvif:Type"`
-	DateFormat      xsd.String                    `xml:"onvif:DateFormat"`
-	TimeFormat      xsd.String                    `xml:"onvif:TimeFormat"`
-	FontSize        xsd.Int                       `xml:"onvif:FontSize"`
-	FontColor       OSDColor                      `xml:"onvif:FontColor"`
-	BackgroundColor OSDColor                      `xml:"onvif:BackgroundColor"`
-	PlainText       xsd.String                    `xml:"onvif:PlainText"`
-	Extension       OSDTextConfigurationExtension `xml:"onvif:Extension"`
+	Type            xsd.String                    `xml:"http://www.onvif.org/ver10/schema Type"`
+	DateFormat      xsd.String                    `xml:"http://www.onvif.org/ver10/schema DateFormat,omitempty"`
+	TimeFormat      xsd.String                    `xml:"http://www.onvif.org/ver10/schema TimeFormat,omitempty"`
+	FontSize        *xsd.Int                      `xml:"http://www.onvif.org/ver10/schema FontSize,omitempty"`
+	FontColor       *OSDColor                     `xml:"http://www.onvif.org/ver10/schema FontColor,omitempty"`
+	BackgroundColor *OSDColor                     `xml:"http://www.onvif.org/ver10/schema BackgroundColor,omitempty"`
+	PlainText       xsd.String                    `xml:"http://www.onvif.org/ver10/schema PlainText,omitempty"`
+	Extension       OSDTextConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type OSDColor struct {
-	Transparent int `xml:"Transparent,attr"`
+	Transparent *int `xml:"Transparent,attr,omitempty"`
 
-	Color Color `xml:"onvif:Color"`
+	Color Color `xml:"http://www.onvif.org/ver10/schema Color"`
 }
 
 type Color struct {
 	X          float64    `xml:"X,attr"`
 	Y          float64    `xml:"Y,attr"`
 	Z          float64    `xml:"Z,attr"`
-	Colorspace xsd.AnyURI `xml:"Colorspace,attr"`
+	Colorspace xsd.AnyURI `xml:"Colorspace,attr,omitempty"`
 }
 
-type OSDTextConfigurationExtension xsd.AnyType
+type OSDTextConfigurationExtension = xsd.AnyElement
 
 type OSDImgConfiguration struct {
-	ImgPath   xsd.AnyURI                   `xml:"onvif:ImgPath"`
-	Extension OSDImgConfigurationExtension `xml:"onvif:Extension"`
+	ImgPath   xsd.AnyURI                   `xml:"http://www.onvif.org/ver10/schema ImgPath"`
+	Extension OSDImgConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type OSDImgConfigurationExtension xsd.AnyType
+type OSDImgConfigurationExtension = xsd.AnyElement
 
-type OSDConfigurationExtension xsd.AnyType
+type OSDConfigurationExtension = xsd.AnyElement
 
 type VideoSource struct {
 	DeviceEntity
-	Framerate  float64
-	Resolution VideoResolution
-	Imaging    ImagingSettings
-	Extension  VideoSourceExtension
+	Framerate  float64              `xml:"http://www.onvif.org/ver10/schema Framerate"`
+	Resolution VideoResolution      `xml:"http://www.onvif.org/ver10/schema Resolution"`
+	Imaging    ImagingSettings      `xml:"http://www.onvif.org/ver10/schema Imaging"`
+	Extension  VideoSourceExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type VideoResolution struct {
-	Width  xsd.Int `xml:"onvif:Width"`
-	Height xsd.Int `xml:"onvif:Height"`
+	Width  xsd.Int `xml:"http://www.onvif.org/ver10/schema Width"`
+	Height xsd.Int `xml:"http://www.onvif.org/ver10/schema Height"`
 }
 
-type VideoResolutionResponse struct {
-	Width  xsd.Int `xml:"Width"`
-	Height xsd.Int `xml:"Height"`
-}
+// Deprecated: use VideoResolution, it decodes the responses too.
+type VideoResolutionResponse = VideoResolution
 
 type ImagingSettings struct {
-	BacklightCompensation BacklightCompensation
-	Brightness            float64
-	ColorSaturation       float64
-	Contrast              float64
-	Exposure              Exposure
-	Focus                 FocusConfiguration
-	IrCutFilter           IrCutFilterMode
-	Sharpness             float64
-	WideDynamicRange      WideDynamicRange
-	WhiteBalance          WhiteBalance
-	Extension             ImagingSettingsExtension
+	BacklightCompensation BacklightCompensation    `xml:"http://www.onvif.org/ver10/schema BacklightCompensation"`
+	Brightness            float64                  `xml:"http://www.onvif.org/ver10/schema Brightness"`
+	ColorSaturation       float64                  `xml:"http://www.onvif.org/ver10/schema ColorSaturation"`
+	Contrast              float64                  `xml:"http://www.onvif.org/ver10/schema Contrast"`
+	Exposure              Exposure                 `xml:"http://www.onvif.org/ver10/schema Exposure"`
+	Focus                 FocusConfiguration       `xml:"http://www.onvif.org/ver10/schema Focus"`
+	IrCutFilter           IrCutFilterMode          `xml:"http://www.onvif.org/ver10/schema IrCutFilter"`
+	Sharpness             float64                  `xml:"http://www.onvif.org/ver10/schema Sharpness"`
+	WideDynamicRange      WideDynamicRange         `xml:"http://www.onvif.org/ver10/schema WideDynamicRange"`
+	WhiteBalance          WhiteBalance             `xml:"http://www.onvif.org/ver10/schema WhiteBalance"`
+	Extension             ImagingSettingsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type BacklightCompensation struct {
-	Mode  BacklightCompensationMode
-	Level float64
+	Mode  BacklightCompensationMode `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Level float64                   `xml:"http://www.onvif.org/ver10/schema Level"`
 }
 
 type BacklightCompensationMode xsd.String
 
 type Exposure struct {
-	Mode            ExposureMode
-	Priority        ExposurePriority
-	Window          Rectangle
-	MinExposureTime float64
-	MaxExposureTime float64
-	MinGain         float64
-	MaxGain         float64
-	MinIris         float64
-	MaxIris         float64
-	ExposureTime    float64
-	Gain            float64
-	Iris            float64
+	Mode            ExposureMode     `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Priority        ExposurePriority `xml:"http://www.onvif.org/ver10/schema Priority"`
+	Window          Rectangle        `xml:"http://www.onvif.org/ver10/schema Window"`
+	MinExposureTime float64          `xml:"http://www.onvif.org/ver10/schema MinExposureTime"`
+	MaxExposureTime float64          `xml:"http://www.onvif.org/ver10/schema MaxExposureTime"`
+	MinGain         float64          `xml:"http://www.onvif.org/ver10/schema MinGain"`
+	MaxGain         float64          `xml:"http://www.onvif.org/ver10/schema MaxGain"`
+	MinIris         float64          `xml:"http://www.onvif.org/ver10/schema MinIris"`
+	MaxIris         float64          `xml:"http://www.onvif.org/ver10/schema MaxIris"`
+	ExposureTime    float64          `xml:"http://www.onvif.org/ver10/schema ExposureTime"`
+	Gain            float64          `xml:"http://www.onvif.org/ver10/schema Gain"`
+	Iris            float64          `xml:"http://www.onvif.org/ver10/schema Iris"`
 }
 
 type ExposureMode xsd.String
@@ -187,10 +199,10 @@ type Rectangle struct {
 }
 
 type FocusConfiguration struct {
-	AutoFocusMode AutoFocusMode
-	DefaultSpeed  float64
-	NearLimit     float64
-	FarLimit      float64
+	AutoFocusMode AutoFocusMode `xml:"http://www.onvif.org/ver10/schema AutoFocusMode"`
+	DefaultSpeed  float64       `xml:"http://www.onvif.org/ver10/schema DefaultSpeed"`
+	NearLimit     float64       `xml:"http://www.onvif.org/ver10/schema NearLimit"`
+	FarLimit      float64       `xml:"http://www.onvif.org/ver10/schema FarLimit"`
 }
 
 type AutoFocusMode xsd.String
@@ -198,148 +210,151 @@ type AutoFocusMode xsd.String
 type IrCutFilterMode xsd.String
 
 type WideDynamicRange struct {
-	Mode  WideDynamicMode `xml:"onvif:Mode"`
-	Level float64         `xml:"onvif:Level"`
+	Mode  WideDynamicMode `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Level float64         `xml:"http://www.onvif.org/ver10/schema Level"`
 }
 
 type WideDynamicMode xsd.String
 
 type WhiteBalance struct {
-	Mode   WhiteBalanceMode
-	CrGain float64
-	CbGain float64
+	Mode   WhiteBalanceMode `xml:"http://www.onvif.org/ver10/schema Mode"`
+	CrGain float64          `xml:"http://www.onvif.org/ver10/schema CrGain"`
+	CbGain float64          `xml:"http://www.onvif.org/ver10/schema CbGain"`
 }
 
 type WhiteBalanceMode xsd.String
 
-type ImagingSettingsExtension xsd.AnyType
+type ImagingSettingsExtension = xsd.AnyElement
 
 type VideoSourceExtension struct {
-	Imaging   ImagingSettings20
-	Extension VideoSourceExtension2
+	Any       []xsd.AnyElement      `xml:",any"`
+	Imaging   ImagingSettings20     `xml:"http://www.onvif.org/ver10/schema Imaging"`
+	Extension VideoSourceExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type ImagingSettings20 struct {
-	BacklightCompensation BacklightCompensation20    `xml:"onvif:BacklightCompensation"`
-	Brightness            float64                    `xml:"onvif:Brightness"`
-	ColorSaturation       float64                    `xml:"onvif:ColorSaturation"`
-	Contrast              float64                    `xml:"onvif:Contrast"`
-	Exposure              Exposure20                 `xml:"onvif:Exposure"`
-	Focus                 FocusConfiguration20       `xml:"onvif:Focus"`
-	IrCutFilter           IrCutFilterMode            `xml:"onvif:IrCutFilter"`
-	Sharpness             float64                    `xml:"onvif:Sharpness"`
-	WideDynamicRange      WideDynamicRange20         `xml:"onvif:WideDynamicRange"`
-	WhiteBalance          WhiteBalance20             `xml:"onvif:WhiteBalance"`
-	Extension             ImagingSettingsExtension20 `xml:"onvif:Extension"`
+	BacklightCompensation *BacklightCompensation20    `xml:"http://www.onvif.org/ver10/schema BacklightCompensation,omitempty"`
+	Brightness            *float64                    `xml:"http://www.onvif.org/ver10/schema Brightness,omitempty"`
+	ColorSaturation       *float64                    `xml:"http://www.onvif.org/ver10/schema ColorSaturation,omitempty"`
+	Contrast              *float64                    `xml:"http://www.onvif.org/ver10/schema Contrast,omitempty"`
+	Exposure              *Exposure20                 `xml:"http://www.onvif.org/ver10/schema Exposure,omitempty"`
+	Focus                 *FocusConfiguration20       `xml:"http://www.onvif.org/ver10/schema Focus,omitempty"`
+	IrCutFilter           IrCutFilterMode             `xml:"http://www.onvif.org/ver10/schema IrCutFilter,omitempty"`
+	Sharpness             *float64                    `xml:"http://www.onvif.org/ver10/schema Sharpness,omitempty"`
+	WideDynamicRange      *WideDynamicRange20         `xml:"http://www.onvif.org/ver10/schema WideDynamicRange,omitempty"`
+	WhiteBalance          *WhiteBalance20             `xml:"http://www.onvif.org/ver10/schema WhiteBalance,omitempty"`
+	Extension             *ImagingSettingsExtension20 `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
 type BacklightCompensation20 struct {
-	Mode  BacklightCompensationMode `xml:"onvif:Mode"`
-	Level float64                   `xml:"onvif:Level"`
+	Mode  BacklightCompensationMode `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Level *float64                  `xml:"http://www.onvif.org/ver10/schema Level,omitempty"`
 }
 
 type Exposure20 struct {
-	Mode            ExposureMode     `xml:"onvif:Mode"`
-	Priority        ExposurePriority `xml:"onvif:Priority"`
-	Window          Rectangle        `xml:"onvif:Window"`
-	MinExposureTime float64          `xml:"onvif:MinExposureTime"`
-	MaxExposureTime float64          `xml:"onvif:MaxExposureTime"`
-	MinGain         float64          `xml:"onvif:MinGain"`
-	MaxGain         float64          `xml:"onvif:MaxGain"`
-	MinIris         float64          `xml:"onvif:MinIris"`
-	MaxIris         float64          `xml:"onvif:MaxIris"`
-	ExposureTime    float64          `xml:"onvif:ExposureTime"`
-	Gain            float64          `xml:"onvif:Gain"`
-	Iris            float64          `xml:"onvif:Iris"`
+	Mode            ExposureMode     `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Priority        ExposurePriority `xml:"http://www.onvif.org/ver10/schema Priority,omitempty"`
+	Window          *Rectangle       `xml:"http://www.onvif.org/ver10/schema Window,omitempty"`
+	MinExposureTime *float64         `xml:"http://www.onvif.org/ver10/schema MinExposureTime,omitempty"`
+	MaxExposureTime *float64         `xml:"http://www.onvif.org/ver10/schema MaxExposureTime,omitempty"`
+	MinGain         *float64         `xml:"http://www.onvif.org/ver10/schema MinGain,omitempty"`
+	MaxGain         *float64         `xml:"http://www.onvif.org/ver10/schema MaxGain,omitempty"`
+	MinIris         *float64         `xml:"http://www.onvif.org/ver10/schema MinIris,omitempty"`
+	MaxIris         *float64         `xml:"http://www.onvif.org/ver10/schema MaxIris,omitempty"`
+	ExposureTime    *float64         `xml:"http://www.onvif.org/ver10/schema ExposureTime,omitempty"`
+	Gain            *float64         `xml:"http://www.onvif.org/ver10/schema Gain,omitempty"`
+	Iris            *float64         `xml:"http://www.onvif.org/ver10/schema Iris,omitempty"`
 }
 
 type FocusConfiguration20 struct {
-	AutoFocusMode AutoFocusMode                 `xml:"onvif:AutoFocusMode"`
-	DefaultSpeed  float64                       `xml:"onvif:DefaultSpeed"`
-	NearLimit     float64                       `xml:"onvif:NearLimit"`
-	FarLimit      float64                       `xml:"onvif:FarLimit"`
-	Extension     FocusConfiguration20Extension `xml:"onvif:Extension"`
+	AutoFocusMode AutoFocusMode                 `xml:"http://www.onvif.org/ver10/schema AutoFocusMode"`
+	DefaultSpeed  *float64                      `xml:"http://www.onvif.org/ver10/schema DefaultSpeed,omitempty"`
+	NearLimit     *float64                      `xml:"http://www.onvif.org/ver10/schema NearLimit,omitempty"`
+	FarLimit      *float64                      `xml:"http://www.onvif.org/ver10/schema FarLimit,omitempty"`
+	Extension     FocusConfiguration20Extension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type FocusConfiguration20Extension xsd.AnyType
+type FocusConfiguration20Extension = xsd.AnyElement
 
 type WideDynamicRange20 struct {
-	Mode  WideDynamicMode `xml:"onvif:Mode"`
-	Level float64         `xml:"onvif:Level"`
+	Mode  WideDynamicMode `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Level *float64        `xml:"http://www.onvif.org/ver10/schema Level,omitempty"`
 }
 
 type WhiteBalance20 struct {
-	Mode      WhiteBalanceMode        `xml:"onvif:Mode"`
-	CrGain    float64                 `xml:"onvif:CrGain"`
-	CbGain    float64                 `xml:"onvif:CbGain"`
-	Extension WhiteBalance20Extension `xml:"onvif:Extension"`
+	Mode      WhiteBalanceMode        `xml:"http://www.onvif.org/ver10/schema Mode"`
+	CrGain    *float64                `xml:"http://www.onvif.org/ver10/schema CrGain,omitempty"`
+	CbGain    *float64                `xml:"http://www.onvif.org/ver10/schema CbGain,omitempty"`
+	Extension WhiteBalance20Extension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type WhiteBalance20Extension xsd.AnyType
+type WhiteBalance20Extension = xsd.AnyElement
 
 type ImagingSettingsExtension20 struct {
-	ImageStabilization ImageStabilization          `xml:"onvif:ImageStabilization"`
-	Extension          ImagingSettingsExtension202 `xml:"onvif:Extension"`
+	Any                []xsd.AnyElement             `xml:",any"`
+	ImageStabilization *ImageStabilization          `xml:"http://www.onvif.org/ver10/schema ImageStabilization,omitempty"`
+	Extension          *ImagingSettingsExtension202 `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
 type ImageStabilization struct {
-	Mode      ImageStabilizationMode      `xml:"onvif:Mode"`
-	Level     float64                     `xml:"onvif:Level"`
-	Extension ImageStabilizationExtension `xml:"onvif:Extension"`
+	Mode      ImageStabilizationMode      `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Level     *float64                    `xml:"http://www.onvif.org/ver10/schema Level,omitempty"`
+	Extension ImageStabilizationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type ImageStabilizationMode xsd.String
 
-type ImageStabilizationExtension xsd.AnyType
+type ImageStabilizationExtension = xsd.AnyElement
 
 type ImagingSettingsExtension202 struct {
-	IrCutFilterAutoAdjustment IrCutFilterAutoAdjustment   `xml:"onvif:IrCutFilterAutoAdjustment"`
-	Extension                 ImagingSettingsExtension203 `xml:"onvif:Extension"`
+	IrCutFilterAutoAdjustment []IrCutFilterAutoAdjustment  `xml:"http://www.onvif.org/ver10/schema IrCutFilterAutoAdjustment,omitempty"`
+	Extension                 *ImagingSettingsExtension203 `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
 type IrCutFilterAutoAdjustment struct {
-	BoundaryType   string                             `xml:"onvif:BoundaryType"`
-	BoundaryOffset float64                            `xml:"onvif:BoundaryOffset"`
-	ResponseTime   xsd.Duration                       `xml:"onvif:ResponseTime"`
-	Extension      IrCutFilterAutoAdjustmentExtension `xml:"onvif:Extension"`
+	BoundaryType   string                             `xml:"http://www.onvif.org/ver10/schema BoundaryType"`
+	BoundaryOffset float64                            `xml:"http://www.onvif.org/ver10/schema BoundaryOffset"`
+	ResponseTime   xsd.Duration                       `xml:"http://www.onvif.org/ver10/schema ResponseTime"`
+	Extension      IrCutFilterAutoAdjustmentExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type IrCutFilterAutoAdjustmentExtension xsd.AnyType
+type IrCutFilterAutoAdjustmentExtension = xsd.AnyElement
 
 type ImagingSettingsExtension203 struct {
-	ToneCompensation ToneCompensation            `xml:"onvif:ToneCompensation"`
-	Defogging        Defogging                   `xml:"onvif:Defogging"`
-	NoiseReduction   NoiseReduction              `xml:"onvif:NoiseReduction"`
-	Extension        ImagingSettingsExtension204 `xml:"onvif:Extension"`
+	ToneCompensation ToneCompensation            `xml:"http://www.onvif.org/ver10/schema ToneCompensation"`
+	Defogging        Defogging                   `xml:"http://www.onvif.org/ver10/schema Defogging"`
+	NoiseReduction   NoiseReduction              `xml:"http://www.onvif.org/ver10/schema NoiseReduction"`
+	Extension        ImagingSettingsExtension204 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type ToneCompensation struct {
-	Mode      string                    `xml:"onvif:Mode"`
-	Level     float64                   `xml:"onvif:Level"`
-	Extension ToneCompensationExtension `xml:"onvif:Extension"`
+	Mode      string                    `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Level     float64                   `xml:"http://www.onvif.org/ver10/schema Level"`
+	Extension ToneCompensationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type ToneCompensationExtension xsd.AnyType
+type ToneCompensationExtension = xsd.AnyElement
 
 type Defogging struct {
-	Mode      string
-	Level     float64
-	Extension DefoggingExtension
+	Mode      string             `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Level     float64            `xml:"http://www.onvif.org/ver10/schema Level"`
+	Extension DefoggingExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type DefoggingExtension xsd.AnyType
+type DefoggingExtension = xsd.AnyElement
 
 type NoiseReduction struct {
-	Level float64 `xml:"onvif:Level"`
+	Level float64 `xml:"http://www.onvif.org/ver10/schema Level"`
 }
 
-type ImagingSettingsExtension204 xsd.AnyType
+type ImagingSettingsExtension204 = xsd.AnyElement
 
-type VideoSourceExtension2 xsd.AnyType
+type VideoSourceExtension2 = xsd.AnyElement
 
 type AudioSource struct {
 	DeviceEntity
-	Channels int
+	Channels int              `xml:"http://www.onvif.org/ver10/schema Channels"`
+	Any      []xsd.AnyElement `xml:",any"`
 }
 
 type AudioOutput struct {
@@ -347,110 +362,81 @@ type AudioOutput struct {
 }
 
 type Profile struct {
-	Token                       ReferenceToken `xml:"token,attr"`
-	Fixed                       bool           `xml:"fixed,attr"`
-	Name                        Name
-	VideoSourceConfiguration    VideoSourceConfiguration
-	AudioSourceConfiguration    AudioSourceConfiguration
-	VideoEncoderConfiguration   VideoEncoderConfiguration
-	AudioEncoderConfiguration   AudioEncoderConfiguration
-	VideoAnalyticsConfiguration VideoAnalyticsConfiguration
-	PTZConfiguration            PTZConfiguration
-	MetadataConfiguration       MetadataConfiguration
-	Extension                   ProfileExtension
-}
-
-type ProfileResponse struct {
-	Token                       ReferenceToken `xml:"token,attr"`
-	Fixed                       bool           `xml:"fixed,attr"`
-	Name                        Name
-	VideoSourceConfiguration    VideoSourceConfigurationResponse
-	AudioSourceConfiguration    AudioSourceConfiguration
-	VideoEncoderConfiguration   VideoEncoderConfigurationResponse
-	AudioEncoderConfiguration   AudioEncoderConfiguration
-	VideoAnalyticsConfiguration VideoAnalyticsConfiguration
-	PTZConfiguration            PTZConfiguration
-	MetadataConfiguration       MetadataConfiguration
-	Extension                   ProfileExtension
-}
+	Token                       ReferenceToken              `xml:"token,attr"`
+	Fixed                       bool                        `xml:"fixed,attr"`
+	Name                        Name                        `xml:"http://www.onvif.org/ver10/schema Name"`
+	VideoSourceConfiguration    VideoSourceConfiguration    `xml:"http://www.onvif.org/ver10/schema VideoSourceConfiguration"`
+	AudioSourceConfiguration    AudioSourceConfiguration    `xml:"http://www.onvif.org/ver10/schema AudioSourceConfiguration"`
+	VideoEncoderConfiguration   VideoEncoderConfiguration   `xml:"http://www.onvif.org/ver10/schema VideoEncoderConfiguration"`
+	AudioEncoderConfiguration   AudioEncoderConfiguration   `xml:"http://www.onvif.org/ver10/schema AudioEncoderConfiguration"`
+	VideoAnalyticsConfiguration VideoAnalyticsConfiguration `xml:"http://www.onvif.org/ver10/schema VideoAnalyticsConfiguration"`
+	PTZConfiguration            PTZConfiguration            `xml:"http://www.onvif.org/ver10/schema PTZConfiguration"`
+	MetadataConfiguration       MetadataConfiguration       `xml:"http://www.onvif.org/ver10/schema MetadataConfiguration"`
+	Extension                   ProfileExtension            `xml:"http://www.onvif.org/ver10/schema Extension"`
+}
+
+// Deprecated: use Profile, it decodes the responses too.
+type ProfileResponse = Profile
 
 type VideoSourceConfiguration struct {
 	ConfigurationEntity
-	ViewMode    string                            `xml:"ViewMode,attr"`
-	SourceToken ReferenceToken                    `xml:"onvif:SourceToken"`
-	Bounds      IntRectangle                      `xml:"onvif:Bounds"`
-	Extension   VideoSourceConfigurationExtension `xml:"onvif:Extension"`
+	ViewMode    string                             `xml:"ViewMode,attr,omitempty"`
+	SourceToken ReferenceToken                     `xml:"http://www.onvif.org/ver10/schema SourceToken"`
+	Bounds      IntRectangle                       `xml:"http://www.onvif.org/ver10/schema Bounds"`
+	Any         []xsd.AnyElement                   `xml:",any"`
+	Extension   *VideoSourceConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
-type VideoSourceConfigurationResponse struct {
-	ConfigurationEntityResponse
-	ViewMode    string                                    `xml:"ViewMode,attr"`
-	SourceToken ReferenceToken                            `xml:"SourceToken"`
-	Bounds      IntRectangle                              `xml:"Bounds"`
-	Extension   VideoSourceConfigurationExtensionResponse `xml:"Extension"`
-}
+// Deprecated: use VideoSourceConfiguration, it decodes the responses too.
+type VideoSourceConfigurationResponse = VideoSourceConfiguration
 
 type ConfigurationEntity struct {
 	Token    ReferenceToken `xml:"token,attr"`
-	Name     Name           `xml:"onvif:Name"`
-	UseCount int            `xml:"onvif:UseCount"`
+	Name     Name           `xml:"http://www.onvif.org/ver10/schema Name"`
+	UseCount int            `xml:"http://www.onvif.org/ver10/schema UseCount"`
 }
 
-type ConfigurationEntityResponse struct {
-	Token    ReferenceToken `xml:"token,attr"`
-	Name     Name           `xml:"Name"`
-	UseCount int            `xml:"UseCount"`
-}
+// Deprecated: use ConfigurationEntity, it decodes the responses too.
+type ConfigurationEntityResponse = ConfigurationEntity
 
 type VideoSourceConfigurationExtension struct {
-	Rotate    Rotate                             `xml:"onvif:Rotate"`
-	Extension VideoSourceConfigurationExtension2 `xml:"onvif:Extension"`
+	Rotate    *Rotate                             `xml:"http://www.onvif.org/ver10/schema Rotate,omitempty"`
+	Extension *VideoSourceConfigurationExtension2 `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
-type VideoSourceConfigurationExtensionResponse struct {
-	Rotate    RotateResponse                             `xml:"Rotate"`
-	Extension VideoSourceConfigurationExtension2Response `xml:"Extension"`
-}
+// Deprecated: use VideoSourceConfigurationExtension, it decodes the responses too.
+type VideoSourceConfigurationExtensionResponse = VideoSourceConfigurationExtension
 
 type Rotate struct {
-	Mode      RotateMode      `xml:"onvif:Mode"`
-	Degree    xsd.Int         `xml:"onvif:Degree"`
-	Extension RotateExtension `xml:"onvif:Extension"`
+	Mode      RotateMode      `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Degree    *xsd.Int        `xml:"http://www.onvif.org/ver10/schema Degree,omitempty"`
+	Extension RotateExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type RotateResponse struct {
-	Mode      RotateMode      `xml:"Mode"`
-	Degree    xsd.Int         `xml:"Degree"`
-	Extension RotateExtension `xml:"Extension"`
-}
+// Deprecated: use Rotate, it decodes the responses too.
+type RotateResponse = Rotate
 
 type RotateMode xsd.String
 
-type RotateExtension xsd.AnyType
+type RotateExtension = xsd.AnyElement
 
 type VideoSourceConfigurationExtension2 struct {
-	LensDescription  LensDescription  `xml:"onvif:LensDescription"`
-	SceneOrientation SceneOrientation `xml:"onvif:SceneOrientation"`
+	LensDescription  []LensDescription `xml:"http://www.onvif.org/ver10/schema LensDescription,omitempty"`
+	SceneOrientation *SceneOrientation `xml:"http://www.onvif.org/ver10/schema SceneOrientation,omitempty"`
 }
 
-type VideoSourceConfigurationExtension2Response struct {
-	LensDescription  LensDescriptionResponse  `xml:"LensDescription"`
-	SceneOrientation SceneOrientationResponse `xml:"SceneOrientation"`
-}
+// Deprecated: use VideoSourceConfigurationExtension2, it decodes the responses too.
+type VideoSourceConfigurationExtension2Response = VideoSourceConfigurationExtension2
 
 type LensDescription struct {
-	FocalLength float64        `xml:"FocalLength,attr"`
-	Offset      LensOffset     `xml:"onvif:Offset"`
-	Projection  LensProjection `xml:"onvif:Projection"`
-	XFactor     float64        `xml:"onvif:XFactor"`
+	FocalLength *float64       `xml:"FocalLength,attr,omitempty"`
+	Offset      *LensOffset    `xml:"http://www.onvif.org/ver10/schema Offset,omitempty"`
+	Projection  LensProjection `xml:"http://www.onvif.org/ver10/schema Projection"`
+	XFactor     float64        `xml:"http://www.onvif.org/ver10/schema XFactor"`
 }
 
-type LensDescriptionResponse struct {
-	FocalLength float64                `xml:"FocalLength,attr"`
-	Offset      LensOffset             `xml:"Offset"`
-	Projection  LensProjectionResponse `xml:"Projection"`
-	XFactor     float64                `xml:"XFactor"`
-}
+// Deprecated: use LensDescription, it decodes the responses too.
+type LensDescriptionResponse = LensDescription
 
 type LensOffset struct {
 	X float64 `xml:"x,attr"`
@@ -458,505 +444,553 @@ type LensOffset struct {
 }
 
 type LensProjection struct {
-	Angle         float64 `xml:"onvif:Angle"`
-	Radius        float64 `xml:"onvif:Radius"`
-	Transmittance float64 `xml:"onvif:Transmittance"`
+	Angle         float64 `xml:"http://www.onvif.org/ver10/schema Angle"`
+	Radius        float64 `xml:"http://www.onvif.org/ver10/schema Radius"`
+	Transmittance float64 `xml:"http://www.onvif.org/ver10/schema Transmittance"`
 }
 
-type LensProjectionResponse struct {
-	Angle         float64 `xml:"Angle"`
-	Radius        float64 `xml:"Radius"`
-	Transmittance float64 `xml:"Transmittance"`
-}
+// Deprecated: use LensProjection, it decodes the responses too.
+type LensProjectionResponse = LensProjection
 
 type SceneOrientation struct {
-	Mode        SceneOrientationMode `xml:"onvif:Mode"`
-	Orientation xsd.String           `xml:"onvif:Orientation"`
+	Mode        SceneOrientationMode `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Orientation xsd.String           `xml:"http://www.onvif.org/ver10/schema Orientation,omitempty"`
 }
 
-type SceneOrientationResponse struct {
-	Mode        SceneOrientationMode `xml:"Mode"`
-	Orientation xsd.String           `xml:"Orientation"`
-}
+// Deprecated: use SceneOrientation, it decodes the responses too.
+type SceneOrientationResponse = SceneOrientation
 
 type SceneOrientationMode xsd.String
 
 type AudioSourceConfiguration struct {
 	ConfigurationEntity
-	SourceToken ReferenceToken `xml:"onvif:SourceToken"`
+	SourceToken ReferenceToken   `xml:"http://www.onvif.org/ver10/schema SourceToken"`
+	Any         []xsd.AnyElement `xml:",any"`
 }
 
 type VideoEncoderConfiguration struct {
 	ConfigurationEntity
-	Encoding       VideoEncoding          `xml:"onvif:Encoding"`
-	Resolution     VideoResolution        `xml:"onvif:Resolution"`
-	Quality        float64                `xml:"onvif:Quality"`
-	RateControl    VideoRateControl       `xml:"onvif:RateControl"`
-	MPEG4          Mpeg4Configuration     `xml:"onvif:MPEG4"`
-	H264           H264Configuration      `xml:"onvif:H264"`
-	Multicast      MulticastConfiguration `xml:"onvif:Multicast"`
-	SessionTimeout xsd.Duration           `xml:"onvif:SessionTimeout"`
-}
-
-type VideoEncoderConfigurationResponse struct {
-	ConfigurationEntityResponse
-	Encoding       VideoEncoding                  `xml:"Encoding"`
-	Resolution     VideoResolutionResponse        `xml:"Resolution"`
-	Quality        float64                        `xml:"Quality"`
-	RateControl    VideoRateControlResponse       `xml:"RateControl"`
-	MPEG4          Mpeg4ConfigurationResponse     `xml:"MPEG4"`
-	H264           H264ConfigurationResponse      `xml:"H264"`
-	Multicast      MulticastConfigurationResponse `xml:"Multicast"`
-	SessionTimeout xsd.Duration                   `xml:"SessionTimeout"`
+	Encoding       VideoEncoding          `xml:"http://www.onvif.org/ver10/schema Encoding"`
+	Resolution     VideoResolution        `xml:"http://www.onvif.org/ver10/schema Resolution"`
+	Quality        float64                `xml:"http://www.onvif.org/ver10/schema Quality"`
+	RateControl    *VideoRateControl      `xml:"http://www.onvif.org/ver10/schema RateControl,omitempty"`
+	MPEG4          *Mpeg4Configuration    `xml:"http://www.onvif.org/ver10/schema MPEG4,omitempty"`
+	H264           *H264Configuration     `xml:"http://www.onvif.org/ver10/schema H264,omitempty"`
+	Multicast      MulticastConfiguration `xml:"http://www.onvif.org/ver10/schema Multicast"`
+	SessionTimeout xsd.Duration           `xml:"http://www.onvif.org/ver10/schema SessionTimeout"`
+	Any            []xsd.AnyElement       `xml:",any"`
 }
 
+// Deprecated: use VideoEncoderConfiguration, it decodes the responses too.
+type VideoEncoderConfigurationResponse = VideoEncoderConfiguration
+
 type VideoEncoding xsd.String
 
-type VideoRateControl struct {
-	FrameRateLimit   xsd.Int `xml:"onvif:FrameRateLimit"`
-	EncodingInterval xsd.Int `xml:"onvif:EncodingInterval"`
-	BitrateLimit     xsd.Int `xml:"onvif:BitrateLimit"`
+type VideoEncoder2Configuration struct {
+	ConfigurationEntity
+	GovLength           int                     `xml:"GovLength,attr,omitempty"`
+	Profile             string                  `xml:"Profile,attr,omitempty"`
+	GuaranteedFrameRate bool                    `xml:"GuaranteedFrameRate,attr,omitempty"`
+	Encoding            string                  `xml:"http://www.onvif.org/ver10/schema Encoding"`
+	Resolution          VideoResolution         `xml:"http://www.onvif.org/ver10/schema Resolution"`
+	RateControl         *VideoRateControl2      `xml:"http://www.onvif.org/ver10/schema RateControl,omitempty"`
+	Multicast           *MulticastConfiguration `xml:"http://www.onvif.org/ver10/schema Multicast,omitempty"`
+	Quality             float64                 `xml:"http://www.onvif.org/ver10/schema Quality"`
+	Any                 []xsd.AnyElement        `xml:",any"`
+}
+
+// Deprecated: use VideoEncoder2Configuration, it decodes the responses too.
+type VideoEncoder2ConfigurationResponse = VideoEncoder2Configuration
+
+type VideoRateControl2 struct {
+	ConstantBitRate bool    `xml:"ConstantBitRate,attr,omitempty"`
+	FrameRateLimit  float64 `xml:"http://www.onvif.org/ver10/schema FrameRateLimit"`
+	BitrateLimit    int     `xml:"http://www.onvif.org/ver10/schema BitrateLimit"`
+}
+
+// Deprecated: use VideoRateControl2, it decodes the responses too.
+type VideoRateControl2Response = VideoRateControl2
+
+// VideoEncoder2ConfigurationOptions attribute lists (GovLengthRange, FrameRatesSupported, ProfilesSupported) are space separated
+type VideoEncoder2ConfigurationOptions struct {
+	GovLengthRange               string            `xml:"GovLengthRange,attr"`
+	FrameRatesSupported          string            `xml:"FrameRatesSupported,attr"`
+	ProfilesSupported            string            `xml:"ProfilesSupported,attr"`
+	ConstantBitRateSupported     bool              `xml:"ConstantBitRateSupported,attr"`
+	GuaranteedFrameRateSupported bool              `xml:"GuaranteedFrameRateSupported,attr"`
+	Encoding                     string            `xml:"http://www.onvif.org/ver10/schema Encoding"`
+	QualityRange                 FloatRange        `xml:"http://www.onvif.org/ver10/schema QualityRange"`
+	ResolutionsAvailable         []VideoResolution `xml:"http://www.onvif.org/ver10/schema ResolutionsAvailable"`
+	BitrateRange                 IntRange          `xml:"http://www.onvif.org/ver10/schema BitrateRange"`
 }
-type VideoRateControlResponse struct {
-	FrameRateLimit   xsd.Int `xml:"FrameRateLimit"`
-	EncodingInterval xsd.Int `xml:"EncodingInterval"`
-	BitrateLimit     xsd.Int `xml:"BitrateLimit"`
+
+type VideoRateControl struct {
+	FrameRateLimit   xsd.Int `xml:"http://www.onvif.org/ver10/schema FrameRateLimit"`
+	EncodingInterval xsd.Int `xml:"http://www.onvif.org/ver10/schema EncodingInterval"`
+	BitrateLimit     xsd.Int `xml:"http://www.onvif.org/ver10/schema BitrateLimit"`
 }
 
+// Deprecated: use VideoRateControl, it decodes the responses too.
+type VideoRateControlResponse = VideoRateControl
+
 type Mpeg4Configuration struct {
-	GovLength    xsd.Int      `xml:"onvif:GovLength"`
-	Mpeg4Profile Mpeg4Profile `xml:"onvif:Mpeg4Profile"`
-}
-type Mpeg4ConfigurationResponse struct {
-	GovLength    xsd.Int      `xml:"GovLength"`
-	Mpeg4Profile Mpeg4Profile `xml:"Mpeg4Profile"`
+	GovLength    xsd.Int      `xml:"http://www.onvif.org/ver10/schema GovLength"`
+	Mpeg4Profile Mpeg4Profile `xml:"http://www.onvif.org/ver10/schema Mpeg4Profile"`
 }
 
+// Deprecated: use Mpeg4Configuration, it decodes the responses too.
+type Mpeg4ConfigurationResponse = Mpeg4Configuration
+
 type Mpeg4Profile xsd.String
 
 type H264Configuration struct {
-	GovLength   xsd.Int     `xml:"onvif:GovLength"`
-	H264Profile H264Profile `xml:"onvif:H264Profile"`
+	GovLength   xsd.Int     `xml:"http://www.onvif.org/ver10/schema GovLength"`
+	H264Profile H264Profile `xml:"http://www.onvif.org/ver10/schema H264Profile"`
 }
 
-type H264ConfigurationResponse struct {
-	GovLength   xsd.Int     `xml:"GovLength"`
-	H264Profile H264Profile `xml:"H264Profile"`
-}
+// Deprecated: use H264Configuration, it decodes the responses too.
+type H264ConfigurationResponse = H264Configuration
 
 type H264Profile xsd.String
 
 type MulticastConfiguration struct {
-	Address   IPAddress   `xml:"onvif:Address"`
-	Port      int         `xml:"onvif:Port"`
-	TTL       int         `xml:"onvif:TTL"`
-	AutoStart xsd.Boolean `xml:"onvif:AutoStart"`
+	Address   IPAddress        `xml:"http://www.onvif.org/ver10/schema Address"`
+	Port      int              `xml:"http://www.onvif.org/ver10/schema Port"`
+	TTL       int              `xml:"http://www.onvif.org/ver10/schema TTL"`
+	AutoStart xsd.Boolean      `xml:"http://www.onvif.org/ver10/schema AutoStart"`
+	Any       []xsd.AnyElement `xml:",any"`
 }
 
-type MulticastConfigurationResponse struct {
-	Address   IPAddress   `xml:"Address"`
-	Port      int         `xml:"Port"`
-	TTL       int         `xml:"TTL"`
-	AutoStart xsd.Boolean `xml:"AutoStart"`
-}
+// Deprecated: use MulticastConfiguration, it decodes the responses too.
+type MulticastConfigurationResponse = MulticastConfiguration
 
 type IPAddress struct {
-	Type        IPType      `xml:"onvif:Type"`
-	IPv4Address IPv4Address `xml:"onvif:IPv4Address"`
-	IPv6Address IPv6Address `xml:"onvif:IPv6Address"`
+	Type        IPType      `xml:"http://www.onvif.org/ver10/schema Type"`
+	IPv4Address IPv4Address `xml:"http://www.onvif.org/ver10/schema IPv4Address,omitempty"`
+	IPv6Address IPv6Address `xml:"http://www.onvif.org/ver10/schema IPv6Address,omitempty"`
 }
 
 type IPType xsd.String
 
-//IPv4 address
+// IPv4 address
 type IPv4Address xsd.Token
 
-//IPv6 address
+// IPv6 address
 type IPv6Address xsd.Token
 
 type AudioEncoderConfiguration struct {
 	ConfigurationEntity
-	Encoding       AudioEncoding          `xml:"onvif:Encoding"`
-	Bitrate        int                    `xml:"onvif:Bitrate"`
-	SampleRate     int                    `xml:"onvif:SampleRate"`
-	Multicast      MulticastConfiguration `xml:"onvif:Multicast"`
-	SessionTimeout xsd.Duration           `xml:"onvif:SessionTimeout"`
+	Encoding       AudioEncoding          `xml:"http://www.onvif.org/ver10/schema Encoding"`
+	Bitrate        int                    `xml:"http://www.onvif.org/ver10/schema Bitrate"`
+	SampleRate     int                    `xml:"http://www.onvif.org/ver10/schema SampleRate"`
+	Multicast      MulticastConfiguration `xml:"http://www.onvif.org/ver10/schema Multicast"`
+	SessionTimeout xsd.Duration           `xml:"http://www.onvif.org/ver10/schema SessionTimeout"`
+	Any            []xsd.AnyElement       `xml:",any"`
 }
 
+// Deprecated: use AudioEncoderConfiguration, it decodes the responses too.
+type AudioEncoderConfigurationResponse = AudioEncoderConfiguration
+
 type AudioEncoding xsd.String
 
+type AudioEncoder2Configuration struct {
+	ConfigurationEntity
+	Encoding   string                  `xml:"http://www.onvif.org/ver10/schema Encoding"`
+	Multicast  *MulticastConfiguration `xml:"http://www.onvif.org/ver10/schema Multicast,omitempty"`
+	Bitrate    int                     `xml:"http://www.onvif.org/ver10/schema Bitrate"`
+	SampleRate int                     `xml:"http://www.onvif.org/ver10/schema SampleRate"`
+	Any        []xsd.AnyElement        `xml:",any"`
+}
+
+// Deprecated: use AudioEncoder2Configuration, it decodes the responses too.
+type AudioEncoder2ConfigurationResponse = AudioEncoder2Configuration
+
 type VideoAnalyticsConfiguration struct {
 	ConfigurationEntity
-	AnalyticsEngineConfiguration AnalyticsEngineConfiguration `xml:"onvif:AnalyticsEngineConfiguration"`
-	RuleEngineConfiguration      RuleEngineConfiguration      `xml:"onvif:RuleEngineConfiguration"`
+	AnalyticsEngineConfiguration AnalyticsEngineConfiguration `xml:"http://www.onvif.org/ver10/schema AnalyticsEngineConfiguration"`
+	RuleEngineConfiguration      RuleEngineConfiguration      `xml:"http://www.onvif.org/ver10/schema RuleEngineConfiguration"`
+	Any                          []xsd.AnyElement             `xml:",any"`
 }
 
 type AnalyticsEngineConfiguration struct {
-	AnalyticsModule Config                                `xml:"onvif:AnalyticsModule"`
-	Extension       AnalyticsEngineConfigurationExtension `xml:"onvif:Extension"`
+	AnalyticsModule Config                                `xml:"http://www.onvif.org/ver10/schema AnalyticsModule"`
+	Extension       AnalyticsEngineConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type Config struct {
 	Name       string    `xml:"Name,attr"`
 	Type       xsd.QName `xml:"Type,attr"`
-	Parameters ItemList  `xml:"onvif:Parameters"`
+	Parameters ItemList  `xml:"http://www.onvif.org/ver10/schema Parameters"`
 }
 
 type ItemList struct {
-	SimpleItem  SimpleItem        `xml:"onvif:SimpleItem"`
-	ElementItem ElementItem       `xml:"onvif:ElementItem"`
-	Extension   ItemListExtension `xml:"onvif:Extension"`
+	SimpleItem  SimpleItem        `xml:"http://www.onvif.org/ver10/schema SimpleItem"`
+	ElementItem ElementItem       `xml:"http://www.onvif.org/ver10/schema ElementItem"`
+	Extension   ItemListExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type SimpleItem struct {
-	Name  string            `xml:"onvif:Name,attr"`
-	Value xsd.AnySimpleType `xml:"onvif:Value,attr"`
+	Name  string            `xml:"Name,attr"`
+	Value xsd.AnySimpleType `xml:"Value,attr"`
 }
 
 type ElementItem struct {
 	Name string `xml:"Name,attr"`
 }
 
-type ItemListExtension xsd.AnyType
+type ItemListExtension = xsd.AnyElement
 
-type AnalyticsEngineConfigurationExtension xsd.AnyType
+type AnalyticsEngineConfigurationExtension = xsd.AnyElement
 
 type RuleEngineConfiguration struct {
-	Rule      Config                           `xml:"onvif:Rule"`
-	Extension RuleEngineConfigurationExtension `xml:"onvif:Extension"`
+	Rule      Config                           `xml:"http://www.onvif.org/ver10/schema Rule"`
+	Extension RuleEngineConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type RuleEngineConfigurationExtension xsd.AnyType
+type RuleEngineConfigurationExtension = xsd.AnyElement
 
 type PTZConfiguration struct {
 	ConfigurationEntity
-	MoveRamp                               int                       `xml:"MoveRamp,attr"`
-	PresetRamp                             int                       `xml:"PresetRamp,attr"`
-	PresetTourRamp                         int                       `xml:"PresetTourRamp,attr"`
-	NodeToken                              ReferenceToken            `xml:"NodeToken"`
-	DefaultAbsolutePantTiltPositionSpace   xsd.AnyURI                `xml:"DefaultAbsolutePantTiltPositionSpace"`
-	DefaultAbsoluteZoomPositionSpace       xsd.AnyURI                `xml:"DefaultAbsoluteZoomPositionSpace"`
-	DefaultRelativePanTiltTranslationSpace xsd.AnyURI                `xml:"DefaultRelativePanTiltTranslationSpace"`
-	DefaultRelativeZoomTranslationSpace    xsd.AnyURI                `xml:"DefaultRelativeZoomTranslationSpace"`
-	DefaultContinuousPanTiltVelocitySpace  xsd.AnyURI                `xml:"DefaultContinuousPanTiltVelocitySpace"`
-	DefaultContinuousZoomVelocitySpace     xsd.AnyURI                `xml:"DefaultContinuousZoomVelocitySpace"`
-	DefaultPTZSpeed                        PTZSpeed                  `xml:"DefaultPTZSpeed"`
-	DefaultPTZTimeout                      xsd.Duration              `xml:"DefaultPTZTimeout"`
-	PanTiltLimits                          PanTiltLimits             `xml:"PanTiltLimits"`
-	ZoomLimits                             ZoomLimits                `xml:"ZoomLimits"`
-	Extension                              PTZConfigurationExtension `xml:"Extension"`
-}
-
+	MoveRamp                               *int                       `xml:"MoveRamp,attr,omitempty"`
+	PresetRamp                             *int                       `xml:"PresetRamp,attr,omitempty"`
+	PresetTourRamp                         *int                       `xml:"PresetTourRamp,attr,omitempty"`
+	NodeToken                              ReferenceToken             `xml:"http://www.onvif.org/ver10/schema NodeToken"`
+	DefaultAbsolutePantTiltPositionSpace   xsd.AnyURI                 `xml:"http://www.onvif.org/ver10/schema DefaultAbsolutePantTiltPositionSpace,omitempty"`
+	DefaultAbsoluteZoomPositionSpace       xsd.AnyURI                 `xml:"http://www.onvif.org/ver10/schema DefaultAbsoluteZoomPositionSpace,omitempty"`
+	DefaultRelativePanTiltTranslationSpace xsd.AnyURI                 `xml:"http://www.onvif.org/ver10/schema DefaultRelativePanTiltTranslationSpace,omitempty"`
+	DefaultRelativeZoomTranslationSpace    xsd.AnyURI                 `xml:"http://www.onvif.org/ver10/schema DefaultRelativeZoomTranslationSpace,omitempty"`
+	DefaultContinuousPanTiltVelocitySpace  xsd.AnyURI                 `xml:"http://www.onvif.org/ver10/schema DefaultContinuousPanTiltVelocitySpace,omitempty"`
+	DefaultContinuousZoomVelocitySpace     xsd.AnyURI                 `xml:"http://www.onvif.org/ver10/schema DefaultContinuousZoomVelocitySpace,omitempty"`
+	DefaultPTZSpeed                        *PTZSpeed                  `xml:"http://www.onvif.org/ver10/schema DefaultPTZSpeed,omitempty"`
+	DefaultPTZTimeout                      xsd.Duration               `xml:"http://www.onvif.org/ver10/schema DefaultPTZTimeout,omitempty"`
+	PanTiltLimits                          *PanTiltLimits             `xml:"http://www.onvif.org/ver10/schema PanTiltLimits,omitempty"`
+	ZoomLimits                             *ZoomLimits                `xml:"http://www.onvif.org/ver10/schema ZoomLimits,omitempty"`
+	Extension                              *PTZConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
+}
+
+// PTZSpeed nil PanTilt or Zoom are omitted
 type PTZSpeed struct {
-	PanTilt Vector2D `xml:"onvif:PanTilt"`
-	Zoom    Vector1D `xml:"onvif:Zoom"`
+	PanTilt *Vector2D `xml:"http://www.onvif.org/ver10/schema PanTilt,omitempty"`
+	Zoom    *Vector1D `xml:"http://www.onvif.org/ver10/schema Zoom,omitempty"`
 }
 
 type Vector2D struct {
 	X     float64    `xml:"x,attr"`
 	Y     float64    `xml:"y,attr"`
-	Space xsd.AnyURI `xml:"space,attr"`
+	Space xsd.AnyURI `xml:"space,attr,omitempty"`
 }
 
 type Vector1D struct {
 	X     float64    `xml:"x,attr"`
-	Space xsd.AnyURI `xml:"space,attr"`
+	Space xsd.AnyURI `xml:"space,attr,omitempty"`
 }
 
 type PanTiltLimits struct {
-	Range Space2DDescription `xml:"Range"`
+	Range Space2DDescription `xml:"http://www.onvif.org/ver10/schema Range"`
 }
 
 type Space2DDescription struct {
-	URI    xsd.AnyURI `xml:"URI"`
-	XRange FloatRange `xml:"XRange"`
-	YRange FloatRange `xml:"YRange"`
+	URI    xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema URI"`
+	XRange FloatRange `xml:"http://www.onvif.org/ver10/schema XRange"`
+	YRange FloatRange `xml:"http://www.onvif.org/ver10/schema YRange"`
 }
 
 type ZoomLimits struct {
-	Range Space1DDescription `xml:"Range"`
+	Range Space1DDescription `xml:"http://www.onvif.org/ver10/schema Range"`
 }
 
 type Space1DDescription struct {
-	URI    xsd.AnyURI `xml:"URI"`
-	XRange FloatRange `xml:"XRange"`
+	URI    xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema URI"`
+	XRange FloatRange `xml:"http://www.onvif.org/ver10/schema XRange"`
 }
 
 type PTZConfigurationExtension struct {
-	PTControlDirection PTControlDirection         `xml:"onvif:PTControlDirection"`
-	Extension          PTZConfigurationExtension2 `xml:"onvif:Extension"`
+	Any                []xsd.AnyElement           `xml:",any"`
+	PTControlDirection *PTControlDirection        `xml:"http://www.onvif.org/ver10/schema PTControlDirection,omitempty"`
+	Extension          PTZConfigurationExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTControlDirection struct {
-	EFlip     EFlip                       `xml:"onvif:EFlip"`
-	Reverse   Reverse                     `xml:"onvif:Reverse"`
-	Extension PTControlDirectionExtension `xml:"onvif:Extension"`
+	EFlip     *EFlip                      `xml:"http://www.onvif.org/ver10/schema EFlip,omitempty"`
+	Reverse   *Reverse                    `xml:"http://www.onvif.org/ver10/schema Reverse,omitempty"`
+	Extension PTControlDirectionExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type EFlip struct {
-	Mode EFlipMode `xml:"onvif:Mode"`
+	Mode EFlipMode `xml:"http://www.onvif.org/ver10/schema Mode"`
 }
 
 type EFlipMode xsd.String
 
 type Reverse struct {
-	Mode ReverseMode `xml:"onvif:Mode"`
+	Mode ReverseMode `xml:"http://www.onvif.org/ver10/schema Mode"`
 }
 
 type ReverseMode xsd.String
 
-type PTControlDirectionExtension xsd.AnyType
+type PTControlDirectionExtension = xsd.AnyElement
 
-type PTZConfigurationExtension2 xsd.AnyType
+type PTZConfigurationExtension2 = xsd.AnyElement
 
 type MetadataConfiguration struct {
 	ConfigurationEntity
 	CompressionType              string                         `xml:"CompressionType,attr"`
-	PTZStatus                    PTZFilter                      `xml:"onvif:PTZStatus"`
-	Events                       EventSubscription              `xml:"onvif:Events"`
-	Analytics                    xsd.Boolean                    `xml:"onvif:Analytics"`
-	Multicast                    MulticastConfiguration         `xml:"onvif:Multicast"`
-	SessionTimeout               xsd.Duration                   `xml:"onvif:SessionTimeout"`
-	AnalyticsEngineConfiguration AnalyticsEngineConfiguration   `xml:"onvif:AnalyticsEngineConfiguration"`
-	Extension                    MetadataConfigurationExtension `xml:"onvif:Extension"`
+	PTZStatus                    PTZFilter                      `xml:"http://www.onvif.org/ver10/schema PTZStatus"`
+	Events                       EventSubscription              `xml:"http://www.onvif.org/ver10/schema Events"`
+	Analytics                    xsd.Boolean                    `xml:"http://www.onvif.org/ver10/schema Analytics"`
+	Multicast                    MulticastConfiguration         `xml:"http://www.onvif.org/ver10/schema Multicast"`
+	SessionTimeout               xsd.Duration                   `xml:"http://www.onvif.org/ver10/schema SessionTimeout"`
+	Any                          []xsd.AnyElement               `xml:",any"`
+	AnalyticsEngineConfiguration AnalyticsEngineConfiguration   `xml:"http://www.onvif.org/ver10/schema AnalyticsEngineConfiguration"`
+	Extension                    MetadataConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZFilter struct {
-	Status   bool `xml:"onvif:Status"`
-	Position bool `xml:"onvif:Position"`
+	Status   bool `xml:"http://www.onvif.org/ver10/schema Status"`
+	Position bool `xml:"http://www.onvif.org/ver10/schema Position"`
 }
 
 type EventSubscription struct {
-	Filter             FilterType `xml:"onvif:Filter"`
-	SubscriptionPolicy `xml:"onvif:SubscriptionPolicy"`
+	Filter             FilterType `xml:"http://www.onvif.org/ver10/schema Filter"`
+	SubscriptionPolicy `xml:"http://www.onvif.org/ver10/schema SubscriptionPolicy"`
 }
 
 type FilterType xsd.AnyType
 
 type SubscriptionPolicy xsd.AnyType
 
-type MetadataConfigurationExtension xsd.AnyType
+type MetadataConfigurationExtension = xsd.AnyElement
 
 type ProfileExtension struct {
-	AudioOutputConfiguration  AudioOutputConfiguration
-	AudioDecoderConfiguration AudioDecoderConfiguration
-	Extension                 ProfileExtension2
+	Any                       []xsd.AnyElement          `xml:",any"`
+	AudioOutputConfiguration  AudioOutputConfiguration  `xml:"http://www.onvif.org/ver10/schema AudioOutputConfiguration"`
+	AudioDecoderConfiguration AudioDecoderConfiguration `xml:"http://www.onvif.org/ver10/schema AudioDecoderConfiguration"`
+	Extension                 ProfileExtension2         `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type AudioOutputConfiguration struct {
 	ConfigurationEntity
-	OutputToken ReferenceToken `xml:"onvif:OutputToken"`
-	SendPrimacy xsd.AnyURI     `xml:"onvif:SendPrimacy"`
-	OutputLevel int            `xml:"onvif:OutputLevel"`
+	OutputToken ReferenceToken   `xml:"http://www.onvif.org/ver10/schema OutputToken"`
+	SendPrimacy xsd.AnyURI       `xml:"http://www.onvif.org/ver10/schema SendPrimacy"`
+	OutputLevel int              `xml:"http://www.onvif.org/ver10/schema OutputLevel"`
+	Any         []xsd.AnyElement `xml:",any"`
 }
 
 type AudioDecoderConfiguration struct {
 	ConfigurationEntity
+	Any []xsd.AnyElement `xml:",any"`
 }
 
-type ProfileExtension2 xsd.AnyType
+type ProfileExtension2 = xsd.AnyElement
 
 type VideoSourceConfigurationOptions struct {
-	MaximumNumberOfProfiles    int `xml:"MaximumNumberOfProfiles,attr"`
-	BoundsRange                IntRectangleRange
-	VideoSourceTokensAvailable ReferenceToken
-	Extension                  VideoSourceConfigurationOptionsExtension
+	MaximumNumberOfProfiles    int                                      `xml:"MaximumNumberOfProfiles,attr"`
+	BoundsRange                IntRectangleRange                        `xml:"http://www.onvif.org/ver10/schema BoundsRange"`
+	VideoSourceTokensAvailable ReferenceToken                           `xml:"http://www.onvif.org/ver10/schema VideoSourceTokensAvailable"`
+	Extension                  VideoSourceConfigurationOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type VideoSourceConfigurationOptionsExtension struct {
-	Rotate    RotateOptions
-	Extension VideoSourceConfigurationOptionsExtension2
+	Rotate    RotateOptions                             `xml:"http://www.onvif.org/ver10/schema Rotate"`
+	Extension VideoSourceConfigurationOptionsExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type RotateOptions struct {
-	Mode       RotateMode
-	DegreeList IntList
-	Extension  RotateOptionsExtension
+	Mode       RotateMode             `xml:"http://www.onvif.org/ver10/schema Mode"`
+	DegreeList IntList                `xml:"http://www.onvif.org/ver10/schema DegreeList"`
+	Extension  RotateOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type IntList struct {
-	Items []int
+	Items []int `xml:"http://www.onvif.org/ver10/schema Items"`
 }
 
-type RotateOptionsExtension xsd.AnyType
+type RotateOptionsExtension = xsd.AnyElement
 
 type VideoSourceConfigurationOptionsExtension2 struct {
-	SceneOrientationMode SceneOrientationMode
+	SceneOrientationMode SceneOrientationMode `xml:"http://www.onvif.org/ver10/schema SceneOrientationMode"`
 }
 
 type VideoEncoderConfigurationOptions struct {
-	QualityRange IntRange
-	JPEG         JpegOptions
-	MPEG4        Mpeg4Options
-	H264         H264Options
-	Extension    VideoEncoderOptionsExtension
+	QualityRange IntRange                     `xml:"http://www.onvif.org/ver10/schema QualityRange"`
+	JPEG         JpegOptions                  `xml:"http://www.onvif.org/ver10/schema JPEG"`
+	MPEG4        Mpeg4Options                 `xml:"http://www.onvif.org/ver10/schema MPEG4"`
+	H264         H264Options                  `xml:"http://www.onvif.org/ver10/schema H264"`
+	Extension    VideoEncoderOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type JpegOptions struct {
-	ResolutionsAvailable  []VideoResolutionResponse
-	FrameRateRange        IntRange
-	EncodingIntervalRange IntRange
+	ResolutionsAvailable  []VideoResolution `xml:"http://www.onvif.org/ver10/schema ResolutionsAvailable"`
+	FrameRateRange        IntRange          `xml:"http://www.onvif.org/ver10/schema FrameRateRange"`
+	EncodingIntervalRange IntRange          `xml:"http://www.onvif.org/ver10/schema EncodingIntervalRange"`
 }
 
 type Mpeg4Options struct {
-	ResolutionsAvailable   []VideoResolutionResponse
-	GovLengthRange         IntRange
-	FrameRateRange         IntRange
-	EncodingIntervalRange  IntRange
-	Mpeg4ProfilesSupported []Mpeg4Profile
+	ResolutionsAvailable   []VideoResolution `xml:"http://www.onvif.org/ver10/schema ResolutionsAvailable"`
+	GovLengthRange         IntRange          `xml:"http://www.onvif.org/ver10/schema GovLengthRange"`
+	FrameRateRange         IntRange          `xml:"http://www.onvif.org/ver10/schema FrameRateRange"`
+	EncodingIntervalRange  IntRange          `xml:"http://www.onvif.org/ver10/schema EncodingIntervalRange"`
+	Mpeg4ProfilesSupported []Mpeg4Profile    `xml:"http://www.onvif.org/ver10/schema Mpeg4ProfilesSupported"`
 }
 
 type H264Options struct {
-	ResolutionsAvailable  []VideoResolutionResponse
-	GovLengthRange        IntRange
-	FrameRateRange        IntRange
-	EncodingIntervalRange IntRange
-	H264ProfilesSupported []H264Profile
+	ResolutionsAvailable  []VideoResolution `xml:"http://www.onvif.org/ver10/schema ResolutionsAvailable"`
+	GovLengthRange        IntRange          `xml:"http://www.onvif.org/ver10/schema GovLengthRange"`
+	FrameRateRange        IntRange          `xml:"http://www.onvif.org/ver10/schema FrameRateRange"`
+	EncodingIntervalRange IntRange          `xml:"http://www.onvif.org/ver10/schema EncodingIntervalRange"`
+	H264ProfilesSupported []H264Profile     `xml:"http://www.onvif.org/ver10/schema H264ProfilesSupported"`
 }
 
 type VideoEncoderOptionsExtension struct {
-	JPEG      JpegOptions2
-	MPEG4     Mpeg4Options2
-	H264      H264Options2
-	Extension VideoEncoderOptionsExtension2
+	Any       []xsd.AnyElement              `xml:",any"`
+	JPEG      JpegOptions2                  `xml:"http://www.onvif.org/ver10/schema JPEG"`
+	MPEG4     Mpeg4Options2                 `xml:"http://www.onvif.org/ver10/schema MPEG4"`
+	H264      H264Options2                  `xml:"http://www.onvif.org/ver10/schema H264"`
+	Extension VideoEncoderOptionsExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type JpegOptions2 struct {
 	JpegOptions
-	BitrateRange IntRange
+	BitrateRange IntRange `xml:"http://www.onvif.org/ver10/schema BitrateRange"`
 }
 
 type Mpeg4Options2 struct {
 	Mpeg4Options
-	BitrateRange IntRange
+	BitrateRange IntRange `xml:"http://www.onvif.org/ver10/schema BitrateRange"`
 }
 
 type H264Options2 struct {
 	H264Options
-	BitrateRange IntRange
+	BitrateRange IntRange `xml:"http://www.onvif.org/ver10/schema BitrateRange"`
 }
 
-type VideoEncoderOptionsExtension2 xsd.AnyType
+type VideoEncoderOptionsExtension2 = xsd.AnyElement
 
 type AudioSourceConfigurationOptions struct {
-	InputTokensAvailable ReferenceToken
-	Extension            AudioSourceOptionsExtension
+	InputTokensAvailable ReferenceToken              `xml:"http://www.onvif.org/ver10/schema InputTokensAvailable"`
+	Extension            AudioSourceOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type AudioSourceOptionsExtension xsd.AnyType
+type AudioSourceOptionsExtension = xsd.AnyElement
 
 type AudioEncoderConfigurationOptions struct {
-	Options AudioEncoderConfigurationOption
+	Options AudioEncoderConfigurationOption `xml:"http://www.onvif.org/ver10/schema Options"`
 }
 
 type AudioEncoderConfigurationOption struct {
-	Encoding       AudioEncoding
-	BitrateList    IntList
-	SampleRateList IntList
+	Encoding       AudioEncoding `xml:"http://www.onvif.org/ver10/schema Encoding"`
+	BitrateList    IntList       `xml:"http://www.onvif.org/ver10/schema BitrateList"`
+	SampleRateList IntList       `xml:"http://www.onvif.org/ver10/schema SampleRateList"`
 }
 
 type MetadataConfigurationOptions struct {
-	PTZStatusFilterOptions PTZStatusFilterOptions
-	Extension              MetadataConfigurationOptionsExtension
+	PTZStatusFilterOptions PTZStatusFilterOptions                `xml:"http://www.onvif.org/ver10/schema PTZStatusFilterOptions"`
+	Extension              MetadataConfigurationOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZStatusFilterOptions struct {
-	PanTiltStatusSupported   bool
-	ZoomStatusSupported      bool
-	PanTiltPositionSupported bool
-	ZoomPositionSupported    bool
-	Extension                PTZStatusFilterOptionsExtension
+	PanTiltStatusSupported   bool                            `xml:"http://www.onvif.org/ver10/schema PanTiltStatusSupported"`
+	ZoomStatusSupported      bool                            `xml:"http://www.onvif.org/ver10/schema ZoomStatusSupported"`
+	PanTiltPositionSupported bool                            `xml:"http://www.onvif.org/ver10/schema PanTiltPositionSupported"`
+	ZoomPositionSupported    bool                            `xml:"http://www.onvif.org/ver10/schema ZoomPositionSupported"`
+	Extension                PTZStatusFilterOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type PTZStatusFilterOptionsExtension xsd.AnyType
+type PTZStatusFilterOptionsExtension = xsd.AnyElement
 
 type MetadataConfigurationOptionsExtension struct {
-	CompressionType string
-	Extension       MetadataConfigurationOptionsExtension2
+	CompressionType string                                 `xml:"http://www.onvif.org/ver10/schema CompressionType"`
+	Extension       MetadataConfigurationOptionsExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type MetadataConfigurationOptionsExtension2 xsd.AnyType
+type MetadataConfigurationOptionsExtension2 = xsd.AnyElement
 
 type AudioOutputConfigurationOptions struct {
-	OutputTokensAvailable ReferenceToken
-	SendPrimacyOptions    xsd.AnyURI
-	OutputLevelRange      IntRange
+	OutputTokensAvailable ReferenceToken `xml:"http://www.onvif.org/ver10/schema OutputTokensAvailable"`
+	SendPrimacyOptions    xsd.AnyURI     `xml:"http://www.onvif.org/ver10/schema SendPrimacyOptions"`
+	OutputLevelRange      IntRange       `xml:"http://www.onvif.org/ver10/schema OutputLevelRange"`
 }
 
 type AudioDecoderConfigurationOptions struct {
-	AACDecOptions  AACDecOptions
-	G711DecOptions G711DecOptions
-	G726DecOptions G726DecOptions
-	Extension      AudioDecoderConfigurationOptionsExtension
+	AACDecOptions  AACDecOptions                             `xml:"http://www.onvif.org/ver10/schema AACDecOptions"`
+	G711DecOptions G711DecOptions                            `xml:"http://www.onvif.org/ver10/schema G711DecOptions"`
+	G726DecOptions G726DecOptions                            `xml:"http://www.onvif.org/ver10/schema G726DecOptions"`
+	Extension      AudioDecoderConfigurationOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type AACDecOptions struct {
-	Bitrate         IntList
-	SampleRateRange IntList
+	Bitrate         IntList `xml:"http://www.onvif.org/ver10/schema Bitrate"`
+	SampleRateRange IntList `xml:"http://www.onvif.org/ver10/schema SampleRateRange"`
 }
 
 type G711DecOptions struct {
-	Bitrate         IntList
-	SampleRateRange IntList
+	Bitrate         IntList `xml:"http://www.onvif.org/ver10/schema Bitrate"`
+	SampleRateRange IntList `xml:"http://www.onvif.org/ver10/schema SampleRateRange"`
 }
 
 type G726DecOptions struct {
-	Bitrate         IntList
-	SampleRateRange IntList
+	Bitrate         IntList `xml:"http://www.onvif.org/ver10/schema Bitrate"`
+	SampleRateRange IntList `xml:"http://www.onvif.org/ver10/schema SampleRateRange"`
 }
 
-type AudioDecoderConfigurationOptionsExtension xsd.AnyType
+type AudioDecoderConfigurationOptionsExtension = xsd.AnyElement
 
 type StreamSetup struct {
-	Stream    StreamType `xml:"onvif:Stream"`
-	Transport Transport  `xml:"onvif:Transport"`
+	Stream    StreamType `xml:"http://www.onvif.org/ver10/schema Stream"`
+	Transport Transport  `xml:"http://www.onvif.org/ver10/schema Transport"`
 }
 
+// Deprecated: use StreamSetup, it decodes the responses too.
+type StreamSetupResponse = StreamSetup
+
 type StreamType xsd.String
 
 type Transport struct {
-	Protocol TransportProtocol `xml:"onvif:Protocol"`
-	Tunnel   *Transport        `xml:"onvif:Tunnel"`
+	Protocol TransportProtocol `xml:"http://www.onvif.org/ver10/schema Protocol"`
+	Tunnel   *Transport        `xml:"http://www.onvif.org/ver10/schema Tunnel"`
 }
 
-//enum
+// Deprecated: use Transport, it decodes the responses too.
+type TransportResponse = Transport
+
+// enum
 type TransportProtocol xsd.String
 
 type MediaUri struct {
-	Uri                 xsd.AnyURI
-	InvalidAfterConnect bool
-	InvalidAfterReboot  bool
-	Timeout             xsd.Duration
+	Uri                 xsd.AnyURI   `xml:"http://www.onvif.org/ver10/schema Uri"`
+	InvalidAfterConnect bool         `xml:"http://www.onvif.org/ver10/schema InvalidAfterConnect"`
+	InvalidAfterReboot  bool         `xml:"http://www.onvif.org/ver10/schema InvalidAfterReboot"`
+	Timeout             xsd.Duration `xml:"http://www.onvif.org/ver10/schema Timeout"`
 }
 
 type VideoSourceMode struct {
-	Token         ReferenceToken `xml:"token,attr"`
-	Enabled       bool           `xml:"Enabled,attr"`
-	MaxFramerate  float64
-	MaxResolution VideoResolution
-	Encodings     EncodingTypes
-	Reboot        bool
-	Description   Description
-	Extension     VideoSourceModeExtension
+	Token         ReferenceToken           `xml:"token,attr"`
+	Enabled       bool                     `xml:"Enabled,attr"`
+	MaxFramerate  float64                  `xml:"http://www.onvif.org/ver10/schema MaxFramerate"`
+	MaxResolution VideoResolution          `xml:"http://www.onvif.org/ver10/schema MaxResolution"`
+	Encodings     EncodingTypes            `xml:"http://www.onvif.org/ver10/schema Encodings"`
+	Reboot        bool                     `xml:"http://www.onvif.org/ver10/schema Reboot"`
+	Description   Description              `xml:"http://www.onvif.org/ver10/schema Description"`
+	Extension     VideoSourceModeExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type EncodingTypes struct {
-	EncodingTypes []string
+	EncodingTypes []string `xml:"http://www.onvif.org/ver10/schema EncodingTypes"`
 }
 
 type Description struct {
-	Description string
+	Description string `xml:"http://www.onvif.org/ver10/schema Description"`
 }
 
-type VideoSourceModeExtension xsd.AnyType
+type VideoSourceModeExtension = xsd.AnyElement
 
 type OSDConfigurationOptions struct {
-	MaximumNumberOfOSDs MaximumNumberOfOSDs
-	Type                OSDType
-	PositionOption      string
-	TextOption          OSDTextOptions
-	ImageOption         OSDImgOptions
-	Extension           OSDConfigurationOptionsExtension
+	MaximumNumberOfOSDs MaximumNumberOfOSDs              `xml:"http://www.onvif.org/ver10/schema MaximumNumberOfOSDs"`
+	Type                OSDType                          `xml:"http://www.onvif.org/ver10/schema Type"`
+	PositionOption      string                           `xml:"http://www.onvif.org/ver10/schema PositionOption"`
+	TextOption          OSDTextOptions                   `xml:"http://www.onvif.org/ver10/schema TextOption"`
+	ImageOption         OSDImgOptions                    `xml:"http://www.onvif.org/ver10/schema ImageOption"`
+	Extension           OSDConfigurationOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type MaximumNumberOfOSDs struct {
@@ -969,36 +1003,36 @@ type MaximumNumberOfOSDs struct {
 }
 
 type OSDTextOptions struct {
-	Type            string
-	FontSizeRange   IntRange
-	DateFormat      string
-	TimeFormat      string
-	FontColor       OSDColorOptions
-	BackgroundColor OSDColorOptions
-	Extension       OSDTextOptionsExtension
+	Type            string                  `xml:"http://www.onvif.org/ver10/schema Type"`
+	FontSizeRange   IntRange                `xml:"http://www.onvif.org/ver10/schema FontSizeRange"`
+	DateFormat      string                  `xml:"http://www.onvif.org/ver10/schema DateFormat"`
+	TimeFormat      string                  `xml:"http://www.onvif.org/ver10/schema TimeFormat"`
+	FontColor       OSDColorOptions         `xml:"http://www.onvif.org/ver10/schema FontColor"`
+	BackgroundColor OSDColorOptions         `xml:"http://www.onvif.org/ver10/schema BackgroundColor"`
+	Extension       OSDTextOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type OSDColorOptions struct {
-	Color       ColorOptions
-	Transparent IntRange
-	Extension   OSDColorOptionsExtension
+	Color       ColorOptions             `xml:"http://www.onvif.org/ver10/schema Color"`
+	Transparent IntRange                 `xml:"http://www.onvif.org/ver10/schema Transparent"`
+	Extension   OSDColorOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type ColorOptions struct {
-	ColorList       Color
-	ColorspaceRange ColorspaceRange
+	ColorList       Color           `xml:"http://www.onvif.org/ver10/schema ColorList"`
+	ColorspaceRange ColorspaceRange `xml:"http://www.onvif.org/ver10/schema ColorspaceRange"`
 }
 
 type ColorspaceRange struct {
-	X          FloatRange
-	Y          FloatRange
-	Z          FloatRange
-	Colorspace xsd.AnyURI
+	X          FloatRange `xml:"http://www.onvif.org/ver10/schema X"`
+	Y          FloatRange `xml:"http://www.onvif.org/ver10/schema Y"`
+	Z          FloatRange `xml:"http://www.onvif.org/ver10/schema Z"`
+	Colorspace xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema Colorspace"`
 }
 
-type OSDColorOptionsExtension xsd.AnyType
+type OSDColorOptionsExtension = xsd.AnyElement
 
-type OSDTextOptionsExtension xsd.AnyType
+type OSDTextOptionsExtension = xsd.AnyElement
 
 type OSDImgOptions struct {
 	FormatsSupported StringAttrList `xml:"FormatsSupported,attr"`
@@ -1006,131 +1040,170 @@ type OSDImgOptions struct {
 	MaxWidth         int            `xml:"MaxWidth,attr"`
 	MaxHeight        int            `xml:"MaxHeight,attr"`
 
-	ImagePath xsd.AnyURI
-	Extension OSDImgOptionsExtension
+	ImagePath xsd.AnyURI             `xml:"http://www.onvif.org/ver10/schema ImagePath"`
+	Extension OSDImgOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
+// StringAttrList is the space separated list attribute
 type StringAttrList struct {
-	AttrList []string
+	AttrList []string `xml:"http://www.onvif.org/ver10/schema AttrList"`
+}
+
+func (l StringAttrList) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: strings.Join(l.AttrList, " ")}, nil
 }
 
-type OSDImgOptionsExtension xsd.AnyType
+func (l *StringAttrList) UnmarshalXMLAttr(attr xml.Attr) error {
+	l.AttrList = strings.Fields(attr.Value)
+	return nil
+}
 
-type OSDConfigurationOptionsExtension xsd.AnyType
+type OSDImgOptionsExtension = xsd.AnyElement
+
+type OSDConfigurationOptionsExtension = xsd.AnyElement
 
 //PTZ
 
 type PTZNode struct {
 	DeviceEntity
-	FixedHomePosition      xsd.Boolean `xml:"FixedHomePosition,attr"`
-	GeoMove                xsd.Boolean `xml:"GeoMove,attr"`
-	Name                   Name
-	SupportedPTZSpaces     PTZSpaces
-	MaximumNumberOfPresets int
-	HomeSupported          xsd.Boolean
-	AuxiliaryCommands      AuxiliaryData
-	Extension              PTZNodeExtension
+	FixedHomePosition      xsd.Boolean      `xml:"FixedHomePosition,attr"`
+	GeoMove                xsd.Boolean      `xml:"GeoMove,attr"`
+	Name                   Name             `xml:"http://www.onvif.org/ver10/schema Name"`
+	SupportedPTZSpaces     PTZSpaces        `xml:"http://www.onvif.org/ver10/schema SupportedPTZSpaces"`
+	MaximumNumberOfPresets int              `xml:"http://www.onvif.org/ver10/schema MaximumNumberOfPresets"`
+	HomeSupported          xsd.Boolean      `xml:"http://www.onvif.org/ver10/schema HomeSupported"`
+	AuxiliaryCommands      AuxiliaryData    `xml:"http://www.onvif.org/ver10/schema AuxiliaryCommands"`
+	Extension              PTZNodeExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
+// PTZSpaces the node may support several spaces of each kind, the vectors select one by URI
 type PTZSpaces struct {
-	AbsolutePanTiltPositionSpace    Space2DDescription
-	AbsoluteZoomPositionSpace       Space1DDescription
-	RelativePanTiltTranslationSpace Space2DDescription
-	RelativeZoomTranslationSpace    Space1DDescription
-	ContinuousPanTiltVelocitySpace  Space2DDescription
-	ContinuousZoomVelocitySpace     Space1DDescription
-	PanTiltSpeedSpace               Space1DDescription
-	ZoomSpeedSpace                  Space1DDescription
-	Extension                       PTZSpacesExtension
+	AbsolutePanTiltPositionSpace    []Space2DDescription `xml:"http://www.onvif.org/ver10/schema AbsolutePanTiltPositionSpace,omitempty"`
+	AbsoluteZoomPositionSpace       []Space1DDescription `xml:"http://www.onvif.org/ver10/schema AbsoluteZoomPositionSpace,omitempty"`
+	RelativePanTiltTranslationSpace []Space2DDescription `xml:"http://www.onvif.org/ver10/schema RelativePanTiltTranslationSpace,omitempty"`
+	RelativeZoomTranslationSpace    []Space1DDescription `xml:"http://www.onvif.org/ver10/schema RelativeZoomTranslationSpace,omitempty"`
+	ContinuousPanTiltVelocitySpace  []Space2DDescription `xml:"http://www.onvif.org/ver10/schema ContinuousPanTiltVelocitySpace,omitempty"`
+	ContinuousZoomVelocitySpace     []Space1DDescription `xml:"http://www.onvif.org/ver10/schema ContinuousZoomVelocitySpace,omitempty"`
+	PanTiltSpeedSpace               []Space1DDescription `xml:"http://www.onvif.org/ver10/schema PanTiltSpeedSpace,omitempty"`
+	ZoomSpeedSpace                  []Space1DDescription `xml:"http://www.onvif.org/ver10/schema ZoomSpeedSpace,omitempty"`
+	Extension                       PTZSpacesExtension   `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type PTZSpacesExtension xsd.AnyType
+type PTZSpacesExtension = xsd.AnyElement
 
-//TODO: restriction
+// TODO: restriction
 type AuxiliaryData xsd.String
 
 type PTZNodeExtension struct {
-	SupportedPresetTour PTZPresetTourSupported
-	Extension           PTZNodeExtension2
+	Any                 []xsd.AnyElement       `xml:",any"`
+	SupportedPresetTour PTZPresetTourSupported `xml:"http://www.onvif.org/ver10/schema SupportedPresetTour"`
+	Extension           PTZNodeExtension2      `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZPresetTourSupported struct {
-	MaximumNumberOfPresetTours int
-	PTZPresetTourOperation     PTZPresetTourOperation
-	Extension                  PTZPresetTourSupportedExtension
+	MaximumNumberOfPresetTours int                             `xml:"http://www.onvif.org/ver10/schema MaximumNumberOfPresetTours"`
+	PTZPresetTourOperation     PTZPresetTourOperation          `xml:"http://www.onvif.org/ver10/schema PTZPresetTourOperation"`
+	Extension                  PTZPresetTourSupportedExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZPresetTourOperation xsd.String
-type PTZPresetTourSupportedExtension xsd.AnyType
+type PTZPresetTourSupportedExtension = xsd.AnyElement
 
-type PTZNodeExtension2 xsd.AnyType
+type PTZNodeExtension2 = xsd.AnyElement
 
 type PTZConfigurationOptions struct {
-	PTZRamps           IntAttrList `xml:"PTZRamps,attr"`
-	Spaces             PTZSpaces
-	PTZTimeout         DurationRange
-	PTControlDirection PTControlDirectionOptions
-	Extension          PTZConfigurationOptions2
+	PTZRamps           IntAttrList               `xml:"PTZRamps,attr"`
+	Spaces             PTZSpaces                 `xml:"http://www.onvif.org/ver10/schema Spaces"`
+	PTZTimeout         DurationRange             `xml:"http://www.onvif.org/ver10/schema PTZTimeout"`
+	PTControlDirection PTControlDirectionOptions `xml:"http://www.onvif.org/ver10/schema PTControlDirection"`
+	Extension          PTZConfigurationOptions2  `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
+// IntAttrList is the space separated list attribute
 type IntAttrList struct {
-	IntAttrList []int
+	IntAttrList []int `xml:"http://www.onvif.org/ver10/schema IntAttrList"`
+}
+
+func (l IntAttrList) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	items := make([]string, len(l.IntAttrList))
+	for i, item := range l.IntAttrList {
+		items[i] = strconv.Itoa(item)
+	}
+	return xml.Attr{Name: name, Value: strings.Join(items, " ")}, nil
+}
+
+func (l *IntAttrList) UnmarshalXMLAttr(attr xml.Attr) error {
+	fields := strings.Fields(attr.Value)
+	l.IntAttrList = make([]int, 0, len(fields))
+	for _, field := range fields {
+		item, err := strconv.Atoi(field)
+		if err != nil {
+			return err
+		}
+		l.IntAttrList = append(l.IntAttrList, item)
+	}
+	return nil
 }
 
 type DurationRange struct {
-	Min xsd.Duration
-	Max xsd.Duration
+	Min xsd.Duration `xml:"http://www.onvif.org/ver10/schema Min"`
+	Max xsd.Duration `xml:"http://www.onvif.org/ver10/schema Max"`
 }
 
 type PTControlDirectionOptions struct {
-	EFlip     EFlipOptions
-	Reverse   ReverseOptions
-	Extension PTControlDirectionOptionsExtension
+	EFlip     EFlipOptions                       `xml:"http://www.onvif.org/ver10/schema EFlip"`
+	Reverse   ReverseOptions                     `xml:"http://www.onvif.org/ver10/schema Reverse"`
+	Extension PTControlDirectionOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type EFlipOptions struct {
-	Mode      EFlipMode
-	Extension EFlipOptionsExtension
+	Mode      EFlipMode             `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Extension EFlipOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type EFlipOptionsExtension xsd.AnyType
+type EFlipOptionsExtension = xsd.AnyElement
 
 type ReverseOptions struct {
-	Mode      ReverseMode
-	Extension ReverseOptionsExtension
+	Mode      ReverseMode             `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Extension ReverseOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type ReverseOptionsExtension xsd.AnyType
+type ReverseOptionsExtension = xsd.AnyElement
 
-type PTControlDirectionOptionsExtension xsd.AnyType
+type PTControlDirectionOptionsExtension = xsd.AnyElement
 
-type PTZConfigurationOptions2 xsd.AnyType
+type PTZConfigurationOptions2 = xsd.AnyElement
 
 type PTZPreset struct {
 	Token       ReferenceToken `xml:"token,attr"`
-	Name        Name
-	PTZPosition PTZVector
+	Name        Name           `xml:"http://www.onvif.org/ver10/schema Name"`
+	PTZPosition PTZVector      `xml:"http://www.onvif.org/ver10/schema PTZPosition"`
 }
 
+// PTZVector nil PanTilt or Zoom are omitted, so AbsoluteMove doesn't move the other axis
 type PTZVector struct {
-	PanTilt Vector2D `xml:"onvif:PanTilt"`
-	Zoom    Vector1D `xml:"onvif:Zoom"`
+	PanTilt *Vector2D `xml:"http://www.onvif.org/ver10/schema PanTilt,omitempty"`
+	Zoom    *Vector1D `xml:"http://www.onvif.org/ver10/schema Zoom,omitempty"`
 }
 
+// Deprecated: use PTZVector, it decodes the responses too.
+type PTZVectorResponse = PTZVector
+
 type PTZStatus struct {
-	Position   PTZVector
-	MoveStatus PTZMoveStatus
-	Error      string
-	UtcTime    xsd.DateTime
+	Position   PTZVector     `xml:"http://www.onvif.org/ver10/schema Position"`
+	MoveStatus PTZMoveStatus `xml:"http://www.onvif.org/ver10/schema MoveStatus"`
+	Error      string        `xml:"http://www.onvif.org/ver10/schema Error"`
+	UtcTime    xsd.DateTime  `xml:"http://www.onvif.org/ver10/schema UtcTime"`
 }
 
 type PTZMoveStatus struct {
-	PanTilt MoveStatus
-	Zoom    MoveStatus
+	PanTilt MoveStatus `xml:"http://www.onvif.org/ver10/schema PanTilt"`
+	Zoom    MoveStatus `xml:"http://www.onvif.org/ver10/schema Zoom"`
 }
 
+// MoveStatus IDLE, MOVING or UNKNOWN
 type MoveStatus struct {
-	Status string
+	Status string `xml:",chardata"`
 }
 
 type GeoLocation struct {
@@ -1141,111 +1214,111 @@ type GeoLocation struct {
 
 type PresetTour struct {
 	Token             ReferenceToken                 `xml:"token,attr"`
-	Name              Name                           `xml:"onvif:Name"`
-	Status            PTZPresetTourStatus            `xml:"onvif:Status"`
-	AutoStart         xsd.Boolean                    `xml:"onvif:AutoStart"`
-	StartingCondition PTZPresetTourStartingCondition `xml:"onvif:StartingCondition"`
-	TourSpot          PTZPresetTourSpot              `xml:"onvif:TourSpot"`
-	Extension         PTZPresetTourExtension         `xml:"onvif:Extension"`
+	Name              Name                           `xml:"http://www.onvif.org/ver10/schema Name"`
+	Status            PTZPresetTourStatus            `xml:"http://www.onvif.org/ver10/schema Status"`
+	AutoStart         xsd.Boolean                    `xml:"http://www.onvif.org/ver10/schema AutoStart"`
+	StartingCondition PTZPresetTourStartingCondition `xml:"http://www.onvif.org/ver10/schema StartingCondition"`
+	TourSpot          PTZPresetTourSpot              `xml:"http://www.onvif.org/ver10/schema TourSpot"`
+	Extension         PTZPresetTourExtension         `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZPresetTourStatus struct {
-	State           PTZPresetTourState           `xml:"onvif:State"`
-	CurrentTourSpot PTZPresetTourSpot            `xml:"onvif:CurrentTourSpot"`
-	Extension       PTZPresetTourStatusExtension `xml:"onvif:Extension"`
+	State           PTZPresetTourState           `xml:"http://www.onvif.org/ver10/schema State"`
+	CurrentTourSpot PTZPresetTourSpot            `xml:"http://www.onvif.org/ver10/schema CurrentTourSpot"`
+	Extension       PTZPresetTourStatusExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZPresetTourState xsd.String
 
 type PTZPresetTourSpot struct {
-	PresetDetail PTZPresetTourPresetDetail  `xml:"onvif:PresetDetail"`
-	Speed        PTZSpeed                   `xml:"onvif:Speed"`
-	StayTime     xsd.Duration               `xml:"onvif:StayTime"`
-	Extension    PTZPresetTourSpotExtension `xml:"onvif:Extension"`
+	PresetDetail PTZPresetTourPresetDetail  `xml:"http://www.onvif.org/ver10/schema PresetDetail"`
+	Speed        PTZSpeed                   `xml:"http://www.onvif.org/ver10/schema Speed"`
+	StayTime     xsd.Duration               `xml:"http://www.onvif.org/ver10/schema StayTime"`
+	Extension    PTZPresetTourSpotExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZPresetTourPresetDetail struct {
-	PresetToken   ReferenceToken             `xml:"onvif:PresetToken"`
-	Home          xsd.Boolean                `xml:"onvif:Home"`
-	PTZPosition   PTZVector                  `xml:"onvif:PTZPosition"`
-	TypeExtension PTZPresetTourTypeExtension `xml:"onvif:TypeExtension"`
+	PresetToken   ReferenceToken             `xml:"http://www.onvif.org/ver10/schema PresetToken"`
+	Home          xsd.Boolean                `xml:"http://www.onvif.org/ver10/schema Home"`
+	PTZPosition   PTZVector                  `xml:"http://www.onvif.org/ver10/schema PTZPosition"`
+	TypeExtension PTZPresetTourTypeExtension `xml:"http://www.onvif.org/ver10/schema TypeExtension"`
 }
 
-type PTZPresetTourTypeExtension xsd.AnyType
+type PTZPresetTourTypeExtension = xsd.AnyElement
 
-type PTZPresetTourSpotExtension xsd.AnyType
+type PTZPresetTourSpotExtension = xsd.AnyElement
 
-type PTZPresetTourStatusExtension xsd.AnyType
+type PTZPresetTourStatusExtension = xsd.AnyElement
 
 type PTZPresetTourStartingCondition struct {
 	RandomPresetOrder xsd.Boolean                             `xml:"RandomPresetOrder,attr"`
-	RecurringTime     xsd.Int                                 `xml:"onvif:RecurringTime"`
-	RecurringDuration xsd.Duration                            `xml:"onvif:RecurringDuration"`
-	Direction         PTZPresetTourDirection                  `xml:"onvif:Direction"`
-	Extension         PTZPresetTourStartingConditionExtension `xml:"onvif:Extension"`
+	RecurringTime     xsd.Int                                 `xml:"http://www.onvif.org/ver10/schema RecurringTime"`
+	RecurringDuration xsd.Duration                            `xml:"http://www.onvif.org/ver10/schema RecurringDuration"`
+	Direction         PTZPresetTourDirection                  `xml:"http://www.onvif.org/ver10/schema Direction"`
+	Extension         PTZPresetTourStartingConditionExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type PTZPresetTourDirection xsd.String
 
-type PTZPresetTourStartingConditionExtension xsd.AnyType
+type PTZPresetTourStartingConditionExtension = xsd.AnyElement
 
-type PTZPresetTourExtension xsd.AnyType
+type PTZPresetTourExtension = xsd.AnyElement
 
 type PTZPresetTourOptions struct {
-	AutoStart         xsd.Boolean
-	StartingCondition PTZPresetTourStartingConditionOptions
-	TourSpot          PTZPresetTourSpotOptions
+	AutoStart         xsd.Boolean                           `xml:"http://www.onvif.org/ver10/schema AutoStart"`
+	StartingCondition PTZPresetTourStartingConditionOptions `xml:"http://www.onvif.org/ver10/schema StartingCondition"`
+	TourSpot          PTZPresetTourSpotOptions              `xml:"http://www.onvif.org/ver10/schema TourSpot"`
 }
 
 type PTZPresetTourStartingConditionOptions struct {
-	RecurringTime     IntRange
-	RecurringDuration DurationRange
-	Direction         PTZPresetTourDirection
-	Extension         PTZPresetTourStartingConditionOptionsExtension
+	RecurringTime     IntRange                                       `xml:"http://www.onvif.org/ver10/schema RecurringTime"`
+	RecurringDuration DurationRange                                  `xml:"http://www.onvif.org/ver10/schema RecurringDuration"`
+	Direction         PTZPresetTourDirection                         `xml:"http://www.onvif.org/ver10/schema Direction"`
+	Extension         PTZPresetTourStartingConditionOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type PTZPresetTourStartingConditionOptionsExtension xsd.AnyType
+type PTZPresetTourStartingConditionOptionsExtension = xsd.AnyElement
 
 type PTZPresetTourSpotOptions struct {
-	PresetDetail PTZPresetTourPresetDetailOptions
-	StayTime     DurationRange
+	PresetDetail PTZPresetTourPresetDetailOptions `xml:"http://www.onvif.org/ver10/schema PresetDetail"`
+	StayTime     DurationRange                    `xml:"http://www.onvif.org/ver10/schema StayTime"`
 }
 
 type PTZPresetTourPresetDetailOptions struct {
-	PresetToken          ReferenceToken
-	Home                 xsd.Boolean
-	PanTiltPositionSpace Space2DDescription
-	ZoomPositionSpace    Space1DDescription
-	Extension            PTZPresetTourPresetDetailOptionsExtension
+	PresetToken          ReferenceToken                            `xml:"http://www.onvif.org/ver10/schema PresetToken"`
+	Home                 xsd.Boolean                               `xml:"http://www.onvif.org/ver10/schema Home"`
+	PanTiltPositionSpace Space2DDescription                        `xml:"http://www.onvif.org/ver10/schema PanTiltPositionSpace"`
+	ZoomPositionSpace    Space1DDescription                        `xml:"http://www.onvif.org/ver10/schema ZoomPositionSpace"`
+	Extension            PTZPresetTourPresetDetailOptionsExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type PTZPresetTourPresetDetailOptionsExtension xsd.AnyType
+type PTZPresetTourPresetDetailOptionsExtension = xsd.AnyElement
 
 //Device
 
 type OnvifVersion struct {
-	Major int
-	Minor int
+	Major int `xml:"http://www.onvif.org/ver10/schema Major"`
+	Minor int `xml:"http://www.onvif.org/ver10/schema Minor"`
 }
 
 type SetDateTimeType xsd.String
 
 type SystemDateTime struct {
-	DateTimeType    SetDateTimeType
-	DaylightSavings xsd.Boolean
-	TimeZone        TimeZoneResponse
-	UTCDateTime     DateTimeResponse
-	LocalDateTime   DateTimeResponse
-	Extension       SystemDateTimeExtension
+	DateTimeType    SetDateTimeType         `xml:"http://www.onvif.org/ver10/schema DateTimeType"`
+	DaylightSavings xsd.Boolean             `xml:"http://www.onvif.org/ver10/schema DaylightSavings"`
+	TimeZone        TimeZone                `xml:"http://www.onvif.org/ver10/schema TimeZone"`
+	UTCDateTime     DateTime                `xml:"http://www.onvif.org/ver10/schema UTCDateTime"`
+	LocalDateTime   DateTime                `xml:"http://www.onvif.org/ver10/schema LocalDateTime"`
+	Extension       SystemDateTimeExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type SystemDateTimeExtension xsd.AnyType
+type SystemDateTimeExtension = xsd.AnyElement
 
 type FactoryDefaultType xsd.String
 
 type AttachmentData struct {
 	ContentType ContentType `xml:"contentType,attr"`
-	Include     Include     `xml:"inc:Include"`
+	Include     Include     `xml:"http://www.w3.org/2004/08/xop/include Include"`
 }
 
 type Include struct {
@@ -1253,25 +1326,25 @@ type Include struct {
 }
 
 type BackupFile struct {
-	Name string         `xml:"onvif:Name"`
-	Data AttachmentData `xml:"onvif:Data"`
+	Name string         `xml:"http://www.onvif.org/ver10/schema Name"`
+	Data AttachmentData `xml:"http://www.onvif.org/ver10/schema Data"`
 }
 
 type SystemLogType xsd.String
 
 type SystemLog struct {
-	Binary AttachmentData
-	String string
+	Binary AttachmentData `xml:"http://www.onvif.org/ver10/schema Binary"`
+	String string         `xml:"http://www.onvif.org/ver10/schema String"`
 }
 
 type SupportInformation struct {
-	Binary AttachmentData
-	String string
+	Binary AttachmentData `xml:"http://www.onvif.org/ver10/schema Binary"`
+	String string         `xml:"http://www.onvif.org/ver10/schema String"`
 }
 
 type Scope struct {
-	ScopeDef  ScopeDefinition
-	ScopeItem xsd.AnyURI
+	ScopeDef  ScopeDefinition `xml:"http://www.onvif.org/ver10/schema ScopeDef"`
+	ScopeItem xsd.AnyURI      `xml:"http://www.onvif.org/ver10/schema ScopeItem"`
 }
 
 type ScopeDefinition xsd.String
@@ -1279,624 +1352,636 @@ type ScopeDefinition xsd.String
 type DiscoveryMode xsd.String
 
 type NetworkHost struct {
-	Type        NetworkHostType      `xml:"onvif:Type"`
-	IPv4Address IPv4Address          `xml:"onvif:IPv4Address"`
-	IPv6Address IPv6Address          `xml:"onvif:IPv6Address"`
-	DNSname     DNSName              `xml:"onvif:DNSname"`
-	Extension   NetworkHostExtension `xml:"onvif:Extension"`
+	Type        NetworkHostType      `xml:"http://www.onvif.org/ver10/schema Type"`
+	IPv4Address IPv4Address          `xml:"http://www.onvif.org/ver10/schema IPv4Address"`
+	IPv6Address IPv6Address          `xml:"http://www.onvif.org/ver10/schema IPv6Address"`
+	DNSname     DNSName              `xml:"http://www.onvif.org/ver10/schema DNSname"`
+	Extension   NetworkHostExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type NetworkHostType xsd.String
 
-type NetworkHostExtension xsd.String
+type NetworkHostExtension = xsd.AnyElement
 
 type RemoteUser struct {
-	Username           string      `xml:"onvif:Username"`
-	Password           string      `xml:"onvif:Password"`
-	UseDerivedPassword xsd.Boolean `xml:"onvif:UseDerivedPassword"`
+	Username           string      `xml:"http://www.onvif.org/ver10/schema Username"`
+	Password           string      `xml:"http://www.onvif.org/ver10/schema Password"`
+	UseDerivedPassword xsd.Boolean `xml:"http://www.onvif.org/ver10/schema UseDerivedPassword"`
 }
 
 type User struct {
-	Username  string        `xml:"onvif:Username"`
-	Password  string        `xml:"onvif:Password"`
-	UserLevel UserLevel     `xml:"onvif:UserLevel"`
-	Extension UserExtension `xml:"onvif:Extension"`
+	Username  string        `xml:"http://www.onvif.org/ver10/schema Username"`
+	Password  string        `xml:"http://www.onvif.org/ver10/schema Password"`
+	UserLevel UserLevel     `xml:"http://www.onvif.org/ver10/schema UserLevel"`
+	Extension UserExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type UserLevel xsd.String
 
-type UserExtension xsd.String
+type UserExtension = xsd.AnyElement
 
 type CapabilityCategory xsd.String
 
-//Capabilities of device
+// Capabilities of device
 type Capabilities struct {
-	Analytics AnalyticsCapabilities
-	Device    DeviceCapabilities
-	Events    EventCapabilities
-	Imaging   ImagingCapabilities
-	Media     MediaCapabilities
-	PTZ       PTZCapabilities
-	Extension CapabilitiesExtension
+	Analytics AnalyticsCapabilities `xml:"http://www.onvif.org/ver10/schema Analytics"`
+	Device    DeviceCapabilities    `xml:"http://www.onvif.org/ver10/schema Device"`
+	Events    EventCapabilities     `xml:"http://www.onvif.org/ver10/schema Events"`
+	Imaging   ImagingCapabilities   `xml:"http://www.onvif.org/ver10/schema Imaging"`
+	Media     MediaCapabilities     `xml:"http://www.onvif.org/ver10/schema Media"`
+	PTZ       PTZCapabilities       `xml:"http://www.onvif.org/ver10/schema PTZ"`
+	Extension CapabilitiesExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-//AnalyticsCapabilities Check
+// AnalyticsCapabilities Check
 type AnalyticsCapabilities struct {
-	XAddr                  xsd.AnyURI
-	RuleSupport            xsd.Boolean
-	AnalyticsModuleSupport xsd.Boolean
+	XAddr                  xsd.AnyURI  `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	RuleSupport            xsd.Boolean `xml:"http://www.onvif.org/ver10/schema RuleSupport"`
+	AnalyticsModuleSupport xsd.Boolean `xml:"http://www.onvif.org/ver10/schema AnalyticsModuleSupport"`
 }
 
-//DeviceCapabilities Check
+// DeviceCapabilities Check
 type DeviceCapabilities struct {
-	XAddr     xsd.AnyURI
-	Network   NetworkCapabilities
-	System    SystemCapabilities
-	IO        IOCapabilities
-	Security  SecurityCapabilities
-	Extension DeviceCapabilitiesExtension
+	XAddr     xsd.AnyURI                  `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	Network   NetworkCapabilities         `xml:"http://www.onvif.org/ver10/schema Network"`
+	System    SystemCapabilities          `xml:"http://www.onvif.org/ver10/schema System"`
+	IO        IOCapabilities              `xml:"http://www.onvif.org/ver10/schema IO"`
+	Security  SecurityCapabilities        `xml:"http://www.onvif.org/ver10/schema Security"`
+	Extension DeviceCapabilitiesExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-//NetworkCapabilities Check
+// NetworkCapabilities Check
 type NetworkCapabilities struct {
-	IPFilter          xsd.Boolean
-	ZeroConfiguration xsd.Boolean
-	IPVersion6        xsd.Boolean
-	DynDNS            xsd.Boolean
-	Extension         NetworkCapabilitiesExtension
+	IPFilter          xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema IPFilter"`
+	ZeroConfiguration xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema ZeroConfiguration"`
+	IPVersion6        xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema IPVersion6"`
+	DynDNS            xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema DynDNS"`
+	Extension         NetworkCapabilitiesExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-//NetworkCapabilitiesExtension Check
+// NetworkCapabilitiesExtension Check
 type NetworkCapabilitiesExtension struct {
-	Dot11Configuration xsd.Boolean
-	Extension          NetworkCapabilitiesExtension2
+	Any                []xsd.AnyElement              `xml:",any"`
+	Dot11Configuration xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema Dot11Configuration"`
+	Extension          NetworkCapabilitiesExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-//NetworkCapabilitiesExtension2 Extension2
-type NetworkCapabilitiesExtension2 xsd.AnyType
+// NetworkCapabilitiesExtension2 Extension2
+type NetworkCapabilitiesExtension2 = xsd.AnyElement
 
-//SystemCapabilities check
+// SystemCapabilities check
 type SystemCapabilities struct {
-	DiscoveryResolve  xsd.Boolean
-	DiscoveryBye      xsd.Boolean
-	RemoteDiscovery   xsd.Boolean
-	SystemBackup      xsd.Boolean
-	SystemLogging     xsd.Boolean
-	FirmwareUpgrade   xsd.Boolean
-	SupportedVersions OnvifVersion
-	Extension         SystemCapabilitiesExtension
+	DiscoveryResolve  xsd.Boolean                 `xml:"http://www.onvif.org/ver10/schema DiscoveryResolve"`
+	DiscoveryBye      xsd.Boolean                 `xml:"http://www.onvif.org/ver10/schema DiscoveryBye"`
+	RemoteDiscovery   xsd.Boolean                 `xml:"http://www.onvif.org/ver10/schema RemoteDiscovery"`
+	SystemBackup      xsd.Boolean                 `xml:"http://www.onvif.org/ver10/schema SystemBackup"`
+	SystemLogging     xsd.Boolean                 `xml:"http://www.onvif.org/ver10/schema SystemLogging"`
+	FirmwareUpgrade   xsd.Boolean                 `xml:"http://www.onvif.org/ver10/schema FirmwareUpgrade"`
+	SupportedVersions OnvifVersion                `xml:"http://www.onvif.org/ver10/schema SupportedVersions"`
+	Extension         SystemCapabilitiesExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type SystemCapabilitiesExtension struct {
-	HttpFirmwareUpgrade    xsd.Boolean
-	HttpSystemBackup       xsd.Boolean
-	HttpSystemLogging      xsd.Boolean
-	HttpSupportInformation xsd.Boolean
-	Extension              SystemCapabilitiesExtension2
+	Any                    []xsd.AnyElement             `xml:",any"`
+	HttpFirmwareUpgrade    xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema HttpFirmwareUpgrade"`
+	HttpSystemBackup       xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema HttpSystemBackup"`
+	HttpSystemLogging      xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema HttpSystemLogging"`
+	HttpSupportInformation xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema HttpSupportInformation"`
+	Extension              SystemCapabilitiesExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type SystemCapabilitiesExtension2 xsd.AnyType
+type SystemCapabilitiesExtension2 = xsd.AnyElement
 
 type IOCapabilities struct {
-	InputConnectors int
-	RelayOutputs    int
-	Extension       IOCapabilitiesExtension
+	InputConnectors int                     `xml:"http://www.onvif.org/ver10/schema InputConnectors"`
+	RelayOutputs    int                     `xml:"http://www.onvif.org/ver10/schema RelayOutputs"`
+	Extension       IOCapabilitiesExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type IOCapabilitiesExtension struct {
-	Auxiliary         xsd.Boolean
-	AuxiliaryCommands AuxiliaryData
-	Extension         IOCapabilitiesExtension2
+	Any               []xsd.AnyElement         `xml:",any"`
+	Auxiliary         xsd.Boolean              `xml:"http://www.onvif.org/ver10/schema Auxiliary"`
+	AuxiliaryCommands AuxiliaryData            `xml:"http://www.onvif.org/ver10/schema AuxiliaryCommands"`
+	Extension         IOCapabilitiesExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type IOCapabilitiesExtension2 xsd.AnyType
+type IOCapabilitiesExtension2 = xsd.AnyElement
 
 type SecurityCapabilities struct {
-	TLS1_1               xsd.Boolean
-	TLS1_2               xsd.Boolean
-	OnboardKeyGeneration xsd.Boolean
-	AccessPolicyConfig   xsd.Boolean
-	X_509Token           xsd.Boolean
-	SAMLToken            xsd.Boolean
-	KerberosToken        xsd.Boolean
-	RELToken             xsd.Boolean
-	Extension            SecurityCapabilitiesExtension
+	TLS1_1               xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema TLS1_1"`
+	TLS1_2               xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema TLS1_2"`
+	OnboardKeyGeneration xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema OnboardKeyGeneration"`
+	AccessPolicyConfig   xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema AccessPolicyConfig"`
+	X_509Token           xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema X_509Token"`
+	SAMLToken            xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema SAMLToken"`
+	KerberosToken        xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema KerberosToken"`
+	RELToken             xsd.Boolean                   `xml:"http://www.onvif.org/ver10/schema RELToken"`
+	Extension            SecurityCapabilitiesExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type SecurityCapabilitiesExtension struct {
-	TLS1_0    xsd.Boolean
-	Extension SecurityCapabilitiesExtension2
+	TLS1_0    xsd.Boolean                    `xml:"http://www.onvif.org/ver10/schema TLS1_0"`
+	Extension SecurityCapabilitiesExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type SecurityCapabilitiesExtension2 struct {
-	Dot1X              xsd.Boolean
-	SupportedEAPMethod int
-	RemoteUserHandling xsd.Boolean
+	Dot1X              xsd.Boolean `xml:"http://www.onvif.org/ver10/schema Dot1X"`
+	SupportedEAPMethod int         `xml:"http://www.onvif.org/ver10/schema SupportedEAPMethod"`
+	RemoteUserHandling xsd.Boolean `xml:"http://www.onvif.org/ver10/schema RemoteUserHandling"`
 }
 
-type DeviceCapabilitiesExtension xsd.AnyType
+type DeviceCapabilitiesExtension = xsd.AnyElement
 
 type EventCapabilities struct {
-	XAddr                                         xsd.AnyURI
-	WSSubscriptionPolicySupport                   xsd.Boolean
-	WSPullPointSupport                            xsd.Boolean
-	WSPausableSubscriptionManagerInterfaceSupport xsd.Boolean
+	XAddr                                         xsd.AnyURI  `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	WSSubscriptionPolicySupport                   xsd.Boolean `xml:"http://www.onvif.org/ver10/schema WSSubscriptionPolicySupport"`
+	WSPullPointSupport                            xsd.Boolean `xml:"http://www.onvif.org/ver10/schema WSPullPointSupport"`
+	WSPausableSubscriptionManagerInterfaceSupport xsd.Boolean `xml:"http://www.onvif.org/ver10/schema WSPausableSubscriptionManagerInterfaceSupport"`
 }
 
 type ImagingCapabilities struct {
-	XAddr xsd.AnyURI
+	XAddr xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema XAddr"`
 }
 
 type MediaCapabilities struct {
-	XAddr                 xsd.AnyURI
-	StreamingCapabilities RealTimeStreamingCapabilities
-	Extension             MediaCapabilitiesExtension
+	XAddr                 xsd.AnyURI                    `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	StreamingCapabilities RealTimeStreamingCapabilities `xml:"http://www.onvif.org/ver10/schema StreamingCapabilities"`
+	Extension             MediaCapabilitiesExtension    `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type RealTimeStreamingCapabilities struct {
-	RTPMulticast xsd.Boolean
-	RTP_TCP      xsd.Boolean
-	RTP_RTSP_TCP xsd.Boolean
-	Extension    RealTimeStreamingCapabilitiesExtension
+	RTPMulticast xsd.Boolean                            `xml:"http://www.onvif.org/ver10/schema RTPMulticast"`
+	RTP_TCP      xsd.Boolean                            `xml:"http://www.onvif.org/ver10/schema RTP_TCP"`
+	RTP_RTSP_TCP xsd.Boolean                            `xml:"http://www.onvif.org/ver10/schema RTP_RTSP_TCP"`
+	Extension    RealTimeStreamingCapabilitiesExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type RealTimeStreamingCapabilitiesExtension xsd.AnyType
+type RealTimeStreamingCapabilitiesExtension = xsd.AnyElement
 
 type MediaCapabilitiesExtension struct {
-	ProfileCapabilities ProfileCapabilities
+	ProfileCapabilities ProfileCapabilities `xml:"http://www.onvif.org/ver10/schema ProfileCapabilities"`
 }
 
 type ProfileCapabilities struct {
-	MaximumNumberOfProfiles int
+	MaximumNumberOfProfiles int `xml:"http://www.onvif.org/ver10/schema MaximumNumberOfProfiles"`
 }
 
 type PTZCapabilities struct {
-	XAddr xsd.AnyURI
+	XAddr xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema XAddr"`
 }
 
 type CapabilitiesExtension struct {
-	DeviceIO        DeviceIOCapabilities
-	Display         DisplayCapabilities
-	Recording       RecordingCapabilities
-	Search          SearchCapabilities
-	Replay          ReplayCapabilities
-	Receiver        ReceiverCapabilities
-	AnalyticsDevice AnalyticsDeviceCapabilities
-	Extensions      CapabilitiesExtension2
+	Any             []xsd.AnyElement            `xml:",any"`
+	DeviceIO        DeviceIOCapabilities        `xml:"http://www.onvif.org/ver10/schema DeviceIO"`
+	Display         DisplayCapabilities         `xml:"http://www.onvif.org/ver10/schema Display"`
+	Recording       RecordingCapabilities       `xml:"http://www.onvif.org/ver10/schema Recording"`
+	Search          SearchCapabilities          `xml:"http://www.onvif.org/ver10/schema Search"`
+	Replay          ReplayCapabilities          `xml:"http://www.onvif.org/ver10/schema Replay"`
+	Receiver        ReceiverCapabilities        `xml:"http://www.onvif.org/ver10/schema Receiver"`
+	AnalyticsDevice AnalyticsDeviceCapabilities `xml:"http://www.onvif.org/ver10/schema AnalyticsDevice"`
+	Extensions      CapabilitiesExtension2      `xml:"http://www.onvif.org/ver10/schema Extensions"`
 }
 
 type DeviceIOCapabilities struct {
-	XAddr        xsd.AnyURI
-	VideoSources int
-	VideoOutputs int
-	AudioSources int
-	AudioOutputs int
-	RelayOutputs int
+	XAddr        xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	VideoSources int        `xml:"http://www.onvif.org/ver10/schema VideoSources"`
+	VideoOutputs int        `xml:"http://www.onvif.org/ver10/schema VideoOutputs"`
+	AudioSources int        `xml:"http://www.onvif.org/ver10/schema AudioSources"`
+	AudioOutputs int        `xml:"http://www.onvif.org/ver10/schema AudioOutputs"`
+	RelayOutputs int        `xml:"http://www.onvif.org/ver10/schema RelayOutputs"`
 }
 
 type DisplayCapabilities struct {
-	XAddr       xsd.AnyURI
-	FixedLayout xsd.Boolean
+	XAddr       xsd.AnyURI  `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	FixedLayout xsd.Boolean `xml:"http://www.onvif.org/ver10/schema FixedLayout"`
 }
 
 type RecordingCapabilities struct {
-	XAddr              xsd.AnyURI
-	ReceiverSource     xsd.Boolean
-	MediaProfileSource xsd.Boolean
-	DynamicRecordings  xsd.Boolean
-	DynamicTracks      xsd.Boolean
-	MaxStringLength    int
+	XAddr              xsd.AnyURI  `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	ReceiverSource     xsd.Boolean `xml:"http://www.onvif.org/ver10/schema ReceiverSource"`
+	MediaProfileSource xsd.Boolean `xml:"http://www.onvif.org/ver10/schema MediaProfileSource"`
+	DynamicRecordings  xsd.Boolean `xml:"http://www.onvif.org/ver10/schema DynamicRecordings"`
+	DynamicTracks      xsd.Boolean `xml:"http://www.onvif.org/ver10/schema DynamicTracks"`
+	MaxStringLength    int         `xml:"http://www.onvif.org/ver10/schema MaxStringLength"`
 }
 
 type SearchCapabilities struct {
-	XAddr          xsd.AnyURI
-	MetadataSearch xsd.Boolean
+	XAddr          xsd.AnyURI  `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	MetadataSearch xsd.Boolean `xml:"http://www.onvif.org/ver10/schema MetadataSearch"`
 }
 
 type ReplayCapabilities struct {
-	XAddr xsd.AnyURI
+	XAddr xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema XAddr"`
 }
 
 type ReceiverCapabilities struct {
-	XAddr                xsd.AnyURI
-	RTP_Multicast        xsd.Boolean
-	RTP_TCP              xsd.Boolean
-	RTP_RTSP_TCP         xsd.Boolean
-	SupportedReceivers   int
-	MaximumRTSPURILength int
+	XAddr                xsd.AnyURI  `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	RTP_Multicast        xsd.Boolean `xml:"http://www.onvif.org/ver10/schema RTP_Multicast"`
+	RTP_TCP              xsd.Boolean `xml:"http://www.onvif.org/ver10/schema RTP_TCP"`
+	RTP_RTSP_TCP         xsd.Boolean `xml:"http://www.onvif.org/ver10/schema RTP_RTSP_TCP"`
+	SupportedReceivers   int         `xml:"http://www.onvif.org/ver10/schema SupportedReceivers"`
+	MaximumRTSPURILength int         `xml:"http://www.onvif.org/ver10/schema MaximumRTSPURILength"`
 }
 
 type AnalyticsDeviceCapabilities struct {
-	XAddr       xsd.AnyURI
-	RuleSupport xsd.Boolean
-	Extension   AnalyticsDeviceExtension
+	XAddr       xsd.AnyURI               `xml:"http://www.onvif.org/ver10/schema XAddr"`
+	RuleSupport xsd.Boolean              `xml:"http://www.onvif.org/ver10/schema RuleSupport"`
+	Extension   AnalyticsDeviceExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type AnalyticsDeviceExtension xsd.AnyType
+type AnalyticsDeviceExtension = xsd.AnyElement
 
-type CapabilitiesExtension2 xsd.AnyType
+type CapabilitiesExtension2 = xsd.AnyElement
 
 type HostnameInformation struct {
-	FromDHCP  xsd.Boolean
-	Name      xsd.Token
-	Extension HostnameInformationExtension
+	FromDHCP  xsd.Boolean                  `xml:"http://www.onvif.org/ver10/schema FromDHCP"`
+	Name      xsd.Token                    `xml:"http://www.onvif.org/ver10/schema Name"`
+	Extension HostnameInformationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type HostnameInformationExtension xsd.AnyType
+type HostnameInformationExtension = xsd.AnyElement
 
 type DNSInformation struct {
-	FromDHCP     xsd.Boolean
-	SearchDomain xsd.Token
-	DNSFromDHCP  IPAddress
-	DNSManual    IPAddress
-	Extension    DNSInformationExtension
+	FromDHCP     xsd.Boolean             `xml:"http://www.onvif.org/ver10/schema FromDHCP"`
+	SearchDomain xsd.Token               `xml:"http://www.onvif.org/ver10/schema SearchDomain"`
+	DNSFromDHCP  IPAddress               `xml:"http://www.onvif.org/ver10/schema DNSFromDHCP"`
+	DNSManual    IPAddress               `xml:"http://www.onvif.org/ver10/schema DNSManual"`
+	Extension    DNSInformationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type DNSInformationExtension xsd.AnyType
+type DNSInformationExtension = xsd.AnyElement
 
 type NTPInformation struct {
-	FromDHCP    xsd.Boolean
-	NTPFromDHCP NetworkHost
-	NTPManual   NetworkHost
-	Extension   NTPInformationExtension
+	FromDHCP    xsd.Boolean             `xml:"http://www.onvif.org/ver10/schema FromDHCP"`
+	NTPFromDHCP NetworkHost             `xml:"http://www.onvif.org/ver10/schema NTPFromDHCP"`
+	NTPManual   NetworkHost             `xml:"http://www.onvif.org/ver10/schema NTPManual"`
+	Extension   NTPInformationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type NTPInformationExtension xsd.AnyType
+type NTPInformationExtension = xsd.AnyElement
 
 type DynamicDNSInformation struct {
-	Type      DynamicDNSType
-	Name      DNSName
-	TTL       xsd.Duration
-	Extension DynamicDNSInformationExtension
+	Type      DynamicDNSType                 `xml:"http://www.onvif.org/ver10/schema Type"`
+	Name      DNSName                        `xml:"http://www.onvif.org/ver10/schema Name"`
+	TTL       xsd.Duration                   `xml:"http://www.onvif.org/ver10/schema TTL"`
+	Extension DynamicDNSInformationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-//TODO: enumeration
+// TODO: enumeration
 type DynamicDNSType xsd.String
 
-type DynamicDNSInformationExtension xsd.AnyType
+type DynamicDNSInformationExtension = xsd.AnyElement
 
 type NetworkInterface struct {
 	DeviceEntity
-	Enabled   xsd.Boolean
-	Info      NetworkInterfaceInfo
-	Link      NetworkInterfaceLink
-	IPv4      IPv4NetworkInterface
-	IPv6      IPv6NetworkInterface
-	Extension NetworkInterfaceExtension
+	Enabled   xsd.Boolean               `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	Info      NetworkInterfaceInfo      `xml:"http://www.onvif.org/ver10/schema Info"`
+	Link      NetworkInterfaceLink      `xml:"http://www.onvif.org/ver10/schema Link"`
+	IPv4      IPv4NetworkInterface      `xml:"http://www.onvif.org/ver10/schema IPv4"`
+	IPv6      IPv6NetworkInterface      `xml:"http://www.onvif.org/ver10/schema IPv6"`
+	Extension NetworkInterfaceExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type NetworkInterfaceInfo struct {
-	Name      xsd.String
-	HwAddress HwAddress
-	MTU       xsd.Int
+	Name      xsd.String `xml:"http://www.onvif.org/ver10/schema Name"`
+	HwAddress HwAddress  `xml:"http://www.onvif.org/ver10/schema HwAddress"`
+	MTU       xsd.Int    `xml:"http://www.onvif.org/ver10/schema MTU"`
 }
 
 type HwAddress xsd.Token
 
 type NetworkInterfaceLink struct {
-	AdminSettings NetworkInterfaceConnectionSetting
-	OperSettings  NetworkInterfaceConnectionSetting
-	InterfaceType IANA_IfTypes `xml:"IANA-IfTypes"`
+	AdminSettings NetworkInterfaceConnectionSetting `xml:"http://www.onvif.org/ver10/schema AdminSettings"`
+	OperSettings  NetworkInterfaceConnectionSetting `xml:"http://www.onvif.org/ver10/schema OperSettings"`
+	InterfaceType IANA_IfTypes                      `xml:"http://www.onvif.org/ver10/schema IANA-IfTypes"`
 }
 
 type IANA_IfTypes xsd.Int
 
 type NetworkInterfaceConnectionSetting struct {
-	AutoNegotiation xsd.Boolean `xml:"onvif:AutoNegotiation"`
-	Speed           xsd.Int     `xml:"onvif:Speed"`
-	Duplex          Duplex      `xml:"onvif:Duplex"`
+	AutoNegotiation xsd.Boolean `xml:"http://www.onvif.org/ver10/schema AutoNegotiation"`
+	Speed           xsd.Int     `xml:"http://www.onvif.org/ver10/schema Speed"`
+	Duplex          Duplex      `xml:"http://www.onvif.org/ver10/schema Duplex"`
 }
 
-//TODO: enum
+// TODO: enum
 type Duplex xsd.String
 
 type NetworkInterfaceExtension struct {
-	InterfaceType IANA_IfTypes
-	Dot3          Dot3Configuration
-	Dot11         Dot11Configuration
-	Extension     NetworkInterfaceExtension2
+	Any           []xsd.AnyElement           `xml:",any"`
+	InterfaceType IANA_IfTypes               `xml:"http://www.onvif.org/ver10/schema InterfaceType"`
+	Dot3          Dot3Configuration          `xml:"http://www.onvif.org/ver10/schema Dot3"`
+	Dot11         Dot11Configuration         `xml:"http://www.onvif.org/ver10/schema Dot11"`
+	Extension     NetworkInterfaceExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type NetworkInterfaceExtension2 xsd.AnyType
+type NetworkInterfaceExtension2 = xsd.AnyElement
 
 type Dot11Configuration struct {
-	SSID     Dot11SSIDType                  `xml:"onvif:SSID"`
-	Mode     Dot11StationMode               `xml:"onvif:Mode"`
-	Alias    Name                           `xml:"onvif:Alias"`
-	Priority NetworkInterfaceConfigPriority `xml:"onvif:Priority"`
-	Security Dot11SecurityConfiguration     `xml:"onvif:Security"`
+	SSID     Dot11SSIDType                  `xml:"http://www.onvif.org/ver10/schema SSID"`
+	Mode     Dot11StationMode               `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Alias    Name                           `xml:"http://www.onvif.org/ver10/schema Alias"`
+	Priority NetworkInterfaceConfigPriority `xml:"http://www.onvif.org/ver10/schema Priority"`
+	Security Dot11SecurityConfiguration     `xml:"http://www.onvif.org/ver10/schema Security"`
 }
 
 type Dot11SecurityConfiguration struct {
-	Mode      Dot11SecurityMode                   `xml:"onvif:Mode"`
-	Algorithm Dot11Cipher                         `xml:"onvif:Algorithm"`
-	PSK       Dot11PSKSet                         `xml:"onvif:PSK"`
-	Dot1X     ReferenceToken                      `xml:"onvif:Dot1X"`
-	Extension Dot11SecurityConfigurationExtension `xml:"onvif:Extension"`
+	Mode      Dot11SecurityMode                   `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Algorithm Dot11Cipher                         `xml:"http://www.onvif.org/ver10/schema Algorithm"`
+	PSK       Dot11PSKSet                         `xml:"http://www.onvif.org/ver10/schema PSK"`
+	Dot1X     ReferenceToken                      `xml:"http://www.onvif.org/ver10/schema Dot1X"`
+	Extension Dot11SecurityConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type Dot11SecurityConfigurationExtension xsd.AnyType
+type Dot11SecurityConfigurationExtension = xsd.AnyElement
 
 type Dot11PSKSet struct {
-	Key        Dot11PSK             `xml:"onvif:Key"`
-	Passphrase Dot11PSKPassphrase   `xml:"onvif:Passphrase"`
-	Extension  Dot11PSKSetExtension `xml:"onvif:Extension"`
+	Key        Dot11PSK             `xml:"http://www.onvif.org/ver10/schema Key"`
+	Passphrase Dot11PSKPassphrase   `xml:"http://www.onvif.org/ver10/schema Passphrase"`
+	Extension  Dot11PSKSetExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type Dot11PSKSetExtension xsd.AnyType
+type Dot11PSKSetExtension = xsd.AnyElement
 
 type Dot11PSKPassphrase xsd.String
 
 type Dot11PSK xsd.HexBinary
 
-//TODO: enumeration
+// TODO: enumeration
 type Dot11Cipher xsd.String
 
-//TODO: enumeration
+// TODO: enumeration
 type Dot11SecurityMode xsd.String
 
-//TODO: restrictions
+// TODO: restrictions
 type NetworkInterfaceConfigPriority xsd.Integer
 
-//TODO: enumeration
+// TODO: enumeration
 type Dot11StationMode xsd.String
 
-//TODO: restrictions
+// TODO: restrictions
 type Dot11SSIDType xsd.HexBinary
 
 type Dot3Configuration xsd.String
 
 type IPv6NetworkInterface struct {
-	Enabled xsd.Boolean
-	Config  IPv6Configuration
+	Enabled xsd.Boolean       `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	Config  IPv6Configuration `xml:"http://www.onvif.org/ver10/schema Config"`
 }
 
 type IPv6Configuration struct {
-	AcceptRouterAdvert xsd.Boolean
-	DHCP               IPv6DHCPConfiguration
-	Manual             PrefixedIPv6Address
-	LinkLocal          PrefixedIPv6Address
-	FromDHCP           PrefixedIPv6Address
-	FromRA             PrefixedIPv6Address
-	Extension          IPv6ConfigurationExtension
+	AcceptRouterAdvert xsd.Boolean                `xml:"http://www.onvif.org/ver10/schema AcceptRouterAdvert"`
+	DHCP               IPv6DHCPConfiguration      `xml:"http://www.onvif.org/ver10/schema DHCP"`
+	Manual             PrefixedIPv6Address        `xml:"http://www.onvif.org/ver10/schema Manual"`
+	LinkLocal          PrefixedIPv6Address        `xml:"http://www.onvif.org/ver10/schema LinkLocal"`
+	FromDHCP           PrefixedIPv6Address        `xml:"http://www.onvif.org/ver10/schema FromDHCP"`
+	FromRA             PrefixedIPv6Address        `xml:"http://www.onvif.org/ver10/schema FromRA"`
+	Extension          IPv6ConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type IPv6ConfigurationExtension xsd.AnyType
+type IPv6ConfigurationExtension = xsd.AnyElement
 
 type PrefixedIPv6Address struct {
-	Address      IPv6Address `xml:"onvif:Address"`
-	PrefixLength xsd.Int     `xml:"onvif:PrefixLength"`
+	Address      IPv6Address `xml:"http://www.onvif.org/ver10/schema Address"`
+	PrefixLength xsd.Int     `xml:"http://www.onvif.org/ver10/schema PrefixLength"`
 }
 
-//TODO: enumeration
+// TODO: enumeration
 type IPv6DHCPConfiguration xsd.String
 
 type IPv4NetworkInterface struct {
-	Enabled xsd.Boolean
-	Config  IPv4Configuration
+	Enabled xsd.Boolean       `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	Config  IPv4Configuration `xml:"http://www.onvif.org/ver10/schema Config"`
 }
 
 type IPv4Configuration struct {
-	Manual    PrefixedIPv4Address
-	LinkLocal PrefixedIPv4Address
-	FromDHCP  PrefixedIPv4Address
-	DHCP      xsd.Boolean
+	Manual    PrefixedIPv4Address `xml:"http://www.onvif.org/ver10/schema Manual"`
+	LinkLocal PrefixedIPv4Address `xml:"http://www.onvif.org/ver10/schema LinkLocal"`
+	FromDHCP  PrefixedIPv4Address `xml:"http://www.onvif.org/ver10/schema FromDHCP"`
+	DHCP      xsd.Boolean         `xml:"http://www.onvif.org/ver10/schema DHCP"`
 }
 
-//optional, unbounded
+// optional, unbounded
 type PrefixedIPv4Address struct {
-	Address      IPv4Address `xml:"onvif:Address"`
-	PrefixLength xsd.Int     `xml:"onvif:PrefixLength"`
+	Address      IPv4Address `xml:"http://www.onvif.org/ver10/schema Address"`
+	PrefixLength xsd.Int     `xml:"http://www.onvif.org/ver10/schema PrefixLength"`
 }
 
 type NetworkInterfaceSetConfiguration struct {
-	Enabled   xsd.Boolean                               `xml:"onvif:Enabled"`
-	Link      NetworkInterfaceConnectionSetting         `xml:"onvif:Link"`
-	MTU       xsd.Int                                   `xml:"onvif:MTU"`
-	IPv4      IPv4NetworkInterfaceSetConfiguration      `xml:"onvif:IPv4"`
-	IPv6      IPv6NetworkInterfaceSetConfiguration      `xml:"onvif:IPv6"`
-	Extension NetworkInterfaceSetConfigurationExtension `xml:"onvif:Extension"`
+	Enabled   xsd.Boolean                               `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	Link      NetworkInterfaceConnectionSetting         `xml:"http://www.onvif.org/ver10/schema Link"`
+	MTU       xsd.Int                                   `xml:"http://www.onvif.org/ver10/schema MTU"`
+	IPv4      IPv4NetworkInterfaceSetConfiguration      `xml:"http://www.onvif.org/ver10/schema IPv4"`
+	IPv6      IPv6NetworkInterfaceSetConfiguration      `xml:"http://www.onvif.org/ver10/schema IPv6"`
+	Extension NetworkInterfaceSetConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type NetworkInterfaceSetConfigurationExtension struct {
-	Dot3      Dot3Configuration                          `xml:"onvif:Dot3"`
-	Dot11     Dot11Configuration                         `xml:"onvif:Dot11"`
-	Extension NetworkInterfaceSetConfigurationExtension2 `xml:"onvif:Extension"`
+	Any       []xsd.AnyElement                           `xml:",any"`
+	Dot3      Dot3Configuration                          `xml:"http://www.onvif.org/ver10/schema Dot3"`
+	Dot11     Dot11Configuration                         `xml:"http://www.onvif.org/ver10/schema Dot11"`
+	Extension NetworkInterfaceSetConfigurationExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type NetworkInterfaceSetConfigurationExtension2 xsd.AnyType
+type NetworkInterfaceSetConfigurationExtension2 = xsd.AnyElement
 
 type IPv6NetworkInterfaceSetConfiguration struct {
-	Enabled            xsd.Boolean           `xml:"onvif:Enabled"`
-	AcceptRouterAdvert xsd.Boolean           `xml:"onvif:AcceptRouterAdvert"`
-	Manual             PrefixedIPv6Address   `xml:"onvif:Manual"`
-	DHCP               IPv6DHCPConfiguration `xml:"onvif:DHCP"`
+	Enabled            xsd.Boolean           `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	AcceptRouterAdvert xsd.Boolean           `xml:"http://www.onvif.org/ver10/schema AcceptRouterAdvert"`
+	Manual             PrefixedIPv6Address   `xml:"http://www.onvif.org/ver10/schema Manual"`
+	DHCP               IPv6DHCPConfiguration `xml:"http://www.onvif.org/ver10/schema DHCP"`
 }
 
 type IPv4NetworkInterfaceSetConfiguration struct {
-	Enabled xsd.Boolean         `xml:"onvif:Enabled"`
-	Manual  PrefixedIPv4Address `xml:"onvif:Manual"`
-	DHCP    xsd.Boolean         `xml:"onvif:DHCP"`
+	Enabled xsd.Boolean         `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	Manual  PrefixedIPv4Address `xml:"http://www.onvif.org/ver10/schema Manual"`
+	DHCP    xsd.Boolean         `xml:"http://www.onvif.org/ver10/schema DHCP"`
 }
 
 type NetworkProtocol struct {
-	Name      NetworkProtocolType      `xml:"onvif:Name"`
-	Enabled   xsd.Boolean              `xml:"onvif:Enabled"`
-	Port      xsd.Int                  `xml:"onvif:Port"`
-	Extension NetworkProtocolExtension `xml:"onvif:Extension"`
+	Name      NetworkProtocolType      `xml:"http://www.onvif.org/ver10/schema Name"`
+	Enabled   xsd.Boolean              `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	Port      xsd.Int                  `xml:"http://www.onvif.org/ver10/schema Port"`
+	Extension NetworkProtocolExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type NetworkProtocolExtension xsd.AnyType
+type NetworkProtocolExtension = xsd.AnyElement
 
-//TODO: enumeration
+// TODO: enumeration
 type NetworkProtocolType xsd.String
 
 type NetworkGateway struct {
-	IPv4Address IPv4Address
-	IPv6Address IPv6Address
+	IPv4Address IPv4Address `xml:"http://www.onvif.org/ver10/schema IPv4Address"`
+	IPv6Address IPv6Address `xml:"http://www.onvif.org/ver10/schema IPv6Address"`
 }
 
 type NetworkZeroConfiguration struct {
-	InterfaceToken ReferenceToken
-	Enabled        xsd.Boolean
-	Addresses      IPv4Address
-	Extension      NetworkZeroConfigurationExtension
+	InterfaceToken ReferenceToken                    `xml:"http://www.onvif.org/ver10/schema InterfaceToken"`
+	Enabled        xsd.Boolean                       `xml:"http://www.onvif.org/ver10/schema Enabled"`
+	Addresses      IPv4Address                       `xml:"http://www.onvif.org/ver10/schema Addresses"`
+	Extension      NetworkZeroConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
 type NetworkZeroConfigurationExtension struct {
-	Additional *NetworkZeroConfiguration
-	Extension  NetworkZeroConfigurationExtension2
+	Any        []xsd.AnyElement                   `xml:",any"`
+	Additional *NetworkZeroConfiguration          `xml:"http://www.onvif.org/ver10/schema Additional"`
+	Extension  NetworkZeroConfigurationExtension2 `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type NetworkZeroConfigurationExtension2 xsd.AnyType
+type NetworkZeroConfigurationExtension2 = xsd.AnyElement
 
 type IPAddressFilter struct {
-	Type        IPAddressFilterType      `xml:"onvif:Type"`
-	IPv4Address PrefixedIPv4Address      `xml:"onvif:IPv4Address,omitempty"`
-	IPv6Address PrefixedIPv6Address      `xml:"onvif:IPv6Address,omitempty"`
-	Extension   IPAddressFilterExtension `xml:"onvif:Extension,omitempty"`
+	Type        IPAddressFilterType      `xml:"http://www.onvif.org/ver10/schema Type"`
+	IPv4Address PrefixedIPv4Address      `xml:"http://www.onvif.org/ver10/schema IPv4Address,omitempty"`
+	IPv6Address PrefixedIPv6Address      `xml:"http://www.onvif.org/ver10/schema IPv6Address,omitempty"`
+	Extension   IPAddressFilterExtension `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
-type IPAddressFilterExtension xsd.AnyType
+type IPAddressFilterExtension = xsd.AnyElement
 
-//enum { 'Allow', 'Deny' }
-//TODO: enumeration
+// enum { 'Allow', 'Deny' }
+// TODO: enumeration
 type IPAddressFilterType xsd.String
 
-//TODO: attribite <xs:attribute ref="xmime:contentType" use="optional"/>
 type BinaryData struct {
-	X    ContentType      `xml:"xmime:contentType,attr"`
-	Data xsd.Base64Binary `xml:"onvif:Data"`
+	X    ContentType      `xml:"http://www.w3.org/2005/05/xmlmime contentType,attr"`
+	Data xsd.Base64Binary `xml:"http://www.onvif.org/ver10/schema Data"`
 }
 
 type Certificate struct {
-	CertificateID xsd.Token  `xml:"onvif:CertificateID"`
-	Certificate   BinaryData `xml:"onvif:Certificate"`
+	CertificateID xsd.Token  `xml:"http://www.onvif.org/ver10/schema CertificateID"`
+	Certificate   BinaryData `xml:"http://www.onvif.org/ver10/schema Certificate"`
 }
 
 type CertificateStatus struct {
-	CertificateID xsd.Token   `xml:"onvif:CertificateID"`
-	Status        xsd.Boolean `xml:"onvif:Status"`
+	CertificateID xsd.Token   `xml:"http://www.onvif.org/ver10/schema CertificateID"`
+	Status        xsd.Boolean `xml:"http://www.onvif.org/ver10/schema Status"`
 }
 
 type RelayOutput struct {
 	DeviceEntity
-	Properties RelayOutputSettings
+	Properties RelayOutputSettings `xml:"http://www.onvif.org/ver10/schema Properties"`
 }
 
+// Deprecated: use RelayOutput, it decodes the responses too.
+type RelayOutputResponse = RelayOutput
+
 type RelayOutputSettings struct {
-	Mode      RelayMode      `xml:"onvif:Mode"`
-	DelayTime xsd.Duration   `xml:"onvif:DelayTime"`
-	IdleState RelayIdleState `xml:"onvif:IdleState"`
+	Mode      RelayMode      `xml:"http://www.onvif.org/ver10/schema Mode"`
+	DelayTime xsd.Duration   `xml:"http://www.onvif.org/ver10/schema DelayTime"`
+	IdleState RelayIdleState `xml:"http://www.onvif.org/ver10/schema IdleState"`
 }
 
-//TODO:enumeration
+// Deprecated: use RelayOutputSettings, it decodes the responses too.
+type RelayOutputSettingsResponse = RelayOutputSettings
+
+// TODO:enumeration
 type RelayIdleState xsd.String
 
-//TODO: enumeration
+// TODO: enumeration
 type RelayMode xsd.String
 
-//TODO: enumeration
+// TODO: enumeration
 type RelayLogicalState xsd.String
 
 type CertificateWithPrivateKey struct {
-	CertificateID xsd.Token  `xml:"onvif:CertificateID"`
-	Certificate   BinaryData `xml:"onvif:Certificate"`
-	PrivateKey    BinaryData `xml:"onvif:PrivateKey"`
+	CertificateID xsd.Token  `xml:"http://www.onvif.org/ver10/schema CertificateID"`
+	Certificate   BinaryData `xml:"http://www.onvif.org/ver10/schema Certificate"`
+	PrivateKey    BinaryData `xml:"http://www.onvif.org/ver10/schema PrivateKey"`
 }
 
 type CertificateInformation struct {
-	CertificateID      xsd.Token
-	IssuerDN           xsd.String
-	SubjectDN          xsd.String
-	KeyUsage           CertificateUsage
-	ExtendedKeyUsage   CertificateUsage
-	KeyLength          xsd.Int
-	Version            xsd.String
-	SerialNum          xsd.String
-	SignatureAlgorithm xsd.String
-	Validity           DateTimeRange
-	Extension          CertificateInformationExtension
+	CertificateID      xsd.Token                       `xml:"http://www.onvif.org/ver10/schema CertificateID"`
+	IssuerDN           xsd.String                      `xml:"http://www.onvif.org/ver10/schema IssuerDN"`
+	SubjectDN          xsd.String                      `xml:"http://www.onvif.org/ver10/schema SubjectDN"`
+	KeyUsage           CertificateUsage                `xml:"http://www.onvif.org/ver10/schema KeyUsage"`
+	ExtendedKeyUsage   CertificateUsage                `xml:"http://www.onvif.org/ver10/schema ExtendedKeyUsage"`
+	KeyLength          xsd.Int                         `xml:"http://www.onvif.org/ver10/schema KeyLength"`
+	Version            xsd.String                      `xml:"http://www.onvif.org/ver10/schema Version"`
+	SerialNum          xsd.String                      `xml:"http://www.onvif.org/ver10/schema SerialNum"`
+	SignatureAlgorithm xsd.String                      `xml:"http://www.onvif.org/ver10/schema SignatureAlgorithm"`
+	Validity           DateTimeRange                   `xml:"http://www.onvif.org/ver10/schema Validity"`
+	Extension          CertificateInformationExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type CertificateInformationExtension xsd.AnyType
+type CertificateInformationExtension = xsd.AnyElement
 
 type DateTimeRange struct {
-	From  xsd.DateTime
-	Until xsd.DateTime
+	From  xsd.DateTime `xml:"http://www.onvif.org/ver10/schema From"`
+	Until xsd.DateTime `xml:"http://www.onvif.org/ver10/schema Until"`
 }
 
 type CertificateUsage struct {
 	Critical         xsd.Boolean `xml:"Critical,attr"`
-	CertificateUsage xsd.String
+	CertificateUsage xsd.String  `xml:"http://www.onvif.org/ver10/schema CertificateUsage"`
 }
 
 type Dot1XConfiguration struct {
-	Dot1XConfigurationToken ReferenceToken              `xml:"onvif:Dot1XConfigurationToken"`
-	Identity                xsd.String                  `xml:"onvif:Identity"`
-	AnonymousID             xsd.String                  `xml:"onvif:AnonymousID,omitempty"`
-	EAPMethod               xsd.Int                     `xml:"onvif:EAPMethod"`
-	CACertificateID         xsd.Token                   `xml:"onvif:CACertificateID,omitempty"`
-	EAPMethodConfiguration  EAPMethodConfiguration      `xml:"onvif:EAPMethodConfiguration,omitempty"`
-	Extension               Dot1XConfigurationExtension `xml:"onvif:Extension,omitempty"`
+	Dot1XConfigurationToken ReferenceToken              `xml:"http://www.onvif.org/ver10/schema Dot1XConfigurationToken"`
+	Identity                xsd.String                  `xml:"http://www.onvif.org/ver10/schema Identity"`
+	AnonymousID             xsd.String                  `xml:"http://www.onvif.org/ver10/schema AnonymousID,omitempty"`
+	EAPMethod               xsd.Int                     `xml:"http://www.onvif.org/ver10/schema EAPMethod"`
+	CACertificateID         xsd.Token                   `xml:"http://www.onvif.org/ver10/schema CACertificateID,omitempty"`
+	EAPMethodConfiguration  EAPMethodConfiguration      `xml:"http://www.onvif.org/ver10/schema EAPMethodConfiguration,omitempty"`
+	Extension               Dot1XConfigurationExtension `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
-type Dot1XConfigurationExtension xsd.AnyType
+type Dot1XConfigurationExtension = xsd.AnyElement
 
 type EAPMethodConfiguration struct {
-	TLSConfiguration TLSConfiguration   `xml:"onvif:TLSConfiguration,omitempty"`
-	Password         xsd.String         `xml:"onvif:Password,omitempty"`
-	Extension        EapMethodExtension `xml:"onvif:Extension,omitempty"`
+	TLSConfiguration TLSConfiguration   `xml:"http://www.onvif.org/ver10/schema TLSConfiguration,omitempty"`
+	Password         xsd.String         `xml:"http://www.onvif.org/ver10/schema Password,omitempty"`
+	Extension        EapMethodExtension `xml:"http://www.onvif.org/ver10/schema Extension,omitempty"`
 }
 
-type EapMethodExtension xsd.AnyType
+type EapMethodExtension = xsd.AnyElement
 
 type TLSConfiguration struct {
-	CertificateID xsd.Token `xml:"onvif:CertificateID,omitempty"`
+	CertificateID xsd.Token `xml:"http://www.onvif.org/ver10/schema CertificateID,omitempty"`
 }
 
 type Dot11Capabilities struct {
-	TKIP                  xsd.Boolean
-	ScanAvailableNetworks xsd.Boolean
-	MultipleConfiguration xsd.Boolean
-	AdHocStationMode      xsd.Boolean
-	WEP                   xsd.Boolean
+	TKIP                  xsd.Boolean `xml:"http://www.onvif.org/ver10/schema TKIP"`
+	ScanAvailableNetworks xsd.Boolean `xml:"http://www.onvif.org/ver10/schema ScanAvailableNetworks"`
+	MultipleConfiguration xsd.Boolean `xml:"http://www.onvif.org/ver10/schema MultipleConfiguration"`
+	AdHocStationMode      xsd.Boolean `xml:"http://www.onvif.org/ver10/schema AdHocStationMode"`
+	WEP                   xsd.Boolean `xml:"http://www.onvif.org/ver10/schema WEP"`
 }
 
 type Dot11Status struct {
-	SSID              Dot11SSIDType
-	BSSID             xsd.String
-	PairCipher        Dot11Cipher
-	GroupCipher       Dot11Cipher
-	SignalStrength    Dot11SignalStrength
-	ActiveConfigAlias ReferenceToken
+	SSID              Dot11SSIDType       `xml:"http://www.onvif.org/ver10/schema SSID"`
+	BSSID             xsd.String          `xml:"http://www.onvif.org/ver10/schema BSSID"`
+	PairCipher        Dot11Cipher         `xml:"http://www.onvif.org/ver10/schema PairCipher"`
+	GroupCipher       Dot11Cipher         `xml:"http://www.onvif.org/ver10/schema GroupCipher"`
+	SignalStrength    Dot11SignalStrength `xml:"http://www.onvif.org/ver10/schema SignalStrength"`
+	ActiveConfigAlias ReferenceToken      `xml:"http://www.onvif.org/ver10/schema ActiveConfigAlias"`
 }
 
-//TODO: enumeration
+// TODO: enumeration
 type Dot11SignalStrength xsd.String
 
 type Dot11AvailableNetworks struct {
-	SSID                  Dot11SSIDType
-	BSSID                 xsd.String
-	AuthAndMangementSuite Dot11AuthAndMangementSuite
-	PairCipher            Dot11Cipher
-	GroupCipher           Dot11Cipher
-	SignalStrength        Dot11SignalStrength
-	Extension             Dot11AvailableNetworksExtension
+	SSID                  Dot11SSIDType                   `xml:"http://www.onvif.org/ver10/schema SSID"`
+	BSSID                 xsd.String                      `xml:"http://www.onvif.org/ver10/schema BSSID"`
+	AuthAndMangementSuite Dot11AuthAndMangementSuite      `xml:"http://www.onvif.org/ver10/schema AuthAndMangementSuite"`
+	PairCipher            Dot11Cipher                     `xml:"http://www.onvif.org/ver10/schema PairCipher"`
+	GroupCipher           Dot11Cipher                     `xml:"http://www.onvif.org/ver10/schema GroupCipher"`
+	SignalStrength        Dot11SignalStrength             `xml:"http://www.onvif.org/ver10/schema SignalStrength"`
+	Extension             Dot11AvailableNetworksExtension `xml:"http://www.onvif.org/ver10/schema Extension"`
 }
 
-type Dot11AvailableNetworksExtension xsd.AnyType
+type Dot11AvailableNetworksExtension = xsd.AnyElement
 
-//TODO: enumeration
+// TODO: enumeration
 type Dot11AuthAndMangementSuite xsd.String
 
 type SystemLogUriList struct {
-	SystemLog SystemLogUri
+	SystemLog SystemLogUri `xml:"http://www.onvif.org/ver10/schema SystemLog"`
 }
 
 type SystemLogUri struct {
-	Type SystemLogType
-	Uri  xsd.AnyURI
+	Type SystemLogType `xml:"http://www.onvif.org/ver10/schema Type"`
+	Uri  xsd.AnyURI    `xml:"http://www.onvif.org/ver10/schema Uri"`
 }
 
 type LocationEntity struct {
@@ -1906,10 +1991,10 @@ type LocationEntity struct {
 	GeoSource xsd.AnyURI     `xml:"GeoSource,attr"`
 	AutoGeo   xsd.Boolean    `xml:"AutoGeo,attr"`
 
-	GeoLocation      GeoLocation      `xml:"onvif:GeoLocation"`
-	GeoOrientation   GeoOrientation   `xml:"onvif:GeoOrientation"`
-	LocalLocation    LocalLocation    `xml:"onvif:LocalLocation"`
-	LocalOrientation LocalOrientation `xml:"onvif:LocalOrientation"`
+	GeoLocation      GeoLocation      `xml:"http://www.onvif.org/ver10/schema GeoLocation"`
+	GeoOrientation   GeoOrientation   `xml:"http://www.onvif.org/ver10/schema GeoOrientation"`
+	LocalLocation    LocalLocation    `xml:"http://www.onvif.org/ver10/schema LocalLocation"`
+	LocalOrientation LocalOrientation `xml:"http://www.onvif.org/ver10/schema LocalOrientation"`
 }
 
 type LocalOrientation struct {
@@ -1931,63 +2016,372 @@ type GeoOrientation struct {
 }
 
 type FocusMove struct {
-	Absolute   AbsoluteFocus   `xml:"onvif:Absolute"`
-	Relative   RelativeFocus   `xml:"onvif:Relative"`
-	Continuous ContinuousFocus `xml:"onvif:Continuous"`
+	Absolute   AbsoluteFocus   `xml:"http://www.onvif.org/ver10/schema Absolute"`
+	Relative   RelativeFocus   `xml:"http://www.onvif.org/ver10/schema Relative"`
+	Continuous ContinuousFocus `xml:"http://www.onvif.org/ver10/schema Continuous"`
 }
 
 type ContinuousFocus struct {
-	Speed xsd.Float `xml:"onvif:Speed"`
+	Speed xsd.Float `xml:"http://www.onvif.org/ver10/schema Speed"`
 }
 
 type RelativeFocus struct {
-	Distance xsd.Float `xml:"onvif:Distance"`
-	Speed    xsd.Float `xml:"onvif:Speed"`
+	Distance xsd.Float `xml:"http://www.onvif.org/ver10/schema Distance"`
+	Speed    xsd.Float `xml:"http://www.onvif.org/ver10/schema Speed"`
 }
 
 type AbsoluteFocus struct {
-	Position xsd.Float `xml:"onvif:Position"`
-	Speed    xsd.Float `xml:"onvif:Speed"`
+	Position xsd.Float `xml:"http://www.onvif.org/ver10/schema Position"`
+	Speed    xsd.Float `xml:"http://www.onvif.org/ver10/schema Speed"`
 }
 
 type DateTime struct {
-	Time Time `xml:"onvif:Time"`
-	Date Date `xml:"onvif:Date"`
+	Time Time `xml:"http://www.onvif.org/ver10/schema Time"`
+	Date Date `xml:"http://www.onvif.org/ver10/schema Date"`
 }
 
 type Time struct {
-	Hour   xsd.Int `xml:"onvif:Hour"`
-	Minute xsd.Int `xml:"onvif:Minute"`
-	Second xsd.Int `xml:"onvif:Second"`
+	Hour   xsd.Int `xml:"http://www.onvif.org/ver10/schema Hour"`
+	Minute xsd.Int `xml:"http://www.onvif.org/ver10/schema Minute"`
+	Second xsd.Int `xml:"http://www.onvif.org/ver10/schema Second"`
 }
 
 type Date struct {
-	Year  xsd.Int `xml:"onvif:Year"`
-	Month xsd.Int `xml:"onvif:Month"`
-	Day   xsd.Int `xml:"onvif:Day"`
+	Year  xsd.Int `xml:"http://www.onvif.org/ver10/schema Year"`
+	Month xsd.Int `xml:"http://www.onvif.org/ver10/schema Month"`
+	Day   xsd.Int `xml:"http://www.onvif.org/ver10/schema Day"`
 }
 
 type TimeZone struct {
-	TZ xsd.Token `xml:"onvif:TZ"`
+	TZ xsd.Token `xml:"http://www.onvif.org/ver10/schema TZ"`
 }
 
-type DateTimeResponse struct {
-	Time TimeResponse
-	Date DateResponse
+// Deprecated: use DateTime, it decodes the responses too.
+type DateTimeResponse = DateTime
+
+// Deprecated: use Time, it decodes the responses too.
+type TimeResponse = Time
+
+// Deprecated: use Date, it decodes the responses too.
+type DateResponse = Date
+
+// Deprecated: use TimeZone, it decodes the responses too.
+type TimeZoneResponse = TimeZone
+
+//Recording
+
+// RecordingJobMode Idle or Active
+type RecordingJobMode xsd.String
+
+// TrackType Video, Audio, Metadata or Extended
+type TrackType xsd.String
+
+type RecordingSourceInformation struct {
+	SourceId    xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema SourceId"`
+	Name        xsd.String `xml:"http://www.onvif.org/ver10/schema Name"`
+	Location    xsd.String `xml:"http://www.onvif.org/ver10/schema Location"`
+	Description xsd.String `xml:"http://www.onvif.org/ver10/schema Description"`
+	Address     xsd.AnyURI `xml:"http://www.onvif.org/ver10/schema Address"`
 }
 
-type TimeResponse struct {
-	Hour   xsd.Int
-	Minute xsd.Int
-	Second xsd.Int
+// Deprecated: use RecordingSourceInformation, it decodes the responses too.
+type RecordingSourceInformationResponse = RecordingSourceInformation
+
+type RecordingConfiguration struct {
+	Source               RecordingSourceInformation `xml:"http://www.onvif.org/ver10/schema Source"`
+	Content              xsd.String                 `xml:"http://www.onvif.org/ver10/schema Content"`
+	MaximumRetentionTime xsd.Duration               `xml:"http://www.onvif.org/ver10/schema MaximumRetentionTime"`
 }
 
-type DateResponse struct {
-	Year  xsd.Int
-	Month xsd.Int
-	Day   xsd.Int
+// Deprecated: use RecordingConfiguration, it decodes the responses too.
+type RecordingConfigurationResponse = RecordingConfiguration
+
+type TrackConfiguration struct {
+	TrackType   TrackType  `xml:"http://www.onvif.org/ver10/schema TrackType"`
+	Description xsd.String `xml:"http://www.onvif.org/ver10/schema Description"`
 }
 
-type TimeZoneResponse struct {
-	TZ xsd.Token
+// Deprecated: use TrackConfiguration, it decodes the responses too.
+type TrackConfigurationResponse = TrackConfiguration
+
+type GetTracksResponseItem struct {
+	TrackToken    ReferenceToken     `xml:"http://www.onvif.org/ver10/schema TrackToken"`
+	Configuration TrackConfiguration `xml:"http://www.onvif.org/ver10/schema Configuration"`
 }
+
+type GetTracksResponseList struct {
+	Track []GetTracksResponseItem `xml:"http://www.onvif.org/ver10/schema Track"`
+}
+
+type GetRecordingsResponseItem struct {
+	RecordingToken ReferenceToken         `xml:"http://www.onvif.org/ver10/schema RecordingToken"`
+	Configuration  RecordingConfiguration `xml:"http://www.onvif.org/ver10/schema Configuration"`
+	Tracks         GetTracksResponseList  `xml:"http://www.onvif.org/ver10/schema Tracks"`
+}
+
+// SourceReference Type is optional, the default is http://www.onvif.org/ver10/schema/Receiver
+type SourceReference struct {
+	Type  xsd.AnyURI     `xml:"Type,attr,omitempty"`
+	Token ReferenceToken `xml:"http://www.onvif.org/ver10/schema Token"`
+}
+
+// Deprecated: use SourceReference, it decodes the responses too.
+type SourceReferenceResponse = SourceReference
+
+type RecordingJobTrack struct {
+	SourceTag   xsd.String     `xml:"http://www.onvif.org/ver10/schema SourceTag"`
+	Destination ReferenceToken `xml:"http://www.onvif.org/ver10/schema Destination"`
+}
+
+// Deprecated: use RecordingJobTrack, it decodes the responses too.
+type RecordingJobTrackResponse = RecordingJobTrack
+
+type RecordingJobSource struct {
+	SourceToken        *SourceReference    `xml:"http://www.onvif.org/ver10/schema SourceToken,omitempty"`
+	AutoCreateReceiver *xsd.Boolean        `xml:"http://www.onvif.org/ver10/schema AutoCreateReceiver,omitempty"`
+	Tracks             []RecordingJobTrack `xml:"http://www.onvif.org/ver10/schema Tracks,omitempty"`
+}
+
+// Deprecated: use RecordingJobSource, it decodes the responses too.
+type RecordingJobSourceResponse = RecordingJobSource
+
+type RecordingJobConfiguration struct {
+	ScheduleToken  ReferenceToken       `xml:"ScheduleToken,attr,omitempty"`
+	RecordingToken ReferenceToken       `xml:"http://www.onvif.org/ver10/schema RecordingToken"`
+	Mode           RecordingJobMode     `xml:"http://www.onvif.org/ver10/schema Mode"`
+	Priority       xsd.Int              `xml:"http://www.onvif.org/ver10/schema Priority"`
+	Source         []RecordingJobSource `xml:"http://www.onvif.org/ver10/schema Source,omitempty"`
+}
+
+// Deprecated: use RecordingJobConfiguration, it decodes the responses too.
+type RecordingJobConfigurationResponse = RecordingJobConfiguration
+
+type GetRecordingJobsResponseItem struct {
+	JobToken         ReferenceToken            `xml:"http://www.onvif.org/ver10/schema JobToken"`
+	JobConfiguration RecordingJobConfiguration `xml:"http://www.onvif.org/ver10/schema JobConfiguration"`
+}
+
+type RecordingJobStateTrack struct {
+	SourceTag   xsd.String     `xml:"http://www.onvif.org/ver10/schema SourceTag"`
+	Destination ReferenceToken `xml:"http://www.onvif.org/ver10/schema Destination"`
+	Error       xsd.String     `xml:"http://www.onvif.org/ver10/schema Error"`
+	//State Idle, Active, Error or a vendor specific state
+	State xsd.String `xml:"http://www.onvif.org/ver10/schema State"`
+}
+
+type RecordingJobStateTracks struct {
+	Track []RecordingJobStateTrack `xml:"http://www.onvif.org/ver10/schema Track"`
+}
+
+type RecordingJobStateSource struct {
+	SourceToken SourceReference         `xml:"http://www.onvif.org/ver10/schema SourceToken"`
+	State       xsd.String              `xml:"http://www.onvif.org/ver10/schema State"`
+	Tracks      RecordingJobStateTracks `xml:"http://www.onvif.org/ver10/schema Tracks"`
+}
+
+type RecordingJobStateInformation struct {
+	RecordingToken ReferenceToken            `xml:"http://www.onvif.org/ver10/schema RecordingToken"`
+	State          xsd.String                `xml:"http://www.onvif.org/ver10/schema State"`
+	Sources        []RecordingJobStateSource `xml:"http://www.onvif.org/ver10/schema Sources"`
+}
+
+//Search
+
+// SearchState Queued, Searching, Completed or Unknown
+type SearchState xsd.String
+
+// RecordingStatus Initiated, Recording, Stopped, Removing, Removed or Unknown
+type RecordingStatus xsd.String
+
+type RecordingSummary struct {
+	DataFrom         xsd.DateTime `xml:"http://www.onvif.org/ver10/schema DataFrom"`
+	DataUntil        xsd.DateTime `xml:"http://www.onvif.org/ver10/schema DataUntil"`
+	NumberRecordings xsd.Int      `xml:"http://www.onvif.org/ver10/schema NumberRecordings"`
+}
+
+// SearchScope empty scope searches all the recordings
+type SearchScope struct {
+	IncludedSources    []SourceReference `xml:"http://www.onvif.org/ver10/schema IncludedSources,omitempty"`
+	IncludedRecordings []ReferenceToken  `xml:"http://www.onvif.org/ver10/schema IncludedRecordings,omitempty"`
+	//RecordingInformationFilter XPath expression on RecordingInformation
+	RecordingInformationFilter xsd.String `xml:"http://www.onvif.org/ver10/schema RecordingInformationFilter,omitempty"`
+}
+
+type TrackInformation struct {
+	TrackToken  ReferenceToken `xml:"http://www.onvif.org/ver10/schema TrackToken"`
+	TrackType   TrackType      `xml:"http://www.onvif.org/ver10/schema TrackType"`
+	Description xsd.String     `xml:"http://www.onvif.org/ver10/schema Description"`
+	DataFrom    xsd.DateTime   `xml:"http://www.onvif.org/ver10/schema DataFrom"`
+	DataTo      xsd.DateTime   `xml:"http://www.onvif.org/ver10/schema DataTo"`
+}
+
+type RecordingInformation struct {
+	RecordingToken    ReferenceToken             `xml:"http://www.onvif.org/ver10/schema RecordingToken"`
+	Source            RecordingSourceInformation `xml:"http://www.onvif.org/ver10/schema Source"`
+	EarliestRecording xsd.DateTime               `xml:"http://www.onvif.org/ver10/schema EarliestRecording"`
+	LatestRecording   xsd.DateTime               `xml:"http://www.onvif.org/ver10/schema LatestRecording"`
+	Content           xsd.String                 `xml:"http://www.onvif.org/ver10/schema Content"`
+	Track             []TrackInformation         `xml:"http://www.onvif.org/ver10/schema Track"`
+	RecordingStatus   RecordingStatus            `xml:"http://www.onvif.org/ver10/schema RecordingStatus"`
+}
+
+type FindRecordingResultList struct {
+	SearchState          SearchState            `xml:"http://www.onvif.org/ver10/schema SearchState"`
+	RecordingInformation []RecordingInformation `xml:"http://www.onvif.org/ver10/schema RecordingInformation"`
+}
+
+type PTZPositionFilter struct {
+	MinPosition PTZVector   `xml:"http://www.onvif.org/ver10/schema MinPosition"`
+	MaxPosition PTZVector   `xml:"http://www.onvif.org/ver10/schema MaxPosition"`
+	EnterOrExit xsd.Boolean `xml:"http://www.onvif.org/ver10/schema EnterOrExit"`
+}
+
+type FindPTZPositionResult struct {
+	RecordingToken ReferenceToken `xml:"http://www.onvif.org/ver10/schema RecordingToken"`
+	TrackToken     ReferenceToken `xml:"http://www.onvif.org/ver10/schema TrackToken"`
+	Time           xsd.DateTime   `xml:"http://www.onvif.org/ver10/schema Time"`
+	Position       PTZVector      `xml:"http://www.onvif.org/ver10/schema Position"`
+}
+
+type FindPTZPositionResultList struct {
+	SearchState SearchState             `xml:"http://www.onvif.org/ver10/schema SearchState"`
+	Result      []FindPTZPositionResult `xml:"http://www.onvif.org/ver10/schema Result"`
+}
+
+//Replay
+
+type ReplayConfiguration struct {
+	SessionTimeout xsd.Duration `xml:"http://www.onvif.org/ver10/schema SessionTimeout"`
+}
+
+// Deprecated: use ReplayConfiguration, it decodes the responses too.
+type ReplayConfigurationResponse = ReplayConfiguration
+
+//DeviceIO
+
+// DigitalIdleState closed or open
+type DigitalIdleState xsd.String
+
+// DigitalInput IdleState is omitted in SetDigitalInputConfigurations if empty
+type DigitalInput struct {
+	Token     ReferenceToken   `xml:"token,attr"`
+	IdleState DigitalIdleState `xml:"IdleState,attr,omitempty"`
+}
+
+type FloatRectangle struct {
+	X      float64 `xml:"x,attr"`
+	Y      float64 `xml:"y,attr"`
+	Width  float64 `xml:"width,attr"`
+	Height float64 `xml:"height,attr"`
+}
+
+type VideoOutput struct {
+	Token       ReferenceToken  `xml:"token,attr"`
+	Layout      Layout          `xml:"http://www.onvif.org/ver10/schema Layout"`
+	Resolution  VideoResolution `xml:"http://www.onvif.org/ver10/schema Resolution"`
+	RefreshRate float64         `xml:"http://www.onvif.org/ver10/schema RefreshRate"`
+	AspectRatio float64         `xml:"http://www.onvif.org/ver10/schema AspectRatio"`
+}
+
+// Deprecated: use VideoOutput, it decodes the responses too.
+type VideoOutputResponse = VideoOutput
+
+//Receiver
+
+// ReceiverMode AutoConnect, AlwaysConnect, NeverConnect or Unknown
+type ReceiverMode xsd.String
+
+// ReceiverState NotConnected, Connecting, Connected or Unknown
+type ReceiverState xsd.String
+
+type ReceiverConfiguration struct {
+	Mode        ReceiverMode `xml:"http://www.onvif.org/ver10/schema Mode"`
+	MediaUri    xsd.AnyURI   `xml:"http://www.onvif.org/ver10/schema MediaUri"`
+	StreamSetup StreamSetup  `xml:"http://www.onvif.org/ver10/schema StreamSetup"`
+}
+
+// Deprecated: use ReceiverConfiguration, it decodes the responses too.
+type ReceiverConfigurationResponse = ReceiverConfiguration
+
+type Receiver struct {
+	Token         ReferenceToken        `xml:"token,attr"`
+	Configuration ReceiverConfiguration `xml:"http://www.onvif.org/ver10/schema Configuration"`
+}
+
+// Deprecated: use Receiver, it decodes the responses too.
+type ReceiverResponse = Receiver
+
+type ReceiverStateInformation struct {
+	State       ReceiverState `xml:"http://www.onvif.org/ver10/schema State"`
+	AutoCreated bool          `xml:"http://www.onvif.org/ver10/schema AutoCreated"`
+}
+
+//Display
+
+type PaneLayout struct {
+	Pane ReferenceToken `xml:"http://www.onvif.org/ver10/schema Pane"`
+	Area FloatRectangle `xml:"http://www.onvif.org/ver10/schema Area"`
+}
+
+// Deprecated: use PaneLayout, it decodes the responses too.
+type PaneLayoutResponse = PaneLayout
+
+type Layout struct {
+	PaneLayout []PaneLayout `xml:"http://www.onvif.org/ver10/schema PaneLayout"`
+}
+
+// Deprecated: use Layout, it decodes the responses too.
+type LayoutResponse = Layout
+
+type PaneLayoutOptions struct {
+	Area []FloatRectangle `xml:"http://www.onvif.org/ver10/schema Area"`
+}
+
+type LayoutOptions struct {
+	PaneLayoutOptions []PaneLayoutOptions `xml:"http://www.onvif.org/ver10/schema PaneLayoutOptions"`
+}
+
+type JpegDecOptions struct {
+	ResolutionsAvailable  []VideoResolution `xml:"http://www.onvif.org/ver10/schema ResolutionsAvailable"`
+	SupportedInputBitrate IntRange          `xml:"http://www.onvif.org/ver10/schema SupportedInputBitrate"`
+	SupportedFrameRate    IntRange          `xml:"http://www.onvif.org/ver10/schema SupportedFrameRate"`
+}
+
+type H264DecOptions struct {
+	ResolutionsAvailable  []VideoResolution `xml:"http://www.onvif.org/ver10/schema ResolutionsAvailable"`
+	SupportedH264Profiles []string          `xml:"http://www.onvif.org/ver10/schema SupportedH264Profiles"`
+	SupportedInputBitrate IntRange          `xml:"http://www.onvif.org/ver10/schema SupportedInputBitrate"`
+	SupportedFrameRate    IntRange          `xml:"http://www.onvif.org/ver10/schema SupportedFrameRate"`
+}
+
+type Mpeg4DecOptions struct {
+	ResolutionsAvailable   []VideoResolution `xml:"http://www.onvif.org/ver10/schema ResolutionsAvailable"`
+	SupportedMpeg4Profiles []string          `xml:"http://www.onvif.org/ver10/schema SupportedMpeg4Profiles"`
+	SupportedInputBitrate  IntRange          `xml:"http://www.onvif.org/ver10/schema SupportedInputBitrate"`
+	SupportedFrameRate     IntRange          `xml:"http://www.onvif.org/ver10/schema SupportedFrameRate"`
+}
+
+type VideoDecoderConfigurationOptions struct {
+	JpegDecOptions  *JpegDecOptions  `xml:"http://www.onvif.org/ver10/schema JpegDecOptions"`
+	H264DecOptions  *H264DecOptions  `xml:"http://www.onvif.org/ver10/schema H264DecOptions"`
+	Mpeg4DecOptions *Mpeg4DecOptions `xml:"http://www.onvif.org/ver10/schema Mpeg4DecOptions"`
+}
+
+type CodingCapabilities struct {
+	AudioEncodingCapabilities *AudioEncoderConfigurationOptions `xml:"http://www.onvif.org/ver10/schema AudioEncodingCapabilities"`
+	AudioDecodingCapabilities *AudioDecoderConfigurationOptions `xml:"http://www.onvif.org/ver10/schema AudioDecodingCapabilities"`
+	VideoDecodingCapabilities VideoDecoderConfigurationOptions  `xml:"http://www.onvif.org/ver10/schema VideoDecodingCapabilities"`
+}
+
+// PaneConfiguration the receiver of the pane is connected to the video and the audio outputs of the pane
+type PaneConfiguration struct {
+	PaneName                  string                     `xml:"http://www.onvif.org/ver10/schema PaneName,omitempty"`
+	AudioOutputToken          ReferenceToken             `xml:"http://www.onvif.org/ver10/schema AudioOutputToken,omitempty"`
+	AudioSourceToken          ReferenceToken             `xml:"http://www.onvif.org/ver10/schema AudioSourceToken,omitempty"`
+	AudioEncoderConfiguration *AudioEncoderConfiguration `xml:"http://www.onvif.org/ver10/schema AudioEncoderConfiguration,omitempty"`
+	ReceiverToken             ReferenceToken             `xml:"http://www.onvif.org/ver10/schema ReceiverToken,omitempty"`
+	Token                     ReferenceToken             `xml:"http://www.onvif.org/ver10/schema Token"`
+}
+
+// Deprecated: use PaneConfiguration, it decodes the responses too.
+type PaneConfigurationResponse = PaneConfiguration
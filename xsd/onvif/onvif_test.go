@@ -0,0 +1,447 @@
+package onvif
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/neirolis/onvif-go/xsd"
+)
+
+const namespaces = `xmlns:tt="http://www.onvif.org/ver10/schema" xmlns:v="urn:vendor" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`
+
+// roundTrip is a configuration read from a device, modified and written back
+type roundTrip struct {
+	name string
+	// xml is the configuration as the device returns it
+	xml string
+	// value is the type decoding the configuration
+	value interface{}
+	// modify changes the decoded value
+	modify func(v interface{})
+	// want is the configuration written back, only the modified values differ from xml
+	want string
+}
+
+var roundTrips = []roundTrip{
+	{
+		name: "media/VideoEncoderConfiguration",
+		xml: `<tt:Configuration ` + namespaces + ` token="enc0">
+			<tt:Name>MainStream</tt:Name>
+			<tt:UseCount>2</tt:UseCount>
+			<tt:Encoding>H264</tt:Encoding>
+			<tt:Resolution><tt:Width>1920</tt:Width><tt:Height>1080</tt:Height></tt:Resolution>
+			<tt:Quality>4</tt:Quality>
+			<tt:RateControl><tt:FrameRateLimit>25</tt:FrameRateLimit><tt:EncodingInterval>1</tt:EncodingInterval><tt:BitrateLimit>4096</tt:BitrateLimit></tt:RateControl>
+			<tt:H264><tt:GovLength>50</tt:GovLength><tt:H264Profile>Main</tt:H264Profile></tt:H264>
+			<tt:Multicast>
+				<tt:Address><tt:Type>IPv4</tt:Type><tt:IPv4Address>239.0.0.1</tt:IPv4Address></tt:Address>
+				<tt:Port>5000</tt:Port><tt:TTL>5</tt:TTL><tt:AutoStart>false</tt:AutoStart>
+				<v:Interface v:index="1">eth0</v:Interface>
+			</tt:Multicast>
+			<tt:SessionTimeout>PT60S</tt:SessionTimeout>
+			<v:SmartCodec><v:Mode>on</v:Mode></v:SmartCodec>
+		</tt:Configuration>`,
+		value: &VideoEncoderConfiguration{},
+		modify: func(v interface{}) {
+			c := v.(*VideoEncoderConfiguration)
+			c.Resolution.Width, c.Resolution.Height = 1280, 720
+			c.H264.GovLength = 25
+		},
+		want: `<tt:Configuration ` + namespaces + ` token="enc0">
+			<tt:Name>MainStream</tt:Name>
+			<tt:UseCount>2</tt:UseCount>
+			<tt:Encoding>H264</tt:Encoding>
+			<tt:Resolution><tt:Width>1280</tt:Width><tt:Height>720</tt:Height></tt:Resolution>
+			<tt:Quality>4</tt:Quality>
+			<tt:RateControl><tt:FrameRateLimit>25</tt:FrameRateLimit><tt:EncodingInterval>1</tt:EncodingInterval><tt:BitrateLimit>4096</tt:BitrateLimit></tt:RateControl>
+			<tt:H264><tt:GovLength>25</tt:GovLength><tt:H264Profile>Main</tt:H264Profile></tt:H264>
+			<tt:Multicast>
+				<tt:Address><tt:Type>IPv4</tt:Type><tt:IPv4Address>239.0.0.1</tt:IPv4Address></tt:Address>
+				<tt:Port>5000</tt:Port><tt:TTL>5</tt:TTL><tt:AutoStart>false</tt:AutoStart>
+				<v:Interface v:index="1">eth0</v:Interface>
+			</tt:Multicast>
+			<tt:SessionTimeout>PT60S</tt:SessionTimeout>
+			<v:SmartCodec><v:Mode>on</v:Mode></v:SmartCodec>
+		</tt:Configuration>`,
+	},
+	{
+		name: "media/VideoSourceConfiguration",
+		xml: `<tt:Configuration ` + namespaces + ` token="src0">
+			<tt:Name>VideoSource</tt:Name>
+			<tt:UseCount>1</tt:UseCount>
+			<tt:SourceToken>vs0</tt:SourceToken>
+			<tt:Bounds x="0" y="0" width="1920" height="1080"></tt:Bounds>
+			<tt:Extension><tt:Rotate><tt:Mode>OFF</tt:Mode></tt:Rotate></tt:Extension>
+		</tt:Configuration>`,
+		value: &VideoSourceConfiguration{},
+		modify: func(v interface{}) {
+			c := v.(*VideoSourceConfiguration)
+			c.Extension.Rotate.Mode = "ON"
+			degree := xsd.Int(180)
+			c.Extension.Rotate.Degree = &degree
+		},
+		want: `<tt:Configuration ` + namespaces + ` token="src0">
+			<tt:Name>VideoSource</tt:Name>
+			<tt:UseCount>1</tt:UseCount>
+			<tt:SourceToken>vs0</tt:SourceToken>
+			<tt:Bounds x="0" y="0" width="1920" height="1080"></tt:Bounds>
+			<tt:Extension><tt:Rotate><tt:Mode>ON</tt:Mode><tt:Degree>180</tt:Degree></tt:Rotate></tt:Extension>
+		</tt:Configuration>`,
+	},
+	{
+		name: "ptz/PTZConfiguration",
+		xml: `<tt:Configuration ` + namespaces + ` token="ptz0">
+			<tt:Name>PTZ</tt:Name>
+			<tt:UseCount>1</tt:UseCount>
+			<tt:NodeToken>node0</tt:NodeToken>
+			<tt:DefaultAbsolutePantTiltPositionSpace>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:DefaultAbsolutePantTiltPositionSpace>
+			<tt:DefaultContinuousPanTiltVelocitySpace>http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocityGenericSpace</tt:DefaultContinuousPanTiltVelocitySpace>
+			<tt:DefaultPTZSpeed>
+				<tt:PanTilt x="0.5" y="0.5" space="http://www.onvif.org/ver10/tptz/PanTiltSpaces/GenericSpeedSpace"></tt:PanTilt>
+				<tt:Zoom x="0.5"></tt:Zoom>
+			</tt:DefaultPTZSpeed>
+			<tt:DefaultPTZTimeout>PT5S</tt:DefaultPTZTimeout>
+			<tt:PanTiltLimits><tt:Range>
+				<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:URI>
+				<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+				<tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange>
+			</tt:Range></tt:PanTiltLimits>
+		</tt:Configuration>`,
+		value: &PTZConfiguration{},
+		modify: func(v interface{}) {
+			c := v.(*PTZConfiguration)
+			c.DefaultPTZSpeed.PanTilt.X = 1
+			c.DefaultPTZTimeout = "PT10S"
+		},
+		want: `<tt:Configuration ` + namespaces + ` token="ptz0">
+			<tt:Name>PTZ</tt:Name>
+			<tt:UseCount>1</tt:UseCount>
+			<tt:NodeToken>node0</tt:NodeToken>
+			<tt:DefaultAbsolutePantTiltPositionSpace>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:DefaultAbsolutePantTiltPositionSpace>
+			<tt:DefaultContinuousPanTiltVelocitySpace>http://www.onvif.org/ver10/tptz/PanTiltSpaces/VelocityGenericSpace</tt:DefaultContinuousPanTiltVelocitySpace>
+			<tt:DefaultPTZSpeed>
+				<tt:PanTilt x="1" y="0.5" space="http://www.onvif.org/ver10/tptz/PanTiltSpaces/GenericSpeedSpace"></tt:PanTilt>
+				<tt:Zoom x="0.5"></tt:Zoom>
+			</tt:DefaultPTZSpeed>
+			<tt:DefaultPTZTimeout>PT10S</tt:DefaultPTZTimeout>
+			<tt:PanTiltLimits><tt:Range>
+				<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:URI>
+				<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+				<tt:YRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:YRange>
+			</tt:Range></tt:PanTiltLimits>
+		</tt:Configuration>`,
+	},
+	{
+		name: "imaging/ImagingSettings20",
+		xml: `<tt:ImagingSettings ` + namespaces + `>
+			<tt:Brightness>50</tt:Brightness>
+			<tt:Contrast>50</tt:Contrast>
+			<tt:Exposure><tt:Mode>AUTO</tt:Mode></tt:Exposure>
+			<tt:IrCutFilter>AUTO</tt:IrCutFilter>
+			<tt:WhiteBalance><tt:Mode>AUTO</tt:Mode>
+				<tt:Extension><v:Preset xsi:type="v:PresetType">Daylight</v:Preset></tt:Extension>
+			</tt:WhiteBalance>
+		</tt:ImagingSettings>`,
+		value: &ImagingSettings20{},
+		modify: func(v interface{}) {
+			c := v.(*ImagingSettings20)
+			brightness := 70.0
+			c.Brightness = &brightness
+			c.IrCutFilter = "ON"
+		},
+		want: `<tt:ImagingSettings ` + namespaces + `>
+			<tt:Brightness>70</tt:Brightness>
+			<tt:Contrast>50</tt:Contrast>
+			<tt:Exposure><tt:Mode>AUTO</tt:Mode></tt:Exposure>
+			<tt:IrCutFilter>ON</tt:IrCutFilter>
+			<tt:WhiteBalance><tt:Mode>AUTO</tt:Mode>
+				<tt:Extension><v:Preset xsi:type="v:PresetType">Daylight</v:Preset></tt:Extension>
+			</tt:WhiteBalance>
+		</tt:ImagingSettings>`,
+	},
+	{
+		name: "media/OSDConfiguration/text",
+		xml: `<tt:OSD ` + namespaces + ` token="osd0">
+			<tt:VideoSourceConfigurationToken>src0</tt:VideoSourceConfigurationToken>
+			<tt:Type>Text</tt:Type>
+			<tt:Position><tt:Type>UpperLeft</tt:Type></tt:Position>
+			<tt:TextString>
+				<tt:Type>Plain</tt:Type>
+				<tt:FontSize>32</tt:FontSize>
+				<tt:FontColor><tt:Color X="1" Y="1" Z="1"></tt:Color></tt:FontColor>
+				<tt:PlainText>Gate 1</tt:PlainText>
+			</tt:TextString>
+		</tt:OSD>`,
+		value: &OSDConfiguration{},
+		modify: func(v interface{}) {
+			c := v.(*OSDConfiguration)
+			c.TextString.PlainText = "Gate 2"
+		},
+		want: `<tt:OSD ` + namespaces + ` token="osd0">
+			<tt:VideoSourceConfigurationToken>src0</tt:VideoSourceConfigurationToken>
+			<tt:Type>Text</tt:Type>
+			<tt:Position><tt:Type>UpperLeft</tt:Type></tt:Position>
+			<tt:TextString>
+				<tt:Type>Plain</tt:Type>
+				<tt:FontSize>32</tt:FontSize>
+				<tt:FontColor><tt:Color X="1" Y="1" Z="1"></tt:Color></tt:FontColor>
+				<tt:PlainText>Gate 2</tt:PlainText>
+			</tt:TextString>
+		</tt:OSD>`,
+	},
+	{
+		name: "media/OSDConfiguration/image",
+		xml: `<tt:OSD ` + namespaces + ` token="osd1">
+			<tt:VideoSourceConfigurationToken>src0</tt:VideoSourceConfigurationToken>
+			<tt:Type>Image</tt:Type>
+			<tt:Position><tt:Type>Custom</tt:Type><tt:Pos x="-0.5" y="0.5"></tt:Pos></tt:Position>
+			<tt:Image><tt:ImgPath>http://192.168.0.10/logo.png</tt:ImgPath></tt:Image>
+			<tt:Extension><v:Opacity>80</v:Opacity></tt:Extension>
+		</tt:OSD>`,
+		value: &OSDConfiguration{},
+		modify: func(v interface{}) {
+			c := v.(*OSDConfiguration)
+			c.Position.Pos.X = 0.5
+		},
+		want: `<tt:OSD ` + namespaces + ` token="osd1">
+			<tt:VideoSourceConfigurationToken>src0</tt:VideoSourceConfigurationToken>
+			<tt:Type>Image</tt:Type>
+			<tt:Position><tt:Type>Custom</tt:Type><tt:Pos x="0.5" y="0.5"></tt:Pos></tt:Position>
+			<tt:Image><tt:ImgPath>http://192.168.0.10/logo.png</tt:ImgPath></tt:Image>
+			<tt:Extension><v:Opacity>80</v:Opacity></tt:Extension>
+		</tt:OSD>`,
+	},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tt := range roundTrips {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := xml.Unmarshal([]byte(tt.xml), tt.value); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			tt.modify(tt.value)
+
+			start, err := rootElement(tt.xml)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			if err := xml.NewEncoder(&buf).EncodeElement(tt.value, start); err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+
+			got, err := canonical(buf.Bytes())
+			if err != nil {
+				t.Fatalf("encoded: %v\n%s", err, buf.String())
+			}
+			want, err := canonical([]byte(tt.want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("encoded:\n%s\ngot:\n%s\nwant:\n%s", buf.String(), strings.Join(got, "\n"), strings.Join(want, "\n"))
+			}
+
+			// the encoded configuration is decoded to the same value
+			again := reflect.New(reflect.TypeOf(tt.value).Elem()).Interface()
+			if err := xml.Unmarshal(buf.Bytes(), again); err != nil {
+				t.Fatalf("decode encoded: %v", err)
+			}
+			if got, want := encode(t, again, start), encode(t, tt.value, start); got != want {
+				t.Errorf("decoded again:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+// rootElement returns the root element of the document, the value is encoded with its name
+func rootElement(data string) (xml.StartElement, error) {
+	d := xml.NewDecoder(strings.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return xml.StartElement{Name: start.Name}, nil
+		}
+	}
+}
+
+func encode(t *testing.T, v interface{}, start xml.StartElement) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := xml.NewEncoder(&buf).EncodeElement(v, start); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// canonical returns the elements, the attributes and the text of the document with the resolved namespaces.
+// The prefixes, the namespace declarations, the order of the attributes and the whitespace are ignored,
+// the QName values are resolved too.
+func canonical(data []byte) ([]string, error) {
+	var res []string
+	var scopes []map[string]string
+	resolve := func(value string) string {
+		prefix, local, ok := strings.Cut(value, ":")
+		if !ok || strings.Contains(local, "/") {
+			return value
+		}
+		for i := len(scopes) - 1; i >= 0; i-- {
+			if namespace, ok := scopes[i][prefix]; ok {
+				return "{" + namespace + "}" + local
+			}
+		}
+		return value
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			scope := map[string]string{}
+			var attrs []string
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" {
+					scope[attr.Name.Local] = attr.Value
+					continue
+				}
+				if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+					continue
+				}
+				attrs = append(attrs, fmt.Sprintf("@{%s}%s=%s", attr.Name.Space, attr.Name.Local, attr.Value))
+			}
+			scopes = append(scopes, scope)
+			for i, attr := range attrs {
+				name, value, _ := strings.Cut(attr, "=")
+				attrs[i] = name + "=" + resolve(value)
+			}
+			sort.Strings(attrs)
+			res = append(res, fmt.Sprintf("<{%s}%s>", t.Name.Space, t.Name.Local))
+			res = append(res, attrs...)
+		case xml.EndElement:
+			scopes = scopes[:len(scopes)-1]
+			res = append(res, fmt.Sprintf("</{%s}%s>", t.Name.Space, t.Name.Local))
+		case xml.CharData:
+			if text := strings.TrimSpace(string(t)); text != "" {
+				res = append(res, resolve(text))
+			}
+		}
+	}
+}
+
+func TestAllTypesListed(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "onvif.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			name := spec.(*ast.TypeSpec).Name.Name
+			if _, ok := allTypes[name]; ast.IsExported(name) && !ok {
+				t.Errorf("%s is not in allTypes", name)
+			}
+		}
+	}
+}
+
+// TestRoundTripAllTypes fills every field of every type, encodes it, decodes the encoded value and encodes it again.
+// The encodings must be the same and the value decoded again must be the same as the decoded one,
+// so no field is lost or moved by a Get followed by a Set.
+func TestRoundTripAllTypes(t *testing.T) {
+	start := xml.StartElement{Name: xml.Name{Space: "http://www.onvif.org/ver10/schema", Local: "Value"}}
+	for name, ptr := range allTypes {
+		typ := reflect.TypeOf(ptr).Elem()
+		t.Run(name, func(t *testing.T) {
+			value := reflect.New(typ)
+			fill(value.Elem(), 0)
+
+			first := encode(t, value.Interface(), start)
+			decoded := reflect.New(typ)
+			if err := xml.Unmarshal([]byte(first), decoded.Interface()); err != nil {
+				t.Fatalf("decode: %v\n%s", err, first)
+			}
+			second := encode(t, decoded.Interface(), start)
+			if first != second {
+				t.Fatalf("encoded again:\n%s\nfirst:\n%s", second, first)
+			}
+
+			again := reflect.New(typ)
+			if err := xml.Unmarshal([]byte(second), again.Interface()); err != nil {
+				t.Fatalf("decode again: %v\n%s", err, second)
+			}
+			if !reflect.DeepEqual(decoded.Interface(), again.Interface()) {
+				t.Errorf("decoded again:\n%#v\nwant:\n%#v", again.Elem().Interface(), decoded.Elem().Interface())
+			}
+		})
+	}
+}
+
+// maxFillDepth bounds the recursive types
+const maxFillDepth = 8
+
+var anyElementType = reflect.TypeOf(xsd.AnyElement{})
+
+// fill sets every field to a value which is not the zero one, the slices get one item
+func fill(v reflect.Value, depth int) {
+	if v.Type() == anyElementType {
+		v.Set(reflect.ValueOf(xsd.AnyElement{
+			XMLName:  xml.Name{Space: "urn:vendor", Local: "Item"},
+			Attr:     []xml.Attr{{Name: xml.Name{Space: "urn:vendor", Local: "mode"}, Value: "fast"}},
+			InnerXML: `<v:Level xmlns:v="urn:vendor">3</v:Level>`,
+		}))
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.PkgPath != "" || field.Name == "XMLName" || field.Tag.Get("xml") == "-" {
+				continue
+			}
+			fill(v.Field(i), depth+1)
+		}
+	case reflect.Ptr:
+		if depth < maxFillDepth {
+			v.Set(reflect.New(v.Type().Elem()))
+			fill(v.Elem(), depth+1)
+		}
+	case reflect.Slice:
+		if depth < maxFillDepth {
+			v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+			fill(v.Index(0), depth+1)
+		}
+	case reflect.String:
+		v.SetString("v1")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(7)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(7)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(0.5)
+	}
+}
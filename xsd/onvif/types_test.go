@@ -0,0 +1,471 @@
+package onvif
+
+// allTypes are the exported types of onvif.go by name, TestAllTypesListed reports the missing ones
+var allTypes = map[string]interface{}{
+	"AACDecOptions":                                  (*AACDecOptions)(nil),
+	"AbsoluteFocus":                                  (*AbsoluteFocus)(nil),
+	"AnalyticsCapabilities":                          (*AnalyticsCapabilities)(nil),
+	"AnalyticsDeviceCapabilities":                    (*AnalyticsDeviceCapabilities)(nil),
+	"AnalyticsDeviceExtension":                       (*AnalyticsDeviceExtension)(nil),
+	"AnalyticsEngineConfiguration":                   (*AnalyticsEngineConfiguration)(nil),
+	"AnalyticsEngineConfigurationExtension":          (*AnalyticsEngineConfigurationExtension)(nil),
+	"AttachmentData":                                 (*AttachmentData)(nil),
+	"AudioDecoderConfiguration":                      (*AudioDecoderConfiguration)(nil),
+	"AudioDecoderConfigurationOptions":               (*AudioDecoderConfigurationOptions)(nil),
+	"AudioDecoderConfigurationOptionsExtension":      (*AudioDecoderConfigurationOptionsExtension)(nil),
+	"AudioEncoder2Configuration":                     (*AudioEncoder2Configuration)(nil),
+	"AudioEncoder2ConfigurationResponse":             (*AudioEncoder2ConfigurationResponse)(nil),
+	"AudioEncoderConfiguration":                      (*AudioEncoderConfiguration)(nil),
+	"AudioEncoderConfigurationOption":                (*AudioEncoderConfigurationOption)(nil),
+	"AudioEncoderConfigurationOptions":               (*AudioEncoderConfigurationOptions)(nil),
+	"AudioEncoderConfigurationResponse":              (*AudioEncoderConfigurationResponse)(nil),
+	"AudioEncoding":                                  (*AudioEncoding)(nil),
+	"AudioOutput":                                    (*AudioOutput)(nil),
+	"AudioOutputConfiguration":                       (*AudioOutputConfiguration)(nil),
+	"AudioOutputConfigurationOptions":                (*AudioOutputConfigurationOptions)(nil),
+	"AudioSource":                                    (*AudioSource)(nil),
+	"AudioSourceConfiguration":                       (*AudioSourceConfiguration)(nil),
+	"AudioSourceConfigurationOptions":                (*AudioSourceConfigurationOptions)(nil),
+	"AudioSourceOptionsExtension":                    (*AudioSourceOptionsExtension)(nil),
+	"AutoFocusMode":                                  (*AutoFocusMode)(nil),
+	"AuxiliaryData":                                  (*AuxiliaryData)(nil),
+	"BacklightCompensation":                          (*BacklightCompensation)(nil),
+	"BacklightCompensation20":                        (*BacklightCompensation20)(nil),
+	"BacklightCompensationMode":                      (*BacklightCompensationMode)(nil),
+	"BackupFile":                                     (*BackupFile)(nil),
+	"BinaryData":                                     (*BinaryData)(nil),
+	"Capabilities":                                   (*Capabilities)(nil),
+	"CapabilitiesExtension":                          (*CapabilitiesExtension)(nil),
+	"CapabilitiesExtension2":                         (*CapabilitiesExtension2)(nil),
+	"CapabilityCategory":                             (*CapabilityCategory)(nil),
+	"Certificate":                                    (*Certificate)(nil),
+	"CertificateInformation":                         (*CertificateInformation)(nil),
+	"CertificateInformationExtension":                (*CertificateInformationExtension)(nil),
+	"CertificateStatus":                              (*CertificateStatus)(nil),
+	"CertificateUsage":                               (*CertificateUsage)(nil),
+	"CertificateWithPrivateKey":                      (*CertificateWithPrivateKey)(nil),
+	"CodingCapabilities":                             (*CodingCapabilities)(nil),
+	"Color":                                          (*Color)(nil),
+	"ColorOptions":                                   (*ColorOptions)(nil),
+	"ColorspaceRange":                                (*ColorspaceRange)(nil),
+	"Config":                                         (*Config)(nil),
+	"ConfigurationEntity":                            (*ConfigurationEntity)(nil),
+	"ConfigurationEntityResponse":                    (*ConfigurationEntityResponse)(nil),
+	"ContentType":                                    (*ContentType)(nil),
+	"ContinuousFocus":                                (*ContinuousFocus)(nil),
+	"DNSInformation":                                 (*DNSInformation)(nil),
+	"DNSInformationExtension":                        (*DNSInformationExtension)(nil),
+	"DNSName":                                        (*DNSName)(nil),
+	"Date":                                           (*Date)(nil),
+	"DateResponse":                                   (*DateResponse)(nil),
+	"DateTime":                                       (*DateTime)(nil),
+	"DateTimeRange":                                  (*DateTimeRange)(nil),
+	"DateTimeResponse":                               (*DateTimeResponse)(nil),
+	"Defogging":                                      (*Defogging)(nil),
+	"DefoggingExtension":                             (*DefoggingExtension)(nil),
+	"Description":                                    (*Description)(nil),
+	"DeviceCapabilities":                             (*DeviceCapabilities)(nil),
+	"DeviceCapabilitiesExtension":                    (*DeviceCapabilitiesExtension)(nil),
+	"DeviceEntity":                                   (*DeviceEntity)(nil),
+	"DeviceIOCapabilities":                           (*DeviceIOCapabilities)(nil),
+	"DigitalIdleState":                               (*DigitalIdleState)(nil),
+	"DigitalInput":                                   (*DigitalInput)(nil),
+	"DiscoveryMode":                                  (*DiscoveryMode)(nil),
+	"DisplayCapabilities":                            (*DisplayCapabilities)(nil),
+	"Dot11AuthAndMangementSuite":                     (*Dot11AuthAndMangementSuite)(nil),
+	"Dot11AvailableNetworks":                         (*Dot11AvailableNetworks)(nil),
+	"Dot11AvailableNetworksExtension":                (*Dot11AvailableNetworksExtension)(nil),
+	"Dot11Capabilities":                              (*Dot11Capabilities)(nil),
+	"Dot11Cipher":                                    (*Dot11Cipher)(nil),
+	"Dot11Configuration":                             (*Dot11Configuration)(nil),
+	"Dot11PSK":                                       (*Dot11PSK)(nil),
+	"Dot11PSKPassphrase":                             (*Dot11PSKPassphrase)(nil),
+	"Dot11PSKSet":                                    (*Dot11PSKSet)(nil),
+	"Dot11PSKSetExtension":                           (*Dot11PSKSetExtension)(nil),
+	"Dot11SSIDType":                                  (*Dot11SSIDType)(nil),
+	"Dot11SecurityConfiguration":                     (*Dot11SecurityConfiguration)(nil),
+	"Dot11SecurityConfigurationExtension":            (*Dot11SecurityConfigurationExtension)(nil),
+	"Dot11SecurityMode":                              (*Dot11SecurityMode)(nil),
+	"Dot11SignalStrength":                            (*Dot11SignalStrength)(nil),
+	"Dot11StationMode":                               (*Dot11StationMode)(nil),
+	"Dot11Status":                                    (*Dot11Status)(nil),
+	"Dot1XConfiguration":                             (*Dot1XConfiguration)(nil),
+	"Dot1XConfigurationExtension":                    (*Dot1XConfigurationExtension)(nil),
+	"Dot3Configuration":                              (*Dot3Configuration)(nil),
+	"Duplex":                                         (*Duplex)(nil),
+	"DurationRange":                                  (*DurationRange)(nil),
+	"DynamicDNSInformation":                          (*DynamicDNSInformation)(nil),
+	"DynamicDNSInformationExtension":                 (*DynamicDNSInformationExtension)(nil),
+	"DynamicDNSType":                                 (*DynamicDNSType)(nil),
+	"EAPMethodConfiguration":                         (*EAPMethodConfiguration)(nil),
+	"EFlip":                                          (*EFlip)(nil),
+	"EFlipMode":                                      (*EFlipMode)(nil),
+	"EFlipOptions":                                   (*EFlipOptions)(nil),
+	"EFlipOptionsExtension":                          (*EFlipOptionsExtension)(nil),
+	"EapMethodExtension":                             (*EapMethodExtension)(nil),
+	"ElementItem":                                    (*ElementItem)(nil),
+	"EncodingTypes":                                  (*EncodingTypes)(nil),
+	"EventCapabilities":                              (*EventCapabilities)(nil),
+	"EventSubscription":                              (*EventSubscription)(nil),
+	"Exposure":                                       (*Exposure)(nil),
+	"Exposure20":                                     (*Exposure20)(nil),
+	"ExposureMode":                                   (*ExposureMode)(nil),
+	"ExposurePriority":                               (*ExposurePriority)(nil),
+	"FactoryDefaultType":                             (*FactoryDefaultType)(nil),
+	"FilterType":                                     (*FilterType)(nil),
+	"FindPTZPositionResult":                          (*FindPTZPositionResult)(nil),
+	"FindPTZPositionResultList":                      (*FindPTZPositionResultList)(nil),
+	"FindRecordingResultList":                        (*FindRecordingResultList)(nil),
+	"FloatRange":                                     (*FloatRange)(nil),
+	"FloatRectangle":                                 (*FloatRectangle)(nil),
+	"FocusConfiguration":                             (*FocusConfiguration)(nil),
+	"FocusConfiguration20":                           (*FocusConfiguration20)(nil),
+	"FocusConfiguration20Extension":                  (*FocusConfiguration20Extension)(nil),
+	"FocusMove":                                      (*FocusMove)(nil),
+	"G711DecOptions":                                 (*G711DecOptions)(nil),
+	"G726DecOptions":                                 (*G726DecOptions)(nil),
+	"GeoLocation":                                    (*GeoLocation)(nil),
+	"GeoOrientation":                                 (*GeoOrientation)(nil),
+	"GetRecordingJobsResponseItem":                   (*GetRecordingJobsResponseItem)(nil),
+	"GetRecordingsResponseItem":                      (*GetRecordingsResponseItem)(nil),
+	"GetTracksResponseItem":                          (*GetTracksResponseItem)(nil),
+	"GetTracksResponseList":                          (*GetTracksResponseList)(nil),
+	"H264Configuration":                              (*H264Configuration)(nil),
+	"H264ConfigurationResponse":                      (*H264ConfigurationResponse)(nil),
+	"H264DecOptions":                                 (*H264DecOptions)(nil),
+	"H264Options":                                    (*H264Options)(nil),
+	"H264Options2":                                   (*H264Options2)(nil),
+	"H264Profile":                                    (*H264Profile)(nil),
+	"HostnameInformation":                            (*HostnameInformation)(nil),
+	"HostnameInformationExtension":                   (*HostnameInformationExtension)(nil),
+	"HwAddress":                                      (*HwAddress)(nil),
+	"IANA_IfTypes":                                   (*IANA_IfTypes)(nil),
+	"IOCapabilities":                                 (*IOCapabilities)(nil),
+	"IOCapabilitiesExtension":                        (*IOCapabilitiesExtension)(nil),
+	"IOCapabilitiesExtension2":                       (*IOCapabilitiesExtension2)(nil),
+	"IPAddress":                                      (*IPAddress)(nil),
+	"IPAddressFilter":                                (*IPAddressFilter)(nil),
+	"IPAddressFilterExtension":                       (*IPAddressFilterExtension)(nil),
+	"IPAddressFilterType":                            (*IPAddressFilterType)(nil),
+	"IPType":                                         (*IPType)(nil),
+	"IPv4Address":                                    (*IPv4Address)(nil),
+	"IPv4Configuration":                              (*IPv4Configuration)(nil),
+	"IPv4NetworkInterface":                           (*IPv4NetworkInterface)(nil),
+	"IPv4NetworkInterfaceSetConfiguration":           (*IPv4NetworkInterfaceSetConfiguration)(nil),
+	"IPv6Address":                                    (*IPv6Address)(nil),
+	"IPv6Configuration":                              (*IPv6Configuration)(nil),
+	"IPv6ConfigurationExtension":                     (*IPv6ConfigurationExtension)(nil),
+	"IPv6DHCPConfiguration":                          (*IPv6DHCPConfiguration)(nil),
+	"IPv6NetworkInterface":                           (*IPv6NetworkInterface)(nil),
+	"IPv6NetworkInterfaceSetConfiguration":           (*IPv6NetworkInterfaceSetConfiguration)(nil),
+	"ImageStabilization":                             (*ImageStabilization)(nil),
+	"ImageStabilizationExtension":                    (*ImageStabilizationExtension)(nil),
+	"ImageStabilizationMode":                         (*ImageStabilizationMode)(nil),
+	"ImagingCapabilities":                            (*ImagingCapabilities)(nil),
+	"ImagingSettings":                                (*ImagingSettings)(nil),
+	"ImagingSettings20":                              (*ImagingSettings20)(nil),
+	"ImagingSettingsExtension":                       (*ImagingSettingsExtension)(nil),
+	"ImagingSettingsExtension20":                     (*ImagingSettingsExtension20)(nil),
+	"ImagingSettingsExtension202":                    (*ImagingSettingsExtension202)(nil),
+	"ImagingSettingsExtension203":                    (*ImagingSettingsExtension203)(nil),
+	"ImagingSettingsExtension204":                    (*ImagingSettingsExtension204)(nil),
+	"Include":                                        (*Include)(nil),
+	"IntAttrList":                                    (*IntAttrList)(nil),
+	"IntList":                                        (*IntList)(nil),
+	"IntRange":                                       (*IntRange)(nil),
+	"IntRectangle":                                   (*IntRectangle)(nil),
+	"IntRectangleRange":                              (*IntRectangleRange)(nil),
+	"IrCutFilterAutoAdjustment":                      (*IrCutFilterAutoAdjustment)(nil),
+	"IrCutFilterAutoAdjustmentExtension":             (*IrCutFilterAutoAdjustmentExtension)(nil),
+	"IrCutFilterMode":                                (*IrCutFilterMode)(nil),
+	"ItemList":                                       (*ItemList)(nil),
+	"ItemListExtension":                              (*ItemListExtension)(nil),
+	"JpegDecOptions":                                 (*JpegDecOptions)(nil),
+	"JpegOptions":                                    (*JpegOptions)(nil),
+	"JpegOptions2":                                   (*JpegOptions2)(nil),
+	"Layout":                                         (*Layout)(nil),
+	"LayoutOptions":                                  (*LayoutOptions)(nil),
+	"LayoutResponse":                                 (*LayoutResponse)(nil),
+	"LensDescription":                                (*LensDescription)(nil),
+	"LensDescriptionResponse":                        (*LensDescriptionResponse)(nil),
+	"LensOffset":                                     (*LensOffset)(nil),
+	"LensProjection":                                 (*LensProjection)(nil),
+	"LensProjectionResponse":                         (*LensProjectionResponse)(nil),
+	"LocalLocation":                                  (*LocalLocation)(nil),
+	"LocalOrientation":                               (*LocalOrientation)(nil),
+	"LocationEntity":                                 (*LocationEntity)(nil),
+	"MaximumNumberOfOSDs":                            (*MaximumNumberOfOSDs)(nil),
+	"MediaCapabilities":                              (*MediaCapabilities)(nil),
+	"MediaCapabilitiesExtension":                     (*MediaCapabilitiesExtension)(nil),
+	"MediaUri":                                       (*MediaUri)(nil),
+	"MetadataConfiguration":                          (*MetadataConfiguration)(nil),
+	"MetadataConfigurationExtension":                 (*MetadataConfigurationExtension)(nil),
+	"MetadataConfigurationOptions":                   (*MetadataConfigurationOptions)(nil),
+	"MetadataConfigurationOptionsExtension":          (*MetadataConfigurationOptionsExtension)(nil),
+	"MetadataConfigurationOptionsExtension2":         (*MetadataConfigurationOptionsExtension2)(nil),
+	"MoveStatus":                                     (*MoveStatus)(nil),
+	"Mpeg4Configuration":                             (*Mpeg4Configuration)(nil),
+	"Mpeg4ConfigurationResponse":                     (*Mpeg4ConfigurationResponse)(nil),
+	"Mpeg4DecOptions":                                (*Mpeg4DecOptions)(nil),
+	"Mpeg4Options":                                   (*Mpeg4Options)(nil),
+	"Mpeg4Options2":                                  (*Mpeg4Options2)(nil),
+	"Mpeg4Profile":                                   (*Mpeg4Profile)(nil),
+	"MulticastConfiguration":                         (*MulticastConfiguration)(nil),
+	"MulticastConfigurationResponse":                 (*MulticastConfigurationResponse)(nil),
+	"NTPInformation":                                 (*NTPInformation)(nil),
+	"NTPInformationExtension":                        (*NTPInformationExtension)(nil),
+	"Name":                                           (*Name)(nil),
+	"NetworkCapabilities":                            (*NetworkCapabilities)(nil),
+	"NetworkCapabilitiesExtension":                   (*NetworkCapabilitiesExtension)(nil),
+	"NetworkCapabilitiesExtension2":                  (*NetworkCapabilitiesExtension2)(nil),
+	"NetworkGateway":                                 (*NetworkGateway)(nil),
+	"NetworkHost":                                    (*NetworkHost)(nil),
+	"NetworkHostExtension":                           (*NetworkHostExtension)(nil),
+	"NetworkHostType":                                (*NetworkHostType)(nil),
+	"NetworkInterface":                               (*NetworkInterface)(nil),
+	"NetworkInterfaceConfigPriority":                 (*NetworkInterfaceConfigPriority)(nil),
+	"NetworkInterfaceConnectionSetting":              (*NetworkInterfaceConnectionSetting)(nil),
+	"NetworkInterfaceExtension":                      (*NetworkInterfaceExtension)(nil),
+	"NetworkInterfaceExtension2":                     (*NetworkInterfaceExtension2)(nil),
+	"NetworkInterfaceInfo":                           (*NetworkInterfaceInfo)(nil),
+	"NetworkInterfaceLink":                           (*NetworkInterfaceLink)(nil),
+	"NetworkInterfaceSetConfiguration":               (*NetworkInterfaceSetConfiguration)(nil),
+	"NetworkInterfaceSetConfigurationExtension":      (*NetworkInterfaceSetConfigurationExtension)(nil),
+	"NetworkInterfaceSetConfigurationExtension2":     (*NetworkInterfaceSetConfigurationExtension2)(nil),
+	"NetworkProtocol":                                (*NetworkProtocol)(nil),
+	"NetworkProtocolExtension":                       (*NetworkProtocolExtension)(nil),
+	"NetworkProtocolType":                            (*NetworkProtocolType)(nil),
+	"NetworkZeroConfiguration":                       (*NetworkZeroConfiguration)(nil),
+	"NetworkZeroConfigurationExtension":              (*NetworkZeroConfigurationExtension)(nil),
+	"NetworkZeroConfigurationExtension2":             (*NetworkZeroConfigurationExtension2)(nil),
+	"NoiseReduction":                                 (*NoiseReduction)(nil),
+	"OSDColor":                                       (*OSDColor)(nil),
+	"OSDColorOptions":                                (*OSDColorOptions)(nil),
+	"OSDColorOptionsExtension":                       (*OSDColorOptionsExtension)(nil),
+	"OSDConfiguration":                               (*OSDConfiguration)(nil),
+	"OSDConfigurationExtension":                      (*OSDConfigurationExtension)(nil),
+	"OSDConfigurationOptions":                        (*OSDConfigurationOptions)(nil),
+	"OSDConfigurationOptionsExtension":               (*OSDConfigurationOptionsExtension)(nil),
+	"OSDImgConfiguration":                            (*OSDImgConfiguration)(nil),
+	"OSDImgConfigurationExtension":                   (*OSDImgConfigurationExtension)(nil),
+	"OSDImgOptions":                                  (*OSDImgOptions)(nil),
+	"OSDImgOptionsExtension":                         (*OSDImgOptionsExtension)(nil),
+	"OSDPosConfiguration":                            (*OSDPosConfiguration)(nil),
+	"OSDPosConfigurationExtension":                   (*OSDPosConfigurationExtension)(nil),
+	"OSDReference":                                   (*OSDReference)(nil),
+	"OSDTextConfiguration":                           (*OSDTextConfiguration)(nil),
+	"OSDTextConfigurationExtension":                  (*OSDTextConfigurationExtension)(nil),
+	"OSDTextOptions":                                 (*OSDTextOptions)(nil),
+	"OSDTextOptionsExtension":                        (*OSDTextOptionsExtension)(nil),
+	"OSDType":                                        (*OSDType)(nil),
+	"OnvifVersion":                                   (*OnvifVersion)(nil),
+	"PTControlDirection":                             (*PTControlDirection)(nil),
+	"PTControlDirectionExtension":                    (*PTControlDirectionExtension)(nil),
+	"PTControlDirectionOptions":                      (*PTControlDirectionOptions)(nil),
+	"PTControlDirectionOptionsExtension":             (*PTControlDirectionOptionsExtension)(nil),
+	"PTZCapabilities":                                (*PTZCapabilities)(nil),
+	"PTZConfiguration":                               (*PTZConfiguration)(nil),
+	"PTZConfigurationExtension":                      (*PTZConfigurationExtension)(nil),
+	"PTZConfigurationExtension2":                     (*PTZConfigurationExtension2)(nil),
+	"PTZConfigurationOptions":                        (*PTZConfigurationOptions)(nil),
+	"PTZConfigurationOptions2":                       (*PTZConfigurationOptions2)(nil),
+	"PTZFilter":                                      (*PTZFilter)(nil),
+	"PTZMoveStatus":                                  (*PTZMoveStatus)(nil),
+	"PTZNode":                                        (*PTZNode)(nil),
+	"PTZNodeExtension":                               (*PTZNodeExtension)(nil),
+	"PTZNodeExtension2":                              (*PTZNodeExtension2)(nil),
+	"PTZPositionFilter":                              (*PTZPositionFilter)(nil),
+	"PTZPreset":                                      (*PTZPreset)(nil),
+	"PTZPresetTourDirection":                         (*PTZPresetTourDirection)(nil),
+	"PTZPresetTourExtension":                         (*PTZPresetTourExtension)(nil),
+	"PTZPresetTourOperation":                         (*PTZPresetTourOperation)(nil),
+	"PTZPresetTourOptions":                           (*PTZPresetTourOptions)(nil),
+	"PTZPresetTourPresetDetail":                      (*PTZPresetTourPresetDetail)(nil),
+	"PTZPresetTourPresetDetailOptions":               (*PTZPresetTourPresetDetailOptions)(nil),
+	"PTZPresetTourPresetDetailOptionsExtension":      (*PTZPresetTourPresetDetailOptionsExtension)(nil),
+	"PTZPresetTourSpot":                              (*PTZPresetTourSpot)(nil),
+	"PTZPresetTourSpotExtension":                     (*PTZPresetTourSpotExtension)(nil),
+	"PTZPresetTourSpotOptions":                       (*PTZPresetTourSpotOptions)(nil),
+	"PTZPresetTourStartingCondition":                 (*PTZPresetTourStartingCondition)(nil),
+	"PTZPresetTourStartingConditionExtension":        (*PTZPresetTourStartingConditionExtension)(nil),
+	"PTZPresetTourStartingConditionOptions":          (*PTZPresetTourStartingConditionOptions)(nil),
+	"PTZPresetTourStartingConditionOptionsExtension": (*PTZPresetTourStartingConditionOptionsExtension)(nil),
+	"PTZPresetTourState":                             (*PTZPresetTourState)(nil),
+	"PTZPresetTourStatus":                            (*PTZPresetTourStatus)(nil),
+	"PTZPresetTourStatusExtension":                   (*PTZPresetTourStatusExtension)(nil),
+	"PTZPresetTourSupported":                         (*PTZPresetTourSupported)(nil),
+	"PTZPresetTourSupportedExtension":                (*PTZPresetTourSupportedExtension)(nil),
+	"PTZPresetTourTypeExtension":                     (*PTZPresetTourTypeExtension)(nil),
+	"PTZSpaces":                                      (*PTZSpaces)(nil),
+	"PTZSpacesExtension":                             (*PTZSpacesExtension)(nil),
+	"PTZSpeed":                                       (*PTZSpeed)(nil),
+	"PTZStatus":                                      (*PTZStatus)(nil),
+	"PTZStatusFilterOptions":                         (*PTZStatusFilterOptions)(nil),
+	"PTZStatusFilterOptionsExtension":                (*PTZStatusFilterOptionsExtension)(nil),
+	"PTZVector":                                      (*PTZVector)(nil),
+	"PTZVectorResponse":                              (*PTZVectorResponse)(nil),
+	"PanTiltLimits":                                  (*PanTiltLimits)(nil),
+	"PaneConfiguration":                              (*PaneConfiguration)(nil),
+	"PaneConfigurationResponse":                      (*PaneConfigurationResponse)(nil),
+	"PaneLayout":                                     (*PaneLayout)(nil),
+	"PaneLayoutOptions":                              (*PaneLayoutOptions)(nil),
+	"PaneLayoutResponse":                             (*PaneLayoutResponse)(nil),
+	"Polygon":                                        (*Polygon)(nil),
+	"PolygonResponse":                                (*PolygonResponse)(nil),
+	"PrefixedIPv4Address":                            (*PrefixedIPv4Address)(nil),
+	"PrefixedIPv6Address":                            (*PrefixedIPv6Address)(nil),
+	"PresetTour":                                     (*PresetTour)(nil),
+	"Profile":                                        (*Profile)(nil),
+	"ProfileCapabilities":                            (*ProfileCapabilities)(nil),
+	"ProfileExtension":                               (*ProfileExtension)(nil),
+	"ProfileExtension2":                              (*ProfileExtension2)(nil),
+	"ProfileResponse":                                (*ProfileResponse)(nil),
+	"RealTimeStreamingCapabilities":                  (*RealTimeStreamingCapabilities)(nil),
+	"RealTimeStreamingCapabilitiesExtension":         (*RealTimeStreamingCapabilitiesExtension)(nil),
+	"Receiver":                                       (*Receiver)(nil),
+	"ReceiverCapabilities":                           (*ReceiverCapabilities)(nil),
+	"ReceiverConfiguration":                          (*ReceiverConfiguration)(nil),
+	"ReceiverConfigurationResponse":                  (*ReceiverConfigurationResponse)(nil),
+	"ReceiverMode":                                   (*ReceiverMode)(nil),
+	"ReceiverResponse":                               (*ReceiverResponse)(nil),
+	"ReceiverState":                                  (*ReceiverState)(nil),
+	"ReceiverStateInformation":                       (*ReceiverStateInformation)(nil),
+	"RecordingCapabilities":                          (*RecordingCapabilities)(nil),
+	"RecordingConfiguration":                         (*RecordingConfiguration)(nil),
+	"RecordingConfigurationResponse":                 (*RecordingConfigurationResponse)(nil),
+	"RecordingInformation":                           (*RecordingInformation)(nil),
+	"RecordingJobConfiguration":                      (*RecordingJobConfiguration)(nil),
+	"RecordingJobConfigurationResponse":              (*RecordingJobConfigurationResponse)(nil),
+	"RecordingJobMode":                               (*RecordingJobMode)(nil),
+	"RecordingJobSource":                             (*RecordingJobSource)(nil),
+	"RecordingJobSourceResponse":                     (*RecordingJobSourceResponse)(nil),
+	"RecordingJobStateInformation":                   (*RecordingJobStateInformation)(nil),
+	"RecordingJobStateSource":                        (*RecordingJobStateSource)(nil),
+	"RecordingJobStateTrack":                         (*RecordingJobStateTrack)(nil),
+	"RecordingJobStateTracks":                        (*RecordingJobStateTracks)(nil),
+	"RecordingJobTrack":                              (*RecordingJobTrack)(nil),
+	"RecordingJobTrackResponse":                      (*RecordingJobTrackResponse)(nil),
+	"RecordingSourceInformation":                     (*RecordingSourceInformation)(nil),
+	"RecordingSourceInformationResponse":             (*RecordingSourceInformationResponse)(nil),
+	"RecordingStatus":                                (*RecordingStatus)(nil),
+	"RecordingSummary":                               (*RecordingSummary)(nil),
+	"Rectangle":                                      (*Rectangle)(nil),
+	"ReferenceToken":                                 (*ReferenceToken)(nil),
+	"RelativeFocus":                                  (*RelativeFocus)(nil),
+	"RelayIdleState":                                 (*RelayIdleState)(nil),
+	"RelayLogicalState":                              (*RelayLogicalState)(nil),
+	"RelayMode":                                      (*RelayMode)(nil),
+	"RelayOutput":                                    (*RelayOutput)(nil),
+	"RelayOutputResponse":                            (*RelayOutputResponse)(nil),
+	"RelayOutputSettings":                            (*RelayOutputSettings)(nil),
+	"RelayOutputSettingsResponse":                    (*RelayOutputSettingsResponse)(nil),
+	"RemoteUser":                                     (*RemoteUser)(nil),
+	"ReplayCapabilities":                             (*ReplayCapabilities)(nil),
+	"ReplayConfiguration":                            (*ReplayConfiguration)(nil),
+	"ReplayConfigurationResponse":                    (*ReplayConfigurationResponse)(nil),
+	"Reverse":                                        (*Reverse)(nil),
+	"ReverseMode":                                    (*ReverseMode)(nil),
+	"ReverseOptions":                                 (*ReverseOptions)(nil),
+	"ReverseOptionsExtension":                        (*ReverseOptionsExtension)(nil),
+	"Rotate":                                         (*Rotate)(nil),
+	"RotateExtension":                                (*RotateExtension)(nil),
+	"RotateMode":                                     (*RotateMode)(nil),
+	"RotateOptions":                                  (*RotateOptions)(nil),
+	"RotateOptionsExtension":                         (*RotateOptionsExtension)(nil),
+	"RotateResponse":                                 (*RotateResponse)(nil),
+	"RuleEngineConfiguration":                        (*RuleEngineConfiguration)(nil),
+	"RuleEngineConfigurationExtension":               (*RuleEngineConfigurationExtension)(nil),
+	"SceneOrientation":                               (*SceneOrientation)(nil),
+	"SceneOrientationMode":                           (*SceneOrientationMode)(nil),
+	"SceneOrientationResponse":                       (*SceneOrientationResponse)(nil),
+	"Scope":                                          (*Scope)(nil),
+	"ScopeDefinition":                                (*ScopeDefinition)(nil),
+	"SearchCapabilities":                             (*SearchCapabilities)(nil),
+	"SearchScope":                                    (*SearchScope)(nil),
+	"SearchState":                                    (*SearchState)(nil),
+	"SecurityCapabilities":                           (*SecurityCapabilities)(nil),
+	"SecurityCapabilitiesExtension":                  (*SecurityCapabilitiesExtension)(nil),
+	"SecurityCapabilitiesExtension2":                 (*SecurityCapabilitiesExtension2)(nil),
+	"SetDateTimeType":                                (*SetDateTimeType)(nil),
+	"SimpleItem":                                     (*SimpleItem)(nil),
+	"SourceReference":                                (*SourceReference)(nil),
+	"SourceReferenceResponse":                        (*SourceReferenceResponse)(nil),
+	"Space1DDescription":                             (*Space1DDescription)(nil),
+	"Space2DDescription":                             (*Space2DDescription)(nil),
+	"StreamSetup":                                    (*StreamSetup)(nil),
+	"StreamSetupResponse":                            (*StreamSetupResponse)(nil),
+	"StreamType":                                     (*StreamType)(nil),
+	"StringAttrList":                                 (*StringAttrList)(nil),
+	"SubscriptionPolicy":                             (*SubscriptionPolicy)(nil),
+	"SupportInformation":                             (*SupportInformation)(nil),
+	"SystemCapabilities":                             (*SystemCapabilities)(nil),
+	"SystemCapabilitiesExtension":                    (*SystemCapabilitiesExtension)(nil),
+	"SystemCapabilitiesExtension2":                   (*SystemCapabilitiesExtension2)(nil),
+	"SystemDateTime":                                 (*SystemDateTime)(nil),
+	"SystemDateTimeExtension":                        (*SystemDateTimeExtension)(nil),
+	"SystemLog":                                      (*SystemLog)(nil),
+	"SystemLogType":                                  (*SystemLogType)(nil),
+	"SystemLogUri":                                   (*SystemLogUri)(nil),
+	"SystemLogUriList":                               (*SystemLogUriList)(nil),
+	"TLSConfiguration":                               (*TLSConfiguration)(nil),
+	"Time":                                           (*Time)(nil),
+	"TimeResponse":                                   (*TimeResponse)(nil),
+	"TimeZone":                                       (*TimeZone)(nil),
+	"TimeZoneResponse":                               (*TimeZoneResponse)(nil),
+	"ToneCompensation":                               (*ToneCompensation)(nil),
+	"ToneCompensationExtension":                      (*ToneCompensationExtension)(nil),
+	"TrackConfiguration":                             (*TrackConfiguration)(nil),
+	"TrackConfigurationResponse":                     (*TrackConfigurationResponse)(nil),
+	"TrackInformation":                               (*TrackInformation)(nil),
+	"TrackType":                                      (*TrackType)(nil),
+	"Transport":                                      (*Transport)(nil),
+	"TransportProtocol":                              (*TransportProtocol)(nil),
+	"TransportResponse":                              (*TransportResponse)(nil),
+	"User":                                           (*User)(nil),
+	"UserExtension":                                  (*UserExtension)(nil),
+	"UserLevel":                                      (*UserLevel)(nil),
+	"Vector":                                         (*Vector)(nil),
+	"Vector1D":                                       (*Vector1D)(nil),
+	"Vector2D":                                       (*Vector2D)(nil),
+	"VideoAnalyticsConfiguration":                    (*VideoAnalyticsConfiguration)(nil),
+	"VideoDecoderConfigurationOptions":               (*VideoDecoderConfigurationOptions)(nil),
+	"VideoEncoder2Configuration":                     (*VideoEncoder2Configuration)(nil),
+	"VideoEncoder2ConfigurationOptions":              (*VideoEncoder2ConfigurationOptions)(nil),
+	"VideoEncoder2ConfigurationResponse":             (*VideoEncoder2ConfigurationResponse)(nil),
+	"VideoEncoderConfiguration":                      (*VideoEncoderConfiguration)(nil),
+	"VideoEncoderConfigurationOptions":               (*VideoEncoderConfigurationOptions)(nil),
+	"VideoEncoderConfigurationResponse":              (*VideoEncoderConfigurationResponse)(nil),
+	"VideoEncoderOptionsExtension":                   (*VideoEncoderOptionsExtension)(nil),
+	"VideoEncoderOptionsExtension2":                  (*VideoEncoderOptionsExtension2)(nil),
+	"VideoEncoding":                                  (*VideoEncoding)(nil),
+	"VideoOutput":                                    (*VideoOutput)(nil),
+	"VideoOutputResponse":                            (*VideoOutputResponse)(nil),
+	"VideoRateControl":                               (*VideoRateControl)(nil),
+	"VideoRateControl2":                              (*VideoRateControl2)(nil),
+	"VideoRateControl2Response":                      (*VideoRateControl2Response)(nil),
+	"VideoRateControlResponse":                       (*VideoRateControlResponse)(nil),
+	"VideoResolution":                                (*VideoResolution)(nil),
+	"VideoResolutionResponse":                        (*VideoResolutionResponse)(nil),
+	"VideoSource":                                    (*VideoSource)(nil),
+	"VideoSourceConfiguration":                       (*VideoSourceConfiguration)(nil),
+	"VideoSourceConfigurationExtension":              (*VideoSourceConfigurationExtension)(nil),
+	"VideoSourceConfigurationExtension2":             (*VideoSourceConfigurationExtension2)(nil),
+	"VideoSourceConfigurationExtension2Response":     (*VideoSourceConfigurationExtension2Response)(nil),
+	"VideoSourceConfigurationExtensionResponse":      (*VideoSourceConfigurationExtensionResponse)(nil),
+	"VideoSourceConfigurationOptions":                (*VideoSourceConfigurationOptions)(nil),
+	"VideoSourceConfigurationOptionsExtension":       (*VideoSourceConfigurationOptionsExtension)(nil),
+	"VideoSourceConfigurationOptionsExtension2":      (*VideoSourceConfigurationOptionsExtension2)(nil),
+	"VideoSourceConfigurationResponse":               (*VideoSourceConfigurationResponse)(nil),
+	"VideoSourceExtension":                           (*VideoSourceExtension)(nil),
+	"VideoSourceExtension2":                          (*VideoSourceExtension2)(nil),
+	"VideoSourceMode":                                (*VideoSourceMode)(nil),
+	"VideoSourceModeExtension":                       (*VideoSourceModeExtension)(nil),
+	"WhiteBalance":                                   (*WhiteBalance)(nil),
+	"WhiteBalance20":                                 (*WhiteBalance20)(nil),
+	"WhiteBalance20Extension":                        (*WhiteBalance20Extension)(nil),
+	"WhiteBalanceMode":                               (*WhiteBalanceMode)(nil),
+	"WideDynamicMode":                                (*WideDynamicMode)(nil),
+	"WideDynamicRange":                               (*WideDynamicRange)(nil),
+	"WideDynamicRange20":                             (*WideDynamicRange20)(nil),
+	"ZoomLimits":                                     (*ZoomLimits)(nil),
+}